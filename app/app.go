@@ -297,16 +297,25 @@ func NewFuryApp(
 		epochsTypes.NewMultiEpochHooks(),
 	)
 
-	app.OracleKeeper = oraclekeeper.NewKeeper(
+	oracleKeeper := oraclekeeper.NewKeeper(
 		appCodec,
 		keys[oracletypes.ModuleName],
 		app.GetSubspace(oracletypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper,
-		stakingKeeper,
+		&stakingKeeper,
+		app.SlashingKeeper,
+		authtypes.FeeCollectorName,
 		distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.OracleKeeper = *oracleKeeper.SetHooks(
+		oracletypes.NewMultiOracleHooks(),
+	)
+	// validators jailed for missing oracle votes can only unjail once their
+	// oracle jail is over
+	app.SlashingKeeper.SetUnjailGuard(app.OracleKeeper)
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
@@ -318,8 +327,6 @@ func NewFuryApp(
 	app.StakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
-	app.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], app.IBCKeeper)
-
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter(), app.AccountKeeper)
 
 	groupConfig := group.DefaultConfig()
@@ -332,7 +339,7 @@ func NewFuryApp(
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, oracle.NewParamChangeProposalHandler(app.OracleKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
 
@@ -351,6 +358,17 @@ func NewFuryApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	// the interchain queries verify their proofs against the IBC light clients
+	app.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(
+		appCodec, keys[interchainquerytypes.StoreKey], app.GetSubspace(interchainquerytypes.ModuleName), app.BankKeeper, app.IBCKeeper,
+	)
+
+	// the oracle reads the remote prices of denoms through interchain queries
+	app.OracleKeeper.SetInterchainQueryKeeper(&app.InterchainQueryKeeper)
+	if err := app.InterchainQueryKeeper.SetCallbackHandler(oracletypes.ModuleName, app.OracleKeeper.CallbackHandler()); err != nil {
+		panic(err)
+	}
+
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -400,12 +418,14 @@ func NewFuryApp(
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		evidencetypes.ModuleName, stakingtypes.ModuleName,
+		// oracle takes its share of the collected fees before mint adds the
+		// block provisions to them and distribution allocates them
+		upgradetypes.ModuleName, capabilitytypes.ModuleName, oracletypes.ModuleName, minttypes.ModuleName,
+		distrtypes.ModuleName, slashingtypes.ModuleName, evidencetypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName,
 		authz.ModuleName, group.ModuleName, feegrant.ModuleName, ibchost.ModuleName,
 		paramstypes.ModuleName, vestingtypes.ModuleName, halving.ModuleName,
-		interchainquerytypes.ModuleName, epochsTypes.ModuleName, oracletypes.ModuleName,
+		interchainquerytypes.ModuleName, epochsTypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
@@ -462,13 +482,16 @@ func NewFuryApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			OracleKeeper: &app.OracleKeeper,
 		},
 	)
 
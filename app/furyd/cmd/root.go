@@ -30,6 +30,8 @@ import (
 
 	"github.com/incubus-network/fanfury-sdk/v2/app"
 	"github.com/incubus-network/fanfury-sdk/v2/app/params"
+	icqcli "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/client/cli"
+	oraclecli "github.com/incubus-network/fanfury-sdk/v2/x/oracle/client/cli"
 )
 
 // NewRootCmd creates a new root command for furyd. It is called once in the
@@ -169,12 +171,14 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	server.AddCommands(rootCmd, furyapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
 
-	// add keybase, auxiliary RPC, query, and tx child commands
+	// add keybase, auxiliary RPC, query, tx, oracle feeder and icq relayer child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(furyapp.DefaultNodeHome),
+		oraclecli.GetOracleCmd(),
+		icqcli.GetICQCmd(),
 	)
 
 	// add rosetta
@@ -32,4 +32,10 @@ const (
 	// feegrant
 	DefaultWeightGrantAllowance  int = 100
 	DefaultWeightRevokeAllowance int = 100
+
+	// oracle
+	DefaultWeightMsgAggregateExchangeRatePrevote int = 100
+	DefaultWeightMsgAggregateExchangeRateVote    int = 100
+	DefaultWeightMsgDelegateFeedConsent          int = 10
+	DefaultWeightMsgAddFundsToRewardPool         int = 10
 )
@@ -27,6 +27,7 @@ import (
 	distrtypes "github.com/incubus-network/fanfury-sdk/v2/x/lsnative/distribution/types"
 	slashingtypes "github.com/incubus-network/fanfury-sdk/v2/x/lsnative/slashing/types"
 	stakingtypes "github.com/incubus-network/fanfury-sdk/v2/x/lsnative/staking/types"
+	oracletypes "github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -181,6 +182,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{}},
 		{app.keys[ibchost.StoreKey], newApp.keys[ibchost.StoreKey], [][]byte{}},
 		{app.keys[interchainquerytypes.StoreKey], newApp.keys[interchainquerytypes.StoreKey], [][]byte{}},
+		{app.keys[oracletypes.StoreKey], newApp.keys[oracletypes.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
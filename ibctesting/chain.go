@@ -55,6 +55,7 @@ type TestChain struct {
 	ChainID       string
 	LastHeader    *ibctmtypes.Header // header for last block height committed
 	CurrentHeader tmproto.Header     // header for current block height
+	LastEvents    []abci.Event       // events emitted by the EndBlock of the last block committed
 	QueryServer   types.QueryServer
 	TxConfig      client.TxConfig
 	Codec         codec.BinaryCodec
@@ -269,6 +270,7 @@ func (chain *TestChain) QueryConsensusStateProof(clientID string) ([]byte, clien
 // It calls BeginBlock with the new block created before returning.
 func (chain *TestChain) NextBlock() {
 	res := chain.App.EndBlock(abci.RequestEndBlock{Height: chain.CurrentHeader.Height})
+	chain.LastEvents = res.Events
 
 	chain.App.Commit()
 
@@ -446,6 +448,12 @@ func (chain *TestChain) CurrentTMClientHeader() *ibctmtypes.Header {
 // CreateTMClientHeader creates a TM header to update the TM client. Args are passed in to allow
 // caller flexibility to use params that differ from the chain.
 func (chain *TestChain) CreateTMClientHeader(chainID string, blockHeight int64, trustedHeight clienttypes.Height, timestamp time.Time, tmValSet, nextVals, tmTrustedVals *tmtypes.ValidatorSet, signers map[string]tmtypes.PrivValidator) *ibctmtypes.Header {
+	return chain.CreateTMClientHeaderWithTxs(chainID, blockHeight, trustedHeight, timestamp, tmValSet, nextVals, tmTrustedVals, signers, nil)
+}
+
+// CreateTMClientHeaderWithTxs creates a TM header like CreateTMClientHeader, whose block data
+// are the given transactions if any.
+func (chain *TestChain) CreateTMClientHeaderWithTxs(chainID string, blockHeight int64, trustedHeight clienttypes.Height, timestamp time.Time, tmValSet, nextVals, tmTrustedVals *tmtypes.ValidatorSet, signers map[string]tmtypes.PrivValidator, txs tmtypes.Txs) *ibctmtypes.Header {
 	var (
 		valSet      *tmproto.ValidatorSet
 		trustedVals *tmproto.ValidatorSet
@@ -455,6 +463,11 @@ func (chain *TestChain) CreateTMClientHeader(chainID string, blockHeight int64,
 	vsetHash := tmValSet.Hash()
 	nextValHash := nextVals.Hash()
 
+	dataHash := tmhash.Sum([]byte("data_hash"))
+	if len(txs) > 0 {
+		dataHash = txs.Hash()
+	}
+
 	tmHeader := tmtypes.Header{
 		Version:            tmprotoversion.Consensus{Block: tmversion.BlockProtocol, App: 2},
 		ChainID:            chainID,
@@ -462,7 +475,7 @@ func (chain *TestChain) CreateTMClientHeader(chainID string, blockHeight int64,
 		Time:               timestamp,
 		LastBlockID:        MakeBlockID(make([]byte, tmhash.Size), 10_000, make([]byte, tmhash.Size)),
 		LastCommitHash:     chain.App.LastCommitID().Hash,
-		DataHash:           tmhash.Sum([]byte("data_hash")),
+		DataHash:           dataHash,
 		ValidatorsHash:     vsetHash,
 		NextValidatorsHash: nextValHash,
 		ConsensusHash:      tmhash.Sum([]byte("consensus_hash")),
@@ -7,13 +7,18 @@ import (
 
 	ibcante "github.com/cosmos/ibc-go/v6/modules/core/ante"
 	"github.com/cosmos/ibc-go/v6/modules/core/keeper"
+
+	oracleante "github.com/incubus-network/fanfury-sdk/v2/x/oracle/ante"
+	oraclekeeper "github.com/incubus-network/fanfury-sdk/v2/x/oracle/keeper"
 )
 
-// HandlerOptions extend the SDK's AnteHandler options by requiring the IBC keeper.
+// HandlerOptions extend the SDK's AnteHandler options by requiring the IBC and
+// oracle keepers.
 type HandlerOptions struct {
 	ante.HandlerOptions
 
-	IBCKeeper *keeper.Keeper
+	IBCKeeper    *keeper.Keeper
+	OracleKeeper *oraclekeeper.Keeper
 }
 
 // NewAnteHandler creates a new ante handler
@@ -27,6 +32,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.SignModeHandler == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for AnteHandler")
 	}
+	if options.OracleKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "oracle keeper is required for AnteHandler")
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
@@ -35,6 +43,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		oracleante.NewOracleVoteDecorator(*options.OracleKeeper), // must be called before the DeductFeeDecorator
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -0,0 +1,56 @@
+package furyapp_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	ibctesting "github.com/incubus-network/fanfury-sdk/v2/ibctesting"
+	"github.com/incubus-network/fanfury-sdk/v2/ibctesting/furyapp/helpers"
+	oracleante "github.com/incubus-network/fanfury-sdk/v2/x/oracle/ante"
+	oracletypes "github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func TestOracleVoteFeeExemption(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	app := chain.GetFuryApp()
+
+	// the sender feeds the oracle votes of the first validator, on a node
+	// requiring fees
+	valAddr := sdk.ValAddress(chain.Vals.Validators[0].Address)
+	feederAddr := chain.SenderAccount.GetAddress()
+	app.OracleKeeper.SetFeeder(chain.GetContext(), oracletypes.NewFeeder(valAddr, feederAddr, 0, nil))
+
+	baseapp.SetMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1)).String())(app.BaseApp)
+	coord.CommitBlock(chain)
+
+	checkTx := func(rates string, sequence uint64) abci.ResponseCheckTx {
+		hash := oracletypes.GetAggregateVoteHash("salt", rates, valAddr)
+		msg := oracletypes.NewMsgAggregateExchangeRatePrevote(hash, feederAddr, valAddr)
+
+		tx, err := helpers.GenTx(chain.TxConfig, []sdk.Msg{msg}, sdk.Coins{}, helpers.DefaultGenTxGas, chain.ChainID,
+			[]uint64{chain.SenderAccount.GetAccountNumber()}, []uint64{sequence}, chain.SenderPrivKey)
+		require.NoError(t, err)
+
+		txBytes, err := chain.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		return app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+	}
+
+	// the first prevote of the vote period is free and of the highest priority
+	sequence := chain.SenderAccount.GetSequence()
+
+	res := checkTx("ATOM:12.5", sequence)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, oracleante.OracleTxPriority, res.Priority)
+
+	// its updates pay the fees
+	res = checkTx("ATOM:13.5", sequence+1)
+	require.Equal(t, sdkerrors.ErrInsufficientFee.ABCICode(), res.Code)
+}
@@ -1,15 +1,20 @@
 package utils
 
 import (
+	"bytes"
 	"fmt"
 	"net/url"
+	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
 	ibcKeeper "github.com/cosmos/ibc-go/v6/modules/core/keeper"
 	tmclienttypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	"github.com/tendermint/tendermint/light"
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func ValidateProofOps(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID string, chainID string, height int64, module string, key []byte, data []byte, proofOps *crypto.ProofOps) error {
@@ -58,3 +63,133 @@ func ValidateProofOps(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID
 
 	return nil
 }
+
+// ValidateHeader verifies the header of the chain against the consensus state
+// of its trusted height stored by the light client of the connection, the way
+// the light client verifies the headers updating it. The header may be of any
+// height above its trusted height, and must match the consensus state of its
+// own height if the light client stored one.
+func ValidateHeader(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID string, chainID string, header *tmclienttypes.Header) error {
+	if header == nil {
+		return fmt.Errorf("unable to validate header. No header submitted")
+	}
+
+	if err := header.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid header: %w", err)
+	}
+
+	if header.Header.ChainID != chainID {
+		return fmt.Errorf("header of chain %s, expected %s", header.Header.ChainID, chainID)
+	}
+
+	connection, found := ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return fmt.Errorf("unable to fetch connection %s", connectionID)
+	}
+
+	clientState, found := ibcKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return fmt.Errorf("unable to fetch client state")
+	}
+
+	tmClientState, ok := clientState.(*tmclienttypes.ClientState)
+	if !ok {
+		return fmt.Errorf("error unmarshaling client state")
+	}
+
+	if !tmClientState.FrozenHeight.IsZero() {
+		return fmt.Errorf("client %s is frozen", connection.ClientId)
+	}
+
+	if tmClientState.ChainId != chainID {
+		return fmt.Errorf("client of chain %s, expected %s", tmClientState.ChainId, chainID)
+	}
+
+	// a header of a height known to the light client must not fork from it
+	if consensusState, found := ibcKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, header.GetHeight()); found {
+		if !reflect.DeepEqual(consensusState, header.ConsensusState()) {
+			return fmt.Errorf("header does not match the consensus state of height %s", header.GetHeight())
+		}
+	}
+
+	trustedState, found := ibcKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, header.TrustedHeight)
+	if !found {
+		return fmt.Errorf("unable to fetch consensus state of trusted height %s", header.TrustedHeight)
+	}
+
+	trustedConsensusState, ok := trustedState.(*tmclienttypes.ConsensusState)
+	if !ok {
+		return fmt.Errorf("error unmarshaling consensus state")
+	}
+
+	if header.GetHeight().GetRevisionNumber() != header.TrustedHeight.RevisionNumber {
+		return fmt.Errorf("header revision %d does not match trusted revision %d",
+			header.GetHeight().GetRevisionNumber(), header.TrustedHeight.RevisionNumber)
+	}
+
+	if header.GetHeight().LTE(header.TrustedHeight) {
+		return fmt.Errorf("header height %s not above trusted height %s", header.GetHeight(), header.TrustedHeight)
+	}
+
+	trustedVals, err := tmtypes.ValidatorSetFromProto(header.TrustedValidators)
+	if err != nil {
+		return fmt.Errorf("invalid trusted validator set: %w", err)
+	}
+
+	if !bytes.Equal(trustedConsensusState.NextValidatorsHash, trustedVals.Hash()) {
+		return fmt.Errorf("trusted validators do not hash to the next validators of the trusted height")
+	}
+
+	signedHeader, err := tmtypes.SignedHeaderFromProto(header.SignedHeader)
+	if err != nil {
+		return fmt.Errorf("invalid signed header: %w", err)
+	}
+
+	vals, err := tmtypes.ValidatorSetFromProto(header.ValidatorSet)
+	if err != nil {
+		return fmt.Errorf("invalid validator set: %w", err)
+	}
+
+	// only the height, time and next validators of the trusted header are
+	// needed for the verification
+	trustedHeader := tmtypes.SignedHeader{
+		Header: &tmtypes.Header{
+			ChainID:            chainID,
+			Height:             int64(header.TrustedHeight.RevisionHeight),
+			Time:               trustedConsensusState.Timestamp,
+			NextValidatorsHash: trustedConsensusState.NextValidatorsHash,
+		},
+	}
+
+	if err := light.Verify(
+		&trustedHeader, trustedVals, signedHeader, vals,
+		tmClientState.TrustingPeriod, ctx.BlockTime(), tmClientState.MaxClockDrift, tmClientState.TrustLevel.ToTendermint(),
+	); err != nil {
+		return fmt.Errorf("unable to verify header: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateTxProof verifies the inclusion proof of a transaction in the block
+// of the header, after verifying the header through ValidateHeader.
+func ValidateTxProof(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID string, chainID string, header *tmclienttypes.Header, proof *tmproto.TxProof) error {
+	if proof == nil {
+		return fmt.Errorf("unable to validate tx proof. No proof submitted")
+	}
+
+	if err := ValidateHeader(ctx, ibcKeeper, connectionID, chainID, header); err != nil {
+		return err
+	}
+
+	txProof, err := tmtypes.TxProofFromProto(*proof)
+	if err != nil {
+		return fmt.Errorf("invalid tx proof: %w", err)
+	}
+
+	if err := txProof.Validate(header.Header.DataHash); err != nil {
+		return fmt.Errorf("unable to verify tx proof: %w", err)
+	}
+
+	return nil
+}
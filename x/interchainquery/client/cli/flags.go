@@ -0,0 +1,23 @@
+package cli
+
+const (
+	FlagConnectionID = "connection-id"
+	FlagModule       = "module"
+	FlagQueryType    = "query-type"
+	FlagOwner        = "owner"
+
+	FlagHeight    = "height"
+	FlagProofFile = "proof-file"
+
+	FlagTimeout   = "timeout"
+	FlagFee       = "fee"
+	FlagFeeEscrow = "fee-escrow"
+)
+
+const (
+	FlagRemoteRPC     = "remote-rpc"
+	FlagRemoteChainID = "remote-chain-id"
+	FlagBatchSize     = "batch-size"
+	FlagRetryInterval = "retry-interval"
+	FlagMaxRetries    = "max-retries"
+)
@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+// GetQueryCmd returns the CLI query commands for the x/interchainquery module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryQuery(),
+		GetCmdQueryAllQueries(),
+		GetCmdQueryDatapoint(),
+		GetCmdQueryDatapoints(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current interchainquery params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryQuery implements the query of an interchain query by its ID
+// command.
+func GetCmdQueryQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query an interchain query by its ID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			res, err := queryClient.Query(context.Background(), &types.QueryQueryRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllQueries implements the query all interchain queries command.
+func GetCmdQueryAllQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queries",
+		Args:  cobra.NoArgs,
+		Short: "Query all the interchain queries, optionally filtered",
+		Example: fmt.Sprintf(
+			"$ %s query interchainquery queries --connection-id connection-0 --module oracle",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllQueriesRequest{Pagination: pageReq}
+
+			if req.ConnectionId, err = cmd.Flags().GetString(FlagConnectionID); err != nil {
+				return err
+			}
+
+			if req.Module, err = cmd.Flags().GetString(FlagModule); err != nil {
+				return err
+			}
+
+			if req.QueryType, err = cmd.Flags().GetString(FlagQueryType); err != nil {
+				return err
+			}
+
+			if req.Owner, err = cmd.Flags().GetString(FlagOwner); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			res, err := queryClient.AllQueries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagConnectionID, "", "Only return the queries of the connection")
+	cmd.Flags().String(FlagModule, "", "Only return the queries registered by the module")
+	cmd.Flags().String(FlagQueryType, "", "Only return the queries of the query type")
+	cmd.Flags().String(FlagOwner, "", "Only return the queries registered by the account")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queries")
+
+	return cmd
+}
+
+// GetCmdQueryDatapoint implements the query datapoint of an interchain query
+// command.
+func GetCmdQueryDatapoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "datapoint [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the stored result of an interchain query by its ID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			res, err := queryClient.Datapoint(context.Background(), &types.QueryDatapointRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDatapoints implements the query all datapoints command.
+func GetCmdQueryDatapoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "datapoints",
+		Args:  cobra.NoArgs,
+		Short: "Query the stored results of all the interchain queries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			res, err := queryClient.Datapoints(context.Background(), &types.QueryDatapointsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "datapoints")
+
+	return cmd
+}
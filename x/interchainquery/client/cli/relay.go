@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/relayer"
+)
+
+// GetICQCmd returns the off-chain commands of the x/interchainquery module.
+func GetICQCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "icq",
+		Short:                      "Off-chain commands for interchain queries",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdRelay(),
+	)
+
+	return cmd
+}
+
+// GetCmdRelay returns a CLI command handler running the interchain query
+// relayer of a queried chain.
+func GetCmdRelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay",
+		Args:  cobra.NoArgs,
+		Short: "Run a relayer answering the interchain queries of a remote chain",
+		Long: `Run a relayer answering the interchain queries of a remote chain.
+
+The relayer subscribes to the new blocks of the --node, runs the queries emitted
+for --remote-chain-id against the --remote-rpc node with proofs, and submits the
+responses in batches signed with the --from key. The queries are run at the
+latest height the IBC light client of their connection can verify, so the client
+must be kept up to date by an IBC relayer.`,
+		Example: fmt.Sprintf(
+			"$ %s icq relay --from relayer --remote-rpc tcp://localhost:36657 --remote-chain-id cosmoshub-4",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			remoteRPC, err := cmd.Flags().GetString(FlagRemoteRPC)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := cmd.Flags().GetString(FlagRemoteChainID)
+			if err != nil {
+				return err
+			}
+
+			batchSize, err := cmd.Flags().GetInt(FlagBatchSize)
+			if err != nil {
+				return err
+			}
+
+			backoff := relayer.DefaultBackoff()
+
+			if backoff.Interval, err = cmd.Flags().GetDuration(FlagRetryInterval); err != nil {
+				return err
+			}
+
+			if backoff.MaxRetries, err = cmd.Flags().GetInt(FlagMaxRetries); err != nil {
+				return err
+			}
+
+			remote, err := rpchttp.New(remoteRPC, "/websocket")
+			if err != nil {
+				return err
+			}
+
+			// the events are subscribed to over a websocket of their own
+			events, err := rpchttp.New(clientCtx.NodeURI, "/websocket")
+			if err != nil {
+				return err
+			}
+
+			if err := events.Start(); err != nil {
+				return err
+			}
+
+			defer func() {
+				_ = events.Stop()
+			}()
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "icq-relayer")
+
+			return relayer.NewRelayer(
+				relayer.NewTxHost(clientCtx, txf),
+				remote,
+				chainID,
+				batchSize,
+				backoff,
+				logger,
+			).Start(cmd.Context(), events)
+		},
+	}
+
+	cmd.Flags().String(FlagRemoteRPC, "", "Tendermint RPC endpoint of a node of the remote chain")
+	cmd.Flags().String(FlagRemoteChainID, "", "Chain ID of the remote chain whose queries are answered")
+	cmd.Flags().Int(FlagBatchSize, 10, "Maximum number of responses submitted in a transaction")
+	cmd.Flags().Duration(FlagRetryInterval, time.Second, "Delay before retrying a failed query or transaction, doubled on every retry")
+	cmd.Flags().Int(FlagMaxRetries, 5, "Number of retries of a failed query or transaction")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagRemoteRPC)
+	_ = cmd.MarkFlagRequired(FlagRemoteChainID)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,268 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+// GetTxCmd returns the CLI transaction commands for the x/interchainquery module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Transaction commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdSubmitQueryResponse(),
+		GetCmdRegisterInterchainQuery(),
+		GetCmdRemoveInterchainQuery(),
+		GetCmdRequeryInterchainQuery(),
+	)
+
+	return cmd
+}
+
+// GetCmdSubmitQueryResponse returns a CLI command handler to generate or
+// broadcast a transaction with a MsgSubmitQueryResponse message.
+func GetCmdSubmitQueryResponse() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-response [chain-id] [query-id] [result]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit the hex encoded result of an interchain query",
+		Long: strings.TrimSpace(`Submit the hex encoded result of an interchain query, as relayed manually from
+the queried chain. --height is the remote height the result was queried at, and
+--proof-file a JSON file of the tendermint.crypto.ProofOps proving the result,
+required by the queries of a store key.`),
+		Example: fmt.Sprintf(
+			"$ %s tx interchainquery submit-response cosmoshub-4 3c5b... 0a2d... --height 1000 --proof-file proof.json --from mykey",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			result, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid result: %w", err)
+			}
+
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitQueryResponse{
+				ChainId:     args[0],
+				QueryId:     args[1],
+				Result:      result,
+				Height:      height,
+				FromAddress: clientCtx.GetFromAddress().String(),
+			}
+
+			proofFile, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return err
+			}
+
+			if proofFile != "" {
+				bz, err := os.ReadFile(proofFile)
+				if err != nil {
+					return err
+				}
+
+				var proofOps crypto.ProofOps
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &proofOps); err != nil {
+					return fmt.Errorf("invalid proof: %w", err)
+				}
+
+				msg.ProofOps = &proofOps
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Remote height the result was queried at")
+	cmd.Flags().String(FlagProofFile, "", "JSON file of the proof of the result")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRegisterInterchainQuery returns a CLI command handler to generate or
+// broadcast a transaction with a MsgRegisterInterchainQuery message.
+func GetCmdRegisterInterchainQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-query [connection-id] [chain-id] [query-type] [request] [period] [ttl]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Register an interchain query whose results are delivered through events and datapoints",
+		Long: strings.TrimSpace(`Register an interchain query whose results are delivered through events and
+datapoints, escrowing the query deposit. The request is hex encoded, the period is
+the number of blocks between two emissions of the query or -1 to emit it once, and
+the ttl the number of blocks its results are stored.
+
+With --timeout, an emission of the query without response after the given number of
+blocks is emitted again up to --max-retries times, the timeout doubling with each
+retry, before the query times out.
+
+With --fee, the first valid response of each emission of the query is paid the fee
+from the --fee-escrow escrowed along the query. The outstanding fees are refunded
+once the query is removed, answered for the last time or timed out.`),
+		Example: fmt.Sprintf(
+			"$ %s tx interchainquery register-query connection-0 cosmoshub-4 store/bank/key 0214... 100 1000 --from mykey",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			request, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid request: %w", err)
+			}
+
+			period, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid period: %w", err)
+			}
+
+			ttl, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid ttl: %w", err)
+			}
+
+			timeout, err := cmd.Flags().GetUint64(FlagTimeout)
+			if err != nil {
+				return err
+			}
+
+			maxRetries, err := cmd.Flags().GetUint64(FlagMaxRetries)
+			if err != nil {
+				return err
+			}
+
+			fee, err := parseCoinsFlag(cmd, FlagFee)
+			if err != nil {
+				return err
+			}
+
+			feeEscrow, err := parseCoinsFlag(cmd, FlagFeeEscrow)
+			if err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterInterchainQuery(
+				clientCtx.GetFromAddress(), args[0], args[1], args[2], request, period, ttl, timeout, maxRetries, fee, feeEscrow, height,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagTimeout, 0, "Number of blocks without response before the query is retried, 0 to never time out")
+	cmd.Flags().Uint64(FlagMaxRetries, 0, "Number of retries of the query before it times out")
+	cmd.Flags().String(FlagFee, "", "Fee paid to the first valid response of each emission of the query")
+	cmd.Flags().String(FlagFeeEscrow, "", "Fees escrowed to pay the responses of the query")
+	cmd.Flags().Int64(FlagHeight, 0, "Remote height to run the query at, 0 for the latest height")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRemoveInterchainQuery returns a CLI command handler to generate or
+// broadcast a transaction with a MsgRemoveInterchainQuery message.
+func GetCmdRemoveInterchainQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-query [query-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove an interchain query registered by the account and refund its deposit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveInterchainQuery(clientCtx.GetFromAddress(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRequeryInterchainQuery returns a CLI command handler to generate or
+// broadcast a transaction with a MsgRequeryInterchainQuery message.
+func GetCmdRequeryInterchainQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requery [query-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Emit again an interchain query registered by the account, adding to its fee escrow",
+		Example: fmt.Sprintf(
+			"$ %s tx interchainquery requery 5c2f... --fee-escrow 1000stake --from mykey",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeEscrow, err := parseCoinsFlag(cmd, FlagFeeEscrow)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequeryInterchainQuery(clientCtx.GetFromAddress(), args[0], feeEscrow)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFeeEscrow, "", "Fees added to the fee escrow of the query")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseCoinsFlag returns the coins of the flag, nil if it is not set.
+func parseCoinsFlag(cmd *cobra.Command, flag string) (sdk.Coins, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+
+	return coins, nil
+}
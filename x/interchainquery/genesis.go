@@ -15,11 +15,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		// Initialize empty epoch values via Cosmos SDK
 		k.SetQuery(ctx, query)
 	}
+
+	k.SetParams(ctx, genState.Params)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Queries: k.AllQueries(ctx),
+		Params:  k.GetParams(ctx),
 	}
 }
@@ -67,7 +67,7 @@ func (suite *InterChainQueryTestSuite) TestInitGenesis() {
 		0,
 	)
 
-	interchainquery.InitGenesis(suite.chainA.GetContext(), suite.GetFuryApp(suite.chainA).InterchainQueryKeeper, types.GenesisState{Queries: []types.Query{*query}})
+	interchainquery.InitGenesis(suite.chainA.GetContext(), suite.GetFuryApp(suite.chainA).InterchainQueryKeeper, types.GenesisState{Queries: []types.Query{*query}, Params: types.DefaultParams()})
 
 	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "")
 	queryResponse, found := suite.GetFuryApp(suite.chainA).InterchainQueryKeeper.GetQuery(suite.chainA.GetContext(), id)
@@ -78,6 +78,9 @@ func (suite *InterChainQueryTestSuite) TestInitGenesis() {
 	suite.Equal(sdk.NewInt(200), queryResponse.Period)
 	suite.Equal(uint64(0), queryResponse.Ttl)
 	suite.Equal("", queryResponse.CallbackId)
+
+	genesis := interchainquery.ExportGenesis(suite.chainA.GetContext(), suite.GetFuryApp(suite.chainA).InterchainQueryKeeper)
+	suite.Equal(types.DefaultParams(), genesis.Params)
 }
 
 func newFuryAppPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
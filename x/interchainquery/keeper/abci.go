@@ -2,16 +2,29 @@ package keeper
 
 import (
 	"encoding/hex"
+	"fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/incubus-network/fanfury-sdk/v2/utils"
 	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
 )
 
 const (
+	// RetryInterval is the timeout, in blocks, of the queries emitted once by
+	// modules through MakeRequest.
 	RetryInterval = 25
+
+	// MaxRetries is the number of retries of the queries emitted once by
+	// modules through MakeRequest.
+	MaxRetries = 3
+
+	// TimedOutRetention is the number of blocks a timed out query is kept to
+	// be re-requested before it is deleted and its deposit refunded.
+	TimedOutRetention = 10_000
 )
 
 // EndBlocker of interchainquery module
@@ -20,28 +33,65 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 
 	_ = k.Logger(ctx)
 	events := sdk.Events{}
-	// emit events for periodic queries
+	height := sdk.NewInt(ctx.BlockHeight())
+
+	var timedOut, expired []types.Query
+
+	// emit events for periodic queries, retry or time out the emissions
+	// without response, and collect the queries timed out for too long
 	k.IterateQueries(ctx, func(_ int64, queryInfo types.Query) (stop bool) {
-		if queryInfo.LastEmission.IsNil() || queryInfo.LastEmission.IsZero() || queryInfo.LastEmission.Add(queryInfo.Period).Equal(sdk.NewInt(ctx.BlockHeight())) {
-			k.Logger(ctx).Info("Interchainquery event emitted", "id", queryInfo.Id)
-			event := sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
-				sdk.NewAttribute(types.AttributeKeyQueryID, queryInfo.Id),
-				sdk.NewAttribute(types.AttributeKeyChainID, queryInfo.ChainId),
-				sdk.NewAttribute(types.AttributeKeyConnectionID, queryInfo.ConnectionId),
-				sdk.NewAttribute(types.AttributeKeyType, queryInfo.QueryType),
-				// TODO: add height to request type
-				sdk.NewAttribute(types.AttributeKeyHeight, "0"),
-				sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(queryInfo.Request)),
-			)
-
-			events = append(events, event)
-			queryInfo.LastEmission = sdk.NewInt(ctx.BlockHeight())
-			k.SetQuery(ctx, queryInfo)
+		switch {
+		case queryInfo.TimedOut:
+			// the pending emission of a timed out query is its last one
+			if height.GTE(timeoutHeight(queryInfo).AddRaw(TimedOutRetention)) {
+				expired = append(expired, queryInfo)
+			}
+
+			return false
+
+		case queryInfo.Timeout > 0 && isPending(queryInfo):
+			// a pending emission is retried instead of emitting the query
+			// again on its period
+			if height.LT(timeoutHeight(queryInfo)) {
+				return false
+			}
+
+			if queryInfo.Retries >= queryInfo.MaxRetries {
+				timedOut = append(timedOut, queryInfo)
+				return false
+			}
+
+			queryInfo.Retries++
+
+		case queryInfo.LastEmission.IsNil() || queryInfo.LastEmission.IsZero() || queryInfo.LastEmission.Add(queryInfo.Period).Equal(height):
 
+		// a periodic query whose emission was pending past its period is
+		// emitted once answered
+		case queryInfo.Timeout > 0 && queryInfo.Period.IsPositive() && queryInfo.LastEmission.Add(queryInfo.Period).LT(height):
+
+		default:
+			return false
 		}
+
+		k.Logger(ctx).Info("Interchainquery event emitted", "id", queryInfo.Id, "retries", queryInfo.Retries)
+		event := sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
+			sdk.NewAttribute(types.AttributeKeyQueryID, queryInfo.Id),
+			sdk.NewAttribute(types.AttributeKeyChainID, queryInfo.ChainId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, queryInfo.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyType, queryInfo.QueryType),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(queryInfo.Height)),
+			sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(queryInfo.Request)),
+			sdk.NewAttribute(types.AttributeKeyRetries, fmt.Sprint(queryInfo.Retries)),
+			sdk.NewAttribute(types.AttributeKeyFee, payableFee(queryInfo).String()),
+		)
+
+		events = append(events, event)
+		queryInfo.LastEmission = height
+		k.SetQuery(ctx, queryInfo)
+
 		return false
 	})
 
@@ -49,16 +99,76 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		ctx.EventManager().EmitEvents(events)
 	}
 
+	for _, query := range timedOut {
+		k.timeoutQuery(ctx, query)
+	}
+
+	for _, query := range expired {
+		k.Logger(ctx).Info("Deleting expired timed out interchainquery", "id", query.Id)
+
+		if err := k.deleteOwnedQuery(ctx, query); err != nil {
+			k.Logger(ctx).Error("failed to delete the timed out query", "id", query.Id, "err", err)
+		}
+	}
+
 	k.IterateDatapoints(ctx, func(_ int64, dp types.DataPoint) bool {
 		q, found := k.GetQuery(ctx, dp.Id)
 		if !found {
 			// query was removed; delete datapoint
 			k.DeleteDatapoint(ctx, dp.Id)
-		} else if dp.LocalHeight.Int64()+int64(q.Ttl) > ctx.BlockHeader().Height {
-			// gc old data
+		} else if dp.LocalHeight.Int64()+int64(q.Ttl) < ctx.BlockHeader().Height {
+			// gc data older than the ttl of the query
 			k.DeleteDatapoint(ctx, dp.Id)
 		}
 
 		return false
 	})
 }
+
+// timeoutQuery sets the query in the terminal timed out state, refunds its
+// outstanding fees, and notifies the module of the query if its callbacks
+// implement QueryTimeoutCallbacks. The state changes of a failing
+// notification are discarded.
+func (k Keeper) timeoutQuery(ctx sdk.Context, query types.Query) {
+	k.Logger(ctx).Info("Interchainquery timed out", "id", query.Id, "retries", query.Retries)
+
+	query.TimedOut = true
+	if err := k.refundFees(ctx, &query); err != nil {
+		k.Logger(ctx).Error("failed to refund the fees of the timed out query", "id", query.Id, "err", err)
+	}
+	k.SetQuery(ctx, query)
+
+	if handler, ok := k.callbacks[query.Module].(types.QueryTimeoutCallbacks); ok && query.Module != "" {
+		_ = utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return handler.OnTimeout(ctx, query)
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueryTimeout,
+			sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, query.Owner),
+			sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRetries, fmt.Sprint(query.Retries)),
+		),
+	)
+}
+
+// isPending returns true if the last emission of the query was not answered.
+func isPending(query types.Query) bool {
+	if query.LastEmission.IsNil() || query.LastEmission.IsZero() {
+		return false
+	}
+
+	// a response delivered in the block of the emission answers the previous
+	// emission, as the queries are emitted at the end of the block
+	return query.LastHeight.IsNil() || query.LastHeight.LTE(query.LastEmission)
+}
+
+// timeoutHeight returns the height at which the pending emission of the query
+// times out, the timeout doubling with each retry.
+func timeoutHeight(query types.Query) sdkmath.Int {
+	timeout := sdkmath.NewIntFromUint64(query.Timeout).Mul(sdkmath.NewIntFromUint64(1 << query.Retries))
+	return query.LastEmission.Add(timeout)
+}
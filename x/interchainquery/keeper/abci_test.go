@@ -6,6 +6,7 @@ import (
 
 	"github.com/incubus-network/fanfury-sdk/v2/ibctesting"
 	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/keeper"
+	icqtypes "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
 )
 
 func (suite *KeeperTestSuite) TestEndBlocker() {
@@ -57,3 +58,203 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 	// call end blocker
 	suite.GetFuryApp(suite.chainA).InterchainQueryKeeper.EndBlocker(suite.chainA.GetContext())
 }
+
+func (suite *KeeperTestSuite) TestDatapointExpiry() {
+	app := suite.GetFuryApp(suite.chainA)
+
+	bz, err := (&stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}).Marshal()
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	query := app.InterchainQueryKeeper.NewQuery(
+		ctx, "", suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(100), "", 10,
+	)
+	app.InterchainQueryKeeper.SetQuery(ctx, *query)
+
+	err = app.InterchainQueryKeeper.SetDatapointForID(ctx, query.Id, []byte{0x01}, sdk.NewInt(suite.chainB.CurrentHeader.Height))
+	suite.Require().NoError(err)
+
+	// the datapoint is kept for the ttl of the query
+	start := ctx.BlockHeight()
+	for _, blocks := range []int64{0, 1, 10} {
+		app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(start + blocks))
+
+		_, err = app.InterchainQueryKeeper.GetDatapointForID(ctx, query.Id)
+		suite.Require().NoError(err)
+	}
+
+	// and deleted once older
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(start + 11))
+
+	_, err = app.InterchainQueryKeeper.GetDatapointForID(ctx, query.Id)
+	suite.Require().Error(err)
+}
+
+// timeoutCallbacks records the queries timing out.
+type timeoutCallbacks struct {
+	timedOut []string
+}
+
+var _ icqtypes.QueryTimeoutCallbacks = &timeoutCallbacks{}
+
+func (c *timeoutCallbacks) AddCallback(string, interface{}) icqtypes.QueryCallbacks { return c }
+
+func (c *timeoutCallbacks) RegisterCallbacks() icqtypes.QueryCallbacks { return c }
+
+func (c *timeoutCallbacks) Call(sdk.Context, string, []byte, icqtypes.Query) error { return nil }
+
+func (c *timeoutCallbacks) Has(id string) bool { return id == "timeout" }
+
+func (c *timeoutCallbacks) OnTimeout(_ sdk.Context, query icqtypes.Query) error {
+	c.timedOut = append(c.timedOut, query.Id)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestQueryTimeout() {
+	app := suite.GetFuryApp(suite.chainA)
+	callbacks := &timeoutCallbacks{}
+	suite.Require().NoError(app.InterchainQueryKeeper.SetCallbackHandler("icqtest", callbacks))
+
+	bz, err := (&stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}).Marshal()
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	app.InterchainQueryKeeper.MakeRequest(
+		ctx, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz,
+		sdk.NewInt(-1), "icqtest", "timeout", 10,
+	)
+	id := keeper.GenerateQueryHash(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "icqtest")
+
+	// endBlock runs the end blocker at the height past the first emission,
+	// returning whether the query was emitted and whether it timed out
+	start := ctx.BlockHeight()
+	endBlock := func(blocks int64) (emitted, timedOut bool) {
+		ctx := ctx.WithBlockHeight(start + blocks).WithEventManager(sdk.NewEventManager())
+		app.InterchainQueryKeeper.EndBlocker(ctx)
+
+		for _, event := range ctx.EventManager().Events() {
+			switch event.Type {
+			case sdk.EventTypeMessage:
+				emitted = true
+			case icqtypes.EventTypeQueryTimeout:
+				timedOut = true
+			}
+		}
+
+		return emitted, timedOut
+	}
+
+	query, found := app.InterchainQueryKeeper.GetQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(keeper.RetryInterval), query.Timeout)
+	suite.Require().Equal(uint64(keeper.MaxRetries), query.MaxRetries)
+
+	emitted, _ := endBlock(0)
+	suite.Require().True(emitted)
+
+	// the timeout doubles with each retry
+	height := int64(0)
+	for i, timeout := range []int64{keeper.RetryInterval, 2 * keeper.RetryInterval, 4 * keeper.RetryInterval} {
+		emitted, _ := endBlock(height + timeout - 1)
+		suite.Require().False(emitted)
+
+		height += timeout
+		emitted, timedOut := endBlock(height)
+		suite.Require().True(emitted)
+		suite.Require().False(timedOut)
+
+		query, _ = app.InterchainQueryKeeper.GetQuery(ctx, id)
+		suite.Require().Equal(uint64(i+1), query.Retries)
+	}
+
+	suite.Require().Empty(callbacks.timedOut)
+
+	// the query times out once its retries are exhausted
+	height += 8 * keeper.RetryInterval
+	emitted, timedOut := endBlock(height - 1)
+	suite.Require().False(emitted)
+	suite.Require().False(timedOut)
+
+	emitted, timedOut = endBlock(height)
+	suite.Require().False(emitted)
+	suite.Require().True(timedOut)
+	suite.Require().Equal([]string{id}, callbacks.timedOut)
+
+	query, _ = app.InterchainQueryKeeper.GetQuery(ctx, id)
+	suite.Require().True(query.TimedOut)
+
+	emitted, timedOut = endBlock(height + 1000)
+	suite.Require().False(emitted)
+	suite.Require().False(timedOut)
+
+	// its responses are ignored
+	_, err = keeper.NewMsgServerImpl(app.InterchainQueryKeeper).SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     id,
+		Result:      []byte{0x01},
+		Height:      suite.chainB.CurrentHeader.Height,
+		FromAddress: TestOwnerAddress,
+	})
+	suite.Require().NoError(err)
+
+	_, err = app.InterchainQueryKeeper.GetDatapointForID(ctx, id)
+	suite.Require().Error(err)
+
+	// a re-request emits it again
+	app.InterchainQueryKeeper.MakeRequest(
+		ctx, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz,
+		sdk.NewInt(-1), "icqtest", "timeout", 10,
+	)
+
+	emitted, _ = endBlock(height + 1001)
+	suite.Require().True(emitted)
+
+	query, _ = app.InterchainQueryKeeper.GetQuery(ctx, id)
+	suite.Require().False(query.TimedOut)
+	suite.Require().Zero(query.Retries)
+}
+
+func (suite *KeeperTestSuite) TestAnsweredQueryNotRetried() {
+	app := suite.GetFuryApp(suite.chainA)
+
+	bz, err := (&stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}).Marshal()
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	query := app.InterchainQueryKeeper.NewQuery(
+		ctx, "", suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(100), "", 10,
+	)
+	query.Timeout = 10
+	query.MaxRetries = 1
+	app.InterchainQueryKeeper.SetQuery(ctx, *query)
+
+	start := ctx.BlockHeight()
+	app.InterchainQueryKeeper.EndBlocker(ctx)
+
+	// the emission is retried once
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(start + 10))
+	retried, _ := app.InterchainQueryKeeper.GetQuery(ctx, query.Id)
+	suite.Require().Equal(uint64(1), retried.Retries)
+	suite.Require().Equal(start+10, retried.LastEmission.Int64())
+
+	// and answered before timing out
+	_, err = keeper.NewMsgServerImpl(app.InterchainQueryKeeper).SubmitQueryResponse(
+		sdk.WrapSDKContext(ctx.WithBlockHeight(start+15)),
+		&icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     query.Id,
+			Result:      []byte{0x01},
+			Height:      suite.chainB.CurrentHeader.Height,
+			FromAddress: TestOwnerAddress,
+		},
+	)
+	suite.Require().NoError(err)
+
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(start + 1000))
+	answered, _ := app.InterchainQueryKeeper.GetQuery(ctx, query.Id)
+	suite.Require().False(answered.TimedOut)
+	suite.Require().Zero(answered.Retries)
+
+	// the periodic emission missed while pending resumes
+	suite.Require().Equal(start+1000, answered.LastEmission.Int64())
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+// escrowFees escrows the fees from the owner of the query into its fee escrow.
+func (k Keeper) escrowFees(ctx sdk.Context, owner sdk.AccAddress, query *types.Query, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	query.FeeEscrow = query.FeeEscrow.Add(fees...)
+
+	return nil
+}
+
+// validateFees returns an error if fees are escrowed for a query whose
+// responses are not proven, as any relayer could be paid for made-up results.
+func validateFees(queryType string, fee sdk.Coins, feeEscrow sdk.Coins) error {
+	if (!fee.IsZero() || !feeEscrow.IsZero()) && !types.IsProvenQueryType(queryType) {
+		return errors.Wrapf(types.ErrInvalidQuery, "fees are only paid to proven responses, %s responses are not proven", queryType)
+	}
+
+	return nil
+}
+
+// payableFee returns the fee paid to the first valid response of the pending
+// emission of the query, nil if its fee escrow does not cover it or its
+// responses are not proven.
+func payableFee(query types.Query) sdk.Coins {
+	if query.Fee.IsZero() || !query.FeeEscrow.IsAllGTE(query.Fee) || !types.IsProvenQueryType(query.QueryType) {
+		return nil
+	}
+
+	return query.Fee
+}
+
+// payFee pays the fee of the query from its fee escrow to the relayer, and
+// returns the fee paid.
+func (k Keeper) payFee(ctx sdk.Context, query *types.Query, relayer sdk.AccAddress) (sdk.Coins, error) {
+	fee := payableFee(*query)
+	if fee.IsZero() {
+		return nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, fee); err != nil {
+		return nil, err
+	}
+
+	query.FeeEscrow = query.FeeEscrow.Sub(fee...)
+
+	return fee, nil
+}
+
+// refundFees refunds the outstanding fees of the query to its owner.
+func (k Keeper) refundFees(ctx sdk.Context, query *types.Query) error {
+	if query.FeeEscrow.IsZero() {
+		return nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(query.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, query.FeeEscrow); err != nil {
+		return err
+	}
+
+	query.FeeEscrow = nil
+
+	return nil
+}
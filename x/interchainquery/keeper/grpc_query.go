@@ -12,10 +12,21 @@ import (
 	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
 )
 
-var _ types.QuerySrvrServer = Keeper{}
+var _ types.QuerySrvrServer = querier{}
+
+// querier implements a QuerySrvrServer for the x/interchainquery module.
+type querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the interchainquery QuerySrvrServer
+// interface for the provided Keeper.
+func NewQuerier(keeper Keeper) types.QuerySrvrServer {
+	return &querier{Keeper: keeper}
+}
 
 // Queries returns information about registered zones.
-func (k Keeper) Queries(c context.Context, req *types.QueryRequestsRequest) (*types.QueryRequestsResponse, error) {
+func (q querier) Queries(c context.Context, req *types.QueryRequestsRequest) (*types.QueryRequestsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -24,11 +35,11 @@ func (k Keeper) Queries(c context.Context, req *types.QueryRequestsRequest) (*ty
 
 	var queries []types.Query
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixQuery)
 
 	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var query types.Query
-		if err := k.cdc.Unmarshal(value, &query); err != nil {
+		if err := q.cdc.Unmarshal(value, &query); err != nil {
 			return false, err
 		}
 
@@ -48,3 +59,119 @@ func (k Keeper) Queries(c context.Context, req *types.QueryRequestsRequest) (*ty
 		Pagination: pageRes,
 	}, nil
 }
+
+// Query returns a query by its ID.
+func (q querier) Query(c context.Context, req *types.QueryQueryRequest) (*types.QueryQueryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	query, found := q.GetQuery(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "query %s not found", req.Id)
+	}
+
+	return &types.QueryQueryResponse{Query: query}, nil
+}
+
+// AllQueries returns all the queries matching the filters of the request.
+func (q querier) AllQueries(c context.Context, req *types.QueryAllQueriesRequest) (*types.QueryAllQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var queries []types.Query
+
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixQuery)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var query types.Query
+		if err := q.cdc.Unmarshal(value, &query); err != nil {
+			return false, err
+		}
+
+		if (req.ConnectionId != "" && query.ConnectionId != req.ConnectionId) ||
+			(req.Module != "" && query.Module != req.Module) ||
+			(req.QueryType != "" && query.QueryType != req.QueryType) ||
+			(req.Owner != "" && query.Owner != req.Owner) {
+			return false, nil
+		}
+
+		if accumulate {
+			queries = append(queries, query)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllQueriesResponse{
+		Queries:    queries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Datapoint returns the datapoint of a query by its ID.
+func (q querier) Datapoint(c context.Context, req *types.QueryDatapointRequest) (*types.QueryDatapointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	datapoint, err := q.GetDatapointForID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDatapointResponse{Datapoint: datapoint}, nil
+}
+
+// Datapoints returns all the datapoints.
+func (q querier) Datapoints(c context.Context, req *types.QueryDatapointsRequest) (*types.QueryDatapointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var datapoints []types.DataPoint
+
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixData)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var datapoint types.DataPoint
+		if err := q.cdc.Unmarshal(value, &datapoint); err != nil {
+			return err
+		}
+
+		datapoints = append(datapoints, datapoint)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDatapointsResponse{
+		Datapoints: datapoints,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Params returns the parameters of the module.
+func (q querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
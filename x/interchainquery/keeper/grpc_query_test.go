@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/incubus-network/fanfury-sdk/v2/x/lsnative/staking/types"
 
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/keeper"
 	icqtypes "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
 )
 
@@ -27,7 +29,7 @@ func (suite *KeeperTestSuite) TestQueries() {
 	// set the query
 	suite.GetFuryApp(suite.chainA).InterchainQueryKeeper.SetQuery(suite.chainA.GetContext(), *query)
 
-	icqsrvSrv := icqtypes.QuerySrvrServer(suite.GetFuryApp(suite.chainA).InterchainQueryKeeper)
+	icqsrvSrv := keeper.NewQuerier(suite.GetFuryApp(suite.chainA).InterchainQueryKeeper)
 
 	res, err := icqsrvSrv.Queries(sdk.WrapSDKContext(suite.chainA.GetContext()), &icqtypes.QueryRequestsRequest{ChainId: suite.chainB.ChainID})
 	suite.NoError(err)
@@ -38,3 +40,73 @@ func (suite *KeeperTestSuite) TestQueries() {
 	suite.Equal(sdk.NewInt(200), res.Queries[0].Period)
 	suite.Equal("", res.Queries[0].CallbackId)
 }
+
+func (suite *KeeperTestSuite) TestAllQueries() {
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	modules := []string{"", "oracle", "other"}
+	for _, module := range modules {
+		query := app.InterchainQueryKeeper.NewQuery(
+			ctx,
+			module,
+			suite.path.EndpointB.ConnectionID,
+			suite.chainB.ChainID,
+			"cosmos.staking.v1beta1.Query/Validators",
+			bz,
+			sdk.NewInt(200),
+			"",
+			10,
+		)
+		app.InterchainQueryKeeper.SetQuery(ctx, *query)
+		suite.NoError(app.InterchainQueryKeeper.SetDatapointForID(ctx, query.Id, []byte{0x01}, sdk.NewInt(1)))
+	}
+
+	querier := keeper.NewQuerier(app.InterchainQueryKeeper)
+
+	tests := []struct {
+		name  string
+		req   *icqtypes.QueryAllQueriesRequest
+		count int
+	}{
+		{"all", &icqtypes.QueryAllQueriesRequest{}, 3},
+		{"by connection", &icqtypes.QueryAllQueriesRequest{ConnectionId: suite.path.EndpointB.ConnectionID}, 3},
+		{"by unknown connection", &icqtypes.QueryAllQueriesRequest{ConnectionId: "connection-9"}, 0},
+		{"by module", &icqtypes.QueryAllQueriesRequest{Module: "oracle"}, 1},
+		{"by query type", &icqtypes.QueryAllQueriesRequest{QueryType: "store/bank/key"}, 0},
+		{"paginated", &icqtypes.QueryAllQueriesRequest{Pagination: &query.PageRequest{Limit: 2}}, 2},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			res, err := querier.AllQueries(sdk.WrapSDKContext(ctx), tc.req)
+			suite.NoError(err)
+			suite.Len(res.Queries, tc.count)
+		})
+	}
+
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "oracle")
+
+	queryRes, err := querier.Query(sdk.WrapSDKContext(ctx), &icqtypes.QueryQueryRequest{Id: id})
+	suite.NoError(err)
+	suite.Equal("oracle", queryRes.Query.Module)
+
+	_, err = querier.Query(sdk.WrapSDKContext(ctx), &icqtypes.QueryQueryRequest{Id: "unknown"})
+	suite.Error(err)
+
+	datapointRes, err := querier.Datapoint(sdk.WrapSDKContext(ctx), &icqtypes.QueryDatapointRequest{Id: id})
+	suite.NoError(err)
+	suite.Equal([]byte{0x01}, datapointRes.Datapoint.Value)
+
+	datapointsRes, err := querier.Datapoints(sdk.WrapSDKContext(ctx), &icqtypes.QueryDatapointsRequest{})
+	suite.NoError(err)
+	suite.Len(datapointsRes.Datapoints, 3)
+
+	paramsRes, err := querier.Params(sdk.WrapSDKContext(ctx), &icqtypes.QueryParamsRequest{})
+	suite.NoError(err)
+	suite.Equal(app.InterchainQueryKeeper.GetParams(ctx), paramsRes.Params)
+}
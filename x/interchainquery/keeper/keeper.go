@@ -3,11 +3,13 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibckeeper "github.com/cosmos/ibc-go/v6/modules/core/keeper"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -16,19 +18,34 @@ import (
 
 // Keeper of this module maintains collections of registered zones.
 type Keeper struct {
-	cdc       codec.Codec
-	storeKey  storetypes.StoreKey
-	callbacks map[string]types.QueryCallbacks
-	IBCKeeper *ibckeeper.Keeper
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	paramSpace paramstypes.Subspace
+	bankKeeper types.BankKeeper
+	callbacks  map[string]types.QueryCallbacks
+	IBCKeeper  *ibckeeper.Keeper
 }
 
 // NewKeeper returns a new instance of zones Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, ibckeeper *ibckeeper.Keeper) Keeper {
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	paramSpace paramstypes.Subspace,
+	bankKeeper types.BankKeeper,
+	ibckeeper *ibckeeper.Keeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		callbacks: make(map[string]types.QueryCallbacks),
-		IBCKeeper: ibckeeper,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		bankKeeper: bankKeeper,
+		callbacks:  make(map[string]types.QueryCallbacks),
+		IBCKeeper:  ibckeeper,
 	}
 }
 
@@ -137,25 +154,47 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, connectionID string, chainID strin
 	existingQuery, found := k.GetQuery(ctx, key)
 
 	if !found {
-		if module != "" {
-			if _, exists := k.callbacks[module]; !exists {
-				err := fmt.Errorf("no callback handler registered for module %s", module)
-				k.Logger(ctx).Error(err.Error())
-				panic(err)
-			}
-
-			if exists := k.callbacks[module].Has(callbackID); !exists {
-				err := fmt.Errorf("no callback %s registered for module %s", callbackID, module)
-				k.Logger(ctx).Error(err.Error())
-				panic(err)
-			}
+		if err := k.ValidateCallback(module, callbackID); err != nil {
+			k.Logger(ctx).Error(err.Error())
+			panic(err)
 		}
 
 		newQuery := k.NewQuery(ctx, module, connectionID, chainID, queryType, request, period, callbackID, ttl)
+		// the queries emitted once time out if never answered
+		if period.IsNegative() {
+			newQuery.Timeout = RetryInterval
+			newQuery.MaxRetries = MaxRetries
+		}
 		k.SetQuery(ctx, *newQuery)
 	} else {
 		// a re-request of an existing query triggers resetting of height to trigger immediately.
 		existingQuery.LastHeight = sdk.ZeroInt()
+		// a re-request of a timed out query emits it again
+		if existingQuery.TimedOut {
+			existingQuery.TimedOut = false
+			existingQuery.Retries = 0
+			existingQuery.LastEmission = sdk.ZeroInt()
+		}
 		k.SetQuery(ctx, existingQuery)
 	}
 }
+
+// ValidateCallback returns an error if the module has no callback handler
+// registered or the handler does not have the callback. Queries without a
+// module do not execute callbacks.
+func (k *Keeper) ValidateCallback(module string, callbackID string) error {
+	if module == "" {
+		return nil
+	}
+
+	handler, exists := k.callbacks[module]
+	if !exists {
+		return errors.Wrapf(types.ErrUnknownCallback, "no callback handler registered for module %s", module)
+	}
+
+	if !handler.Has(callbackID) {
+		return errors.Wrapf(types.ErrUnknownCallback, "no callback %s registered for module %s", callbackID, module)
+	}
+
+	return nil
+}
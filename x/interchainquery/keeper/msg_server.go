@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 	"sort"
 	"strings"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/incubus-network/fanfury-sdk/v2/utils"
@@ -33,6 +36,16 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return &types.MsgSubmitQueryResponseResponse{}, nil
 	}
 
+	// a timed out query no longer accepts responses
+	if q.TimedOut {
+		k.Logger(ctx).Info("ignoring response of timed out query", "QueryID", msg.QueryId)
+
+		return &types.MsgSubmitQueryResponseResponse{}, nil
+	}
+
+	// only the first response of an emission is paid its fee
+	pending := isPending(q)
+
 	// check if query was previously processed
 	// - indicated by query.LastHeight matching current Block Height;
 	if q.LastHeight.Int64() == ctx.BlockHeader().Height {
@@ -42,13 +55,37 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return &types.MsgSubmitQueryResponseResponse{}, nil
 	}
 
+	// a query at a fixed remote height only accepts results of that height
+	if q.Height != 0 && msg.Height != q.Height {
+		return nil, errors.Wrapf(types.ErrInvalidHeight, "response of height %d to query of height %d", msg.Height, q.Height)
+	}
+
+	result := msg.Result
+
 	pathParts := strings.Split(q.QueryType, "/")
-	if pathParts[len(pathParts)-1] == "key" {
+	if len(pathParts) > 1 && pathParts[len(pathParts)-1] == "key" {
 		if err := utils.ValidateProofOps(ctx, k.IBCKeeper, q.ConnectionId, q.ChainId, msg.Height, pathParts[1], q.Request, msg.Result, msg.ProofOps); err != nil {
 			return nil, err
 		}
 	}
 
+	// the callbacks of remote transactions receive the proven transaction
+	if q.QueryType == types.QueryTypeTx {
+		var err error
+		if result, err = k.validateTxResponse(ctx, q, msg.Height, msg.Result); err != nil {
+			return nil, err
+		}
+	}
+
+	// store the datapoint before executing the callbacks, so they can read
+	// the remote height of the result.
+	if q.Ttl > 0 {
+		// don't store if ttl is 0
+		if err := k.SetDatapointForID(ctx, msg.QueryId, result, sdk.NewInt(msg.Height)); err != nil {
+			return nil, err
+		}
+	}
+
 	noDelete := false
 	// execute registered callbacks.
 
@@ -62,11 +99,11 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 	for _, key := range keys {
 		module := k.callbacks[key]
 		if module.Has(q.CallbackId) {
-			err := module.Call(ctx, q.CallbackId, msg.Result, q)
+			err := module.Call(ctx, q.CallbackId, result, q)
 			if err != nil {
 				// not edge case: proceed with regular error handling!
 				if err != types.ErrSucceededNoDelete {
-					k.Logger(ctx).Error("error in callback", "error", err, "msg", msg.QueryId, "result", msg.Result, "type", q.QueryType, "params", q.Request)
+					k.Logger(ctx).Error("error in callback", "error", err, "msg", msg.QueryId, "result", result, "type", q.QueryType, "params", q.Request)
 					return nil, err
 				}
 				// edge case: the callback has resent the same query (re-query)!
@@ -76,9 +113,14 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		}
 	}
 
-	if q.Ttl > 0 {
-		// don't store if ttl is 0
-		if err := k.SetDatapointForID(ctx, msg.QueryId, msg.Result, sdk.NewInt(msg.Height)); err != nil {
+	var fee sdk.Coins
+	if pending {
+		relayer, err := sdk.AccAddressFromBech32(msg.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if fee, err = k.payFee(ctx, &q, relayer); err != nil {
 			return nil, err
 		}
 	}
@@ -87,10 +129,13 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 	// - Period.IsNegative() indicates a single query;
 	// - noDelete indicates a response that triggered a re-query;
 	if q.Period.IsNegative() && !noDelete {
-		k.DeleteQuery(ctx, msg.QueryId)
+		if err := k.deleteOwnedQuery(ctx, q); err != nil {
+			return nil, err
+		}
 	} else {
 		// logic condition: !q.Period.IsNegative() || noDelete == true
 		q.LastHeight = sdk.NewInt(ctx.BlockHeight())
+		q.Retries = 0
 		k.SetQuery(ctx, q)
 	}
 
@@ -99,7 +144,87 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
+		sdk.NewEvent(
+			types.EventTypeQueryResponse,
+			sdk.NewAttribute(types.AttributeKeyQueryID, q.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, q.Owner),
+			sdk.NewAttribute(types.AttributeKeyChainID, q.ChainId),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(msg.Height)),
+			sdk.NewAttribute(types.AttributeKeyResult, hex.EncodeToString(result)),
+			sdk.NewAttribute(types.AttributeKeyRelayer, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
 	})
 
 	return &types.MsgSubmitQueryResponseResponse{}, nil
 }
+
+func (k msgServer) RegisterInterchainQuery(goCtx context.Context, msg *types.MsgRegisterInterchainQuery) (*types.MsgRegisterInterchainQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := k.RegisterQuery(ctx, owner, msg.ConnectionId, msg.ChainId, msg.QueryType, msg.Request, msg.Period, msg.Ttl, msg.Timeout, msg.MaxRetries, msg.Fee, msg.FeeEscrow, msg.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+
+	return &types.MsgRegisterInterchainQueryResponse{QueryId: query.Id}, nil
+}
+
+func (k msgServer) RemoveInterchainQuery(goCtx context.Context, msg *types.MsgRemoveInterchainQuery) (*types.MsgRemoveInterchainQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveQuery(ctx, owner, msg.QueryId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+
+	return &types.MsgRemoveInterchainQueryResponse{}, nil
+}
+
+func (k msgServer) RequeryInterchainQuery(goCtx context.Context, msg *types.MsgRequeryInterchainQuery) (*types.MsgRequeryInterchainQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.RequeryQuery(ctx, owner, msg.QueryId, msg.FeeEscrow); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+
+	return &types.MsgRequeryInterchainQueryResponse{}, nil
+}
@@ -0,0 +1,498 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	furyapp "github.com/incubus-network/fanfury-sdk/v2/app"
+	"github.com/incubus-network/fanfury-sdk/v2/ibctesting"
+	"github.com/incubus-network/fanfury-sdk/v2/ibctesting/mock"
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/keeper"
+	icqtypes "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+	stakingtypes "github.com/incubus-network/fanfury-sdk/v2/x/lsnative/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterInterchainQuery() {
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	owner := suite.chainA.SenderAccount.GetAddress()
+	params := app.InterchainQueryKeeper.GetParams(ctx)
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: sdkstaking.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	newMsg := func(connectionID, chainID string, period int64, ttl uint64) *icqtypes.MsgRegisterInterchainQuery {
+		return icqtypes.NewMsgRegisterInterchainQuery(owner, connectionID, chainID, "cosmos.staking.v1beta1.Query/Validators", bz, period, ttl, 0, 0, nil, nil, 0)
+	}
+
+	tests := []struct {
+		name        string
+		msg         *icqtypes.MsgRegisterInterchainQuery
+		expectError error
+	}{
+		{"period below the minimum", newMsg(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, 1, 10), icqtypes.ErrInvalidQuery},
+		{"ttl above the maximum", newMsg(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, 100, params.MaxQueryTtl+1), icqtypes.ErrInvalidQuery},
+		{"unknown connection", newMsg("connection-9", suite.chainB.ChainID, 100, 10), icqtypes.ErrInvalidQuery},
+		{"wrong chain", newMsg(suite.path.EndpointA.ConnectionID, "testchain-9", 100, 10), icqtypes.ErrInvalidQuery},
+		{"periodic query", newMsg(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, 100, 10), nil},
+		{"duplicate query", newMsg(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, 100, 10), icqtypes.ErrQueryExists},
+	}
+
+	msgSrv := keeper.NewMsgServerImpl(app.InterchainQueryKeeper)
+	balance := app.BankKeeper.GetAllBalances(ctx, owner)
+
+	var queryID string
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			res, err := msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expectError != nil {
+				suite.ErrorIs(err, tc.expectError)
+				return
+			}
+
+			suite.NoError(err)
+			queryID = res.QueryId
+		})
+	}
+
+	// the query is derived from the owner and escrows the deposit
+	suite.Equal(keeper.GenerateQueryHash(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, owner.String()), queryID)
+
+	query, found := app.InterchainQueryKeeper.GetQuery(ctx, queryID)
+	suite.True(found)
+	suite.Equal(owner.String(), query.Owner)
+	suite.Equal(params.QueryDeposit, query.Deposit)
+	suite.Equal(sdk.NewInt(100), query.Period)
+	suite.Equal(balance.Sub(params.QueryDeposit...), app.BankKeeper.GetAllBalances(ctx, owner))
+
+	// only the owner can remove the query
+	other := suite.chainB.SenderAccount.GetAddress()
+	_, err = msgSrv.RemoveInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRemoveInterchainQuery(other, queryID))
+	suite.ErrorIs(err, icqtypes.ErrUnauthorized)
+
+	_, err = msgSrv.RemoveInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRemoveInterchainQuery(owner, queryID))
+	suite.NoError(err)
+
+	_, found = app.InterchainQueryKeeper.GetQuery(ctx, queryID)
+	suite.False(found)
+	suite.Equal(balance, app.BankKeeper.GetAllBalances(ctx, owner))
+
+	_, err = msgSrv.RemoveInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRemoveInterchainQuery(owner, queryID))
+	suite.ErrorIs(err, icqtypes.ErrQueryNotFound)
+}
+
+func (suite *KeeperTestSuite) TestRegisteredQueryResponse() {
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	owner := suite.chainA.SenderAccount.GetAddress()
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: sdkstaking.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	validators := suite.GetFuryApp(suite.chainB).StakingKeeper.GetBondedValidatorsByPower(suite.chainB.GetContext())
+	qvr := stakingtypes.QueryValidatorsResponse{
+		Validators: ibctesting.SdkValidatorsToValidators(validators),
+	}
+	result := suite.GetFuryApp(suite.chainB).AppCodec().MustMarshalJSON(&qvr)
+
+	msgSrv := keeper.NewMsgServerImpl(app.InterchainQueryKeeper)
+	balance := app.BankKeeper.GetAllBalances(ctx, owner)
+
+	// a query emitted once
+	res, err := msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRegisterInterchainQuery(
+		owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, -1, 10, 0, 0, nil, nil, 0,
+	))
+	suite.NoError(err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     res.QueryId,
+		Result:      result,
+		Height:      suite.chainB.CurrentHeader.Height,
+		FromAddress: TestOwnerAddress,
+	})
+	suite.NoError(err)
+
+	// the result is delivered through an event and a datapoint
+	var delivered bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == icqtypes.EventTypeQueryResponse {
+			delivered = true
+		}
+	}
+	suite.True(delivered)
+
+	dataPoint, err := app.InterchainQueryKeeper.GetDatapointForID(ctx, res.QueryId)
+	suite.NoError(err)
+	suite.Equal(result, dataPoint.Value)
+
+	// the answered query is deleted and its deposit refunded
+	_, found := app.InterchainQueryKeeper.GetQuery(ctx, res.QueryId)
+	suite.False(found)
+	suite.Equal(balance, app.BankKeeper.GetAllBalances(ctx, owner))
+}
+
+func (suite *KeeperTestSuite) TestFixedHeightQueryResponse() {
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	owner := suite.chainA.SenderAccount.GetAddress()
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: sdkstaking.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.Require().NoError(err)
+
+	msgSrv := keeper.NewMsgServerImpl(app.InterchainQueryKeeper)
+	height := suite.chainB.CurrentHeader.Height - 1
+
+	res, err := msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRegisterInterchainQuery(
+		owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, -1, 10, 0, 0, nil, nil, height,
+	))
+	suite.Require().NoError(err)
+
+	// the query is emitted for its height
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.InterchainQueryKeeper.EndBlocker(ctx)
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		if event.Type == sdk.EventTypeMessage && attrs[icqtypes.AttributeKeyQueryID] == res.QueryId {
+			suite.Require().Equal(fmt.Sprint(height), attrs[icqtypes.AttributeKeyHeight])
+			emitted = true
+		}
+	}
+	suite.Require().True(emitted)
+
+	// responses for another height are rejected
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     res.QueryId,
+		Result:      []byte{0x01},
+		Height:      suite.chainB.CurrentHeader.Height,
+		FromAddress: TestOwnerAddress,
+	})
+	suite.Require().ErrorIs(err, icqtypes.ErrInvalidHeight)
+
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     res.QueryId,
+		Result:      []byte{0x01},
+		Height:      height,
+		FromAddress: TestOwnerAddress,
+	})
+	suite.Require().NoError(err)
+
+	dataPoint, err := app.InterchainQueryKeeper.GetDatapointForID(ctx, res.QueryId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(height, dataPoint.RemoteHeight.Int64())
+}
+
+func (suite *KeeperTestSuite) TestTxQueryResponse() {
+	app := suite.GetFuryApp(suite.chainA)
+	owner := suite.chainA.SenderAccount.GetAddress()
+	msgSrv := keeper.NewMsgServerImpl(app.InterchainQueryKeeper)
+
+	// two transactions of a block of chainB
+	txConfig := furyapp.MakeTestEncodingConfig().TxConfig
+	var txs tmtypes.Txs
+	for _, memo := range []string{"deposit", "other"} {
+		txBuilder := txConfig.NewTxBuilder()
+		suite.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(
+			suite.chainB.SenderAccount.GetAddress(), owner, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)),
+		)))
+		txBuilder.SetMemo(memo)
+
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		suite.Require().NoError(err)
+		txs = append(txs, bz)
+	}
+
+	// a header of chainB trusted from the latest consensus state of the client on chainA
+	trustedHeight := suite.chainA.GetClientState(suite.path.EndpointA.ClientID).GetLatestHeight().(clienttypes.Height)
+	newHeader := func(txs tmtypes.Txs) *ibctmtypes.Header {
+		return suite.chainB.CreateTMClientHeaderWithTxs(
+			suite.chainB.ChainID, suite.chainB.CurrentHeader.Height, trustedHeight, suite.chainB.CurrentHeader.Time,
+			suite.chainB.Vals, suite.chainB.NextVals, suite.chainB.Vals, suite.chainB.Signers, txs,
+		)
+	}
+
+	header := newHeader(txs)
+	proof := txs.Proof(0).ToProto()
+
+	forgedHeader := newHeader(txs)
+	forgedHeader.Header.DataHash = tmtypes.Txs{txs[0]}.Hash()
+
+	// a header signed by validators the client does not trust
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	suite.Require().NoError(err)
+
+	untrustedVals := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+	untrustedHeader := suite.chainB.CreateTMClientHeaderWithTxs(
+		suite.chainB.ChainID, suite.chainB.CurrentHeader.Height, trustedHeight, suite.chainB.CurrentHeader.Time,
+		untrustedVals, untrustedVals, suite.chainB.Vals, map[string]tmtypes.PrivValidator{pubKey.Address().String(): privVal}, txs,
+	)
+
+	testCases := []struct {
+		name   string
+		height int64
+		res    icqtypes.GetTxWithProofResponse
+		err    error
+	}{
+		{"valid proof", header.Header.Height, icqtypes.GetTxWithProofResponse{Proof: &proof, Header: header}, nil},
+		{"no header", header.Header.Height, icqtypes.GetTxWithProofResponse{Proof: &proof}, icqtypes.ErrInvalidProof},
+		{"other height", header.Header.Height - 1, icqtypes.GetTxWithProofResponse{Proof: &proof, Header: header}, icqtypes.ErrInvalidProof},
+		{"proof of another block", header.Header.Height, icqtypes.GetTxWithProofResponse{Proof: &proof, Header: newHeader(txs[1:])}, icqtypes.ErrInvalidProof},
+		{"unverified header", header.Header.Height, icqtypes.GetTxWithProofResponse{Proof: &proof, Header: forgedHeader}, icqtypes.ErrInvalidProof},
+		{"untrusted validators", header.Header.Height, icqtypes.GetTxWithProofResponse{Proof: &proof, Header: untrustedHeader}, icqtypes.ErrInvalidProof},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+
+			res, err := msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRegisterInterchainQuery(
+				owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, icqtypes.QueryTypeTx, txs[0].Hash(), -1, 10, 0, 0, nil, nil, 0,
+			))
+			suite.Require().NoError(err)
+
+			result, err := app.AppCodec().Marshal(&tc.res)
+			suite.Require().NoError(err)
+
+			_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+				ChainId:     suite.chainB.ChainID,
+				QueryId:     res.QueryId,
+				Result:      result,
+				Height:      tc.height,
+				FromAddress: TestOwnerAddress,
+			})
+			if tc.err != nil {
+				suite.Require().ErrorIs(err, tc.err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the proven transaction is delivered
+			dataPoint, err := app.InterchainQueryKeeper.GetDatapointForID(ctx, res.QueryId)
+			suite.Require().NoError(err)
+
+			var delivered icqtypes.GetTxWithProofResponse
+			suite.Require().NoError(app.AppCodec().Unmarshal(dataPoint.Value, &delivered))
+			suite.Require().Equal("deposit", delivered.Tx.Body.Memo)
+		})
+	}
+
+	// the proof of another transaction than the requested one is rejected
+	ctx, _ := suite.chainA.GetContext().CacheContext()
+	res, err := msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRegisterInterchainQuery(
+		owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, icqtypes.QueryTypeTx, txs[1].Hash(), -1, 10, 0, 0, nil, nil, 0,
+	))
+	suite.Require().NoError(err)
+
+	result, err := app.AppCodec().Marshal(&icqtypes.GetTxWithProofResponse{Proof: &proof, Header: header})
+	suite.Require().NoError(err)
+
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     res.QueryId,
+		Result:      result,
+		Height:      header.Header.Height,
+		FromAddress: TestOwnerAddress,
+	})
+	suite.Require().ErrorIs(err, icqtypes.ErrInvalidProof)
+}
+
+func (suite *KeeperTestSuite) TestQueryFees() {
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	owner := suite.chainA.SenderAccount.GetAddress()
+	relayer := sdk.MustAccAddressFromBech32(TestOwnerAddress)
+	msgSrv := keeper.NewMsgServerImpl(app.InterchainQueryKeeper)
+
+	// the proven balance of an account of chainB
+	request := append(banktypes.CreateAccountBalancesPrefix(suite.chainB.SenderAccount.GetAddress()), []byte(sdk.DefaultBondDenom)...)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	proven := suite.chainB.App.Query(abci.RequestQuery{
+		Path:   "store/bank/key",
+		Height: suite.chainB.App.LastBlockHeight() - 1,
+		Data:   request,
+		Prove:  true,
+	})
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	balance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+	start := ctx.BlockHeight()
+
+	res, err := msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRegisterInterchainQuery(
+		owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "store/bank/key", request, 10, 10, 0, 0, fee, coins(250), 0,
+	))
+	suite.Require().NoError(err)
+
+	// respond submits a response at the height past the registration and
+	// returns the fees paid to the relayer
+	respond := func(blocks int64) sdk.Coins {
+		before := app.BankKeeper.GetAllBalances(ctx, relayer)
+
+		_, err := msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx.WithBlockHeight(start+blocks)), &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     res.QueryId,
+			Result:      proven.Value,
+			ProofOps:    proven.ProofOps,
+			Height:      proven.Height,
+			FromAddress: TestOwnerAddress,
+		})
+		suite.Require().NoError(err)
+
+		return app.BankKeeper.GetAllBalances(ctx, relayer).Sub(before...)
+	}
+
+	outstanding := func() sdk.Coins {
+		query, found := app.InterchainQueryKeeper.GetQuery(ctx, res.QueryId)
+		suite.Require().True(found)
+
+		return query.FeeEscrow
+	}
+
+	suite.Require().Equal(coins(250), outstanding())
+
+	// the first response of an emission is paid
+	app.InterchainQueryKeeper.EndBlocker(ctx)
+	suite.Require().Equal(fee, respond(1))
+	suite.Require().True(respond(2).IsZero())
+	suite.Require().Equal(coins(150), outstanding())
+
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(start + 10))
+	suite.Require().Equal(fee, respond(11))
+	suite.Require().Equal(coins(50), outstanding())
+
+	// the responses are not paid once the escrow no longer covers the fee
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(start + 20))
+	suite.Require().True(respond(21).IsZero())
+	suite.Require().Equal(coins(50), outstanding())
+
+	// a re-request adds to the escrow and emits the query again
+	_, err = msgSrv.RequeryInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRequeryInterchainQuery(owner, res.QueryId, coins(100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins(150), outstanding())
+
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(start + 22))
+	suite.Require().Equal(fee, respond(23))
+	suite.Require().Equal(coins(50), outstanding())
+
+	// only the owner re-requests the query
+	_, err = msgSrv.RequeryInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRequeryInterchainQuery(relayer, res.QueryId, nil))
+	suite.Require().ErrorIs(err, icqtypes.ErrUnauthorized)
+
+	// the outstanding fees are refunded along the deposit
+	_, err = msgSrv.RemoveInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRemoveInterchainQuery(owner, res.QueryId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance.SubAmount(sdk.NewInt(300)), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestTimedOutQueryRefund() {
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	owner := suite.chainA.SenderAccount.GetAddress()
+	msgSrv := keeper.NewMsgServerImpl(app.InterchainQueryKeeper)
+
+	request := append(banktypes.CreateAccountBalancesPrefix(suite.chainB.SenderAccount.GetAddress()), []byte(sdk.DefaultBondDenom)...)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	deposit := app.InterchainQueryKeeper.GetParams(ctx).QueryDeposit
+	balance := app.BankKeeper.GetAllBalances(ctx, owner)
+
+	res, err := msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRegisterInterchainQuery(
+		owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "store/bank/key", request, -1, 10, 10, 0, fee, fee, 0,
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance.Sub(deposit...).Sub(fee...), app.BankKeeper.GetAllBalances(ctx, owner))
+
+	// the fees of the unanswered query are refunded once it times out
+	app.InterchainQueryKeeper.EndBlocker(ctx)
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+
+	query, found := app.InterchainQueryKeeper.GetQuery(ctx, res.QueryId)
+	suite.Require().True(found)
+	suite.Require().True(query.TimedOut)
+	suite.Require().True(query.FeeEscrow.IsZero())
+	suite.Require().Equal(balance.Sub(deposit...), app.BankKeeper.GetAllBalances(ctx, owner))
+
+	// the query is kept to be re-requested for the retention period, then
+	// deleted along with the refund of its deposit
+	timedOutHeight := ctx.BlockHeight() + 10
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(timedOutHeight + keeper.TimedOutRetention - 1))
+
+	_, found = app.InterchainQueryKeeper.GetQuery(ctx, res.QueryId)
+	suite.Require().True(found)
+
+	app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(timedOutHeight + keeper.TimedOutRetention))
+
+	_, found = app.InterchainQueryKeeper.GetQuery(ctx, res.QueryId)
+	suite.Require().False(found)
+	suite.Require().Equal(balance, app.BankKeeper.GetAllBalances(ctx, owner))
+}
+
+func (suite *KeeperTestSuite) TestUnprovenQueryFees() {
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	owner := suite.chainA.SenderAccount.GetAddress()
+	msgSrv := keeper.NewMsgServerImpl(app.InterchainQueryKeeper)
+
+	bz, err := (&stakingtypes.QueryValidatorsRequest{Status: sdkstaking.BondStatusBonded}).Marshal()
+	suite.Require().NoError(err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// the responses to gRPC queries are not proven, so they take no fees
+	_, err = msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRegisterInterchainQuery(
+		owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, 10, 10, 0, 0, fee, fee, 0,
+	))
+	suite.Require().ErrorIs(err, icqtypes.ErrInvalidQuery)
+
+	res, err := msgSrv.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRegisterInterchainQuery(
+		owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, 10, 10, 0, 0, nil, nil, 0,
+	))
+	suite.Require().NoError(err)
+
+	_, err = msgSrv.RequeryInterchainQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgRequeryInterchainQuery(owner, res.QueryId, fee))
+	suite.Require().ErrorIs(err, icqtypes.ErrInvalidQuery)
+}
+
+func (suite *KeeperTestSuite) TestMalformedQueryTypeResponse() {
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	msgSrv := keeper.NewMsgServerImpl(app.InterchainQueryKeeper)
+
+	// a query type without store is not proven and does not panic
+	query := app.InterchainQueryKeeper.NewQuery(ctx, "", suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, "key", []byte{0x01}, sdk.NewInt(-1), "", 10)
+	app.InterchainQueryKeeper.SetQuery(ctx, *query)
+
+	suite.Require().NotPanics(func() {
+		_, err := msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     query.Id,
+			Result:      []byte{0x01},
+			Height:      suite.chainB.CurrentHeader.Height,
+			FromAddress: TestOwnerAddress,
+		})
+		suite.Require().NoError(err)
+	})
+}
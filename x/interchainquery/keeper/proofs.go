@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/utils"
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+// validateTxResponse verifies the GetTxWithProofResponse answering a query of
+// a remote transaction: the transaction must be the one requested, included
+// in the block of the header, whose header is verified by the light client of
+// the query connection, of the height of the response. It returns the
+// response delivered to the callbacks, whose transaction is decoded from the
+// proven transaction bytes. The transaction response is not proven.
+func (k Keeper) validateTxResponse(ctx sdk.Context, query types.Query, height int64, result []byte) ([]byte, error) {
+	var res types.GetTxWithProofResponse
+	if err := k.cdc.Unmarshal(result, &res); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidProof, "invalid tx response: %s", err)
+	}
+
+	if res.Proof == nil {
+		return nil, errors.Wrap(types.ErrInvalidProof, "no tx proof submitted")
+	}
+
+	if hash := tmtypes.Tx(res.Proof.Data).Hash(); !bytes.Equal(hash, query.Request) {
+		return nil, errors.Wrapf(types.ErrInvalidProof, "proof of tx %X, requested %X", hash, query.Request)
+	}
+
+	if res.Header == nil || res.Header.SignedHeader == nil || res.Header.Header == nil {
+		return nil, errors.Wrap(types.ErrInvalidProof, "no header submitted")
+	}
+
+	if res.Header.Header.Height != height {
+		return nil, errors.Wrapf(types.ErrInvalidProof, "header of height %d, response of height %d", res.Header.Header.Height, height)
+	}
+
+	if err := utils.ValidateTxProof(ctx, k.IBCKeeper, query.ConnectionId, query.ChainId, res.Header, res.Proof); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidProof, err.Error())
+	}
+
+	var raw tx.TxRaw
+	if err := k.cdc.Unmarshal(res.Proof.Data, &raw); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidProof, "invalid tx: %s", err)
+	}
+
+	res.Tx = &tx.Tx{
+		Body:       &tx.TxBody{},
+		AuthInfo:   &tx.AuthInfo{},
+		Signatures: raw.Signatures,
+	}
+
+	if err := k.cdc.Unmarshal(raw.BodyBytes, res.Tx.Body); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidProof, "invalid tx body: %s", err)
+	}
+
+	if err := k.cdc.Unmarshal(raw.AuthInfoBytes, res.Tx.AuthInfo); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidProof, "invalid tx auth info: %s", err)
+	}
+
+	return k.cdc.Marshal(&res)
+}
@@ -3,9 +3,11 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmclienttypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
@@ -18,7 +20,7 @@ func GenerateQueryHash(connectionID string, chainID string, queryType string, re
 // ----------------------------------------------------------------
 
 func (k Keeper) NewQuery(ctx sdk.Context, module string, connectionID string, chainID string, queryType string, request []byte, period sdkmath.Int, callbackID string, ttl uint64) *types.Query {
-	return &types.Query{Id: GenerateQueryHash(connectionID, chainID, queryType, request, module), ConnectionId: connectionID, ChainId: chainID, QueryType: queryType, Request: request, Period: period, LastHeight: sdk.ZeroInt(), CallbackId: callbackID, Ttl: ttl}
+	return &types.Query{Id: GenerateQueryHash(connectionID, chainID, queryType, request, module), ConnectionId: connectionID, ChainId: chainID, QueryType: queryType, Request: request, Period: period, LastHeight: sdk.ZeroInt(), CallbackId: callbackID, Ttl: ttl, Module: module}
 }
 
 // GetQuery returns query
@@ -81,3 +83,194 @@ func (k Keeper) AllQueries(ctx sdk.Context) []types.Query {
 
 	return queries
 }
+
+// RegisterQuery registers a query on behalf of the owner, escrowing the query
+// deposit. The query ID is derived from the owner so that several accounts can
+// register the same query. The results of the query are delivered through
+// events and stored datapoints, as it executes no callback.
+func (k Keeper) RegisterQuery(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	connectionID string,
+	chainID string,
+	queryType string,
+	request []byte,
+	period int64,
+	ttl uint64,
+	timeout uint64,
+	maxRetries uint64,
+	fee sdk.Coins,
+	feeEscrow sdk.Coins,
+	height int64,
+) (types.Query, error) {
+	params := k.GetParams(ctx)
+
+	if period >= 0 && uint64(period) < params.MinQueryPeriod {
+		return types.Query{}, errors.Wrapf(types.ErrInvalidQuery, "period must be at least %d blocks, is %d", params.MinQueryPeriod, period)
+	}
+
+	if ttl == 0 || ttl > params.MaxQueryTtl {
+		return types.Query{}, errors.Wrapf(types.ErrInvalidQuery, "ttl must be between 1 and %d blocks, is %d", params.MaxQueryTtl, ttl)
+	}
+
+	if maxRetries > params.MaxQueryRetries {
+		return types.Query{}, errors.Wrapf(types.ErrInvalidQuery, "retries must be at most %d, is %d", params.MaxQueryRetries, maxRetries)
+	}
+
+	if err := validateFees(queryType, fee, feeEscrow); err != nil {
+		return types.Query{}, err
+	}
+
+	if err := k.validateConnection(ctx, connectionID, chainID); err != nil {
+		return types.Query{}, err
+	}
+
+	// the owner takes the place of the module in the query ID
+	query := k.NewQuery(ctx, owner.String(), connectionID, chainID, queryType, request, sdk.NewInt(period), "", ttl)
+	query.Module = ""
+	if _, found := k.GetQuery(ctx, query.Id); found {
+		return types.Query{}, errors.Wrap(types.ErrQueryExists, query.Id)
+	}
+
+	if !params.QueryDeposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, params.QueryDeposit); err != nil {
+			return types.Query{}, err
+		}
+	}
+
+	query.Owner = owner.String()
+	query.Deposit = params.QueryDeposit
+	query.Timeout = timeout
+	query.MaxRetries = maxRetries
+	query.Fee = fee
+	query.Height = height
+
+	if err := k.escrowFees(ctx, owner, query, feeEscrow); err != nil {
+		return types.Query{}, err
+	}
+
+	k.SetQuery(ctx, *query)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterQuery,
+			sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, query.Owner),
+			sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, query.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+			sdk.NewAttribute(types.AttributeKeyDeposit, query.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, query.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeEscrow, query.FeeEscrow.String()),
+		),
+	)
+
+	return *query, nil
+}
+
+// RequeryQuery emits again a query registered by the owner at the end of the
+// block, even if it timed out, and adds the fees to its fee escrow.
+func (k Keeper) RequeryQuery(ctx sdk.Context, owner sdk.AccAddress, id string, feeEscrow sdk.Coins) (types.Query, error) {
+	query, found := k.GetQuery(ctx, id)
+	if !found {
+		return types.Query{}, errors.Wrap(types.ErrQueryNotFound, id)
+	}
+
+	if query.Owner != owner.String() {
+		return types.Query{}, errors.Wrapf(types.ErrUnauthorized, "query %s", id)
+	}
+
+	if err := validateFees(query.QueryType, nil, feeEscrow); err != nil {
+		return types.Query{}, err
+	}
+
+	if err := k.escrowFees(ctx, owner, &query, feeEscrow); err != nil {
+		return types.Query{}, err
+	}
+
+	query.TimedOut = false
+	query.Retries = 0
+	query.LastEmission = sdk.ZeroInt()
+	k.SetQuery(ctx, query)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRequeryQuery,
+			sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, query.Owner),
+			sdk.NewAttribute(types.AttributeKeyFeeEscrow, query.FeeEscrow.String()),
+		),
+	)
+
+	return query, nil
+}
+
+// RemoveQuery removes a query registered by the owner along with its
+// datapoint, and refunds its deposit and outstanding fees.
+func (k Keeper) RemoveQuery(ctx sdk.Context, owner sdk.AccAddress, id string) error {
+	query, found := k.GetQuery(ctx, id)
+	if !found {
+		return errors.Wrap(types.ErrQueryNotFound, id)
+	}
+
+	if query.Owner != owner.String() {
+		return errors.Wrapf(types.ErrUnauthorized, "query %s", id)
+	}
+
+	if err := k.deleteOwnedQuery(ctx, query); err != nil {
+		return err
+	}
+
+	k.DeleteDatapoint(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveQuery,
+			sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, query.Owner),
+			sdk.NewAttribute(types.AttributeKeyDeposit, query.Deposit.String()),
+		),
+	)
+
+	return nil
+}
+
+// deleteOwnedQuery deletes a query and refunds its deposit and outstanding
+// fees to its owner, if any.
+func (k Keeper) deleteOwnedQuery(ctx sdk.Context, query types.Query) error {
+	if refund := query.Deposit.Add(query.FeeEscrow...); query.Owner != "" && !refund.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(query.Owner)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, refund); err != nil {
+			return err
+		}
+	}
+
+	k.DeleteQuery(ctx, query.Id)
+
+	return nil
+}
+
+// validateConnection returns an error if the connection does not exist or its
+// light client does not track the chain.
+func (k Keeper) validateConnection(ctx sdk.Context, connectionID string, chainID string) error {
+	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return errors.Wrapf(types.ErrInvalidQuery, "connection %s not found", connectionID)
+	}
+
+	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return errors.Wrapf(types.ErrInvalidQuery, "client %s not found", connection.ClientId)
+	}
+
+	tmClientState, ok := clientState.(*tmclienttypes.ClientState)
+	if !ok || tmClientState.ChainId != chainID {
+		return errors.Wrapf(types.ErrInvalidQuery, "connection %s is not a connection to %s", connectionID, chainID)
+	}
+
+	return nil
+}
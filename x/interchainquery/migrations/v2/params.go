@@ -0,0 +1,29 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+// MigrateParams performs in-place params migrations from v1 to v2. The v1
+// module has no params, so the migration sets the params of the query
+// registration to their defaults:
+//
+// - QueryDeposit, MinQueryPeriod, MaxQueryTtl and MaxQueryRetries.
+//
+// The params already in the store are kept as they are.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if paramSpace.Has(ctx, pair.Key) {
+			continue
+		}
+
+		paramSpace.Set(ctx, pair.Key, pair.Value)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/migrations/v2"
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+
+	legacyAmino := codec.NewLegacyAmino()
+	paramSpace := paramstypes.NewSubspace(codec.NewProtoCodec(nil), legacyAmino, storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the module of v1 has no params
+	var params types.Params
+	require.Panics(t, func() { paramSpace.GetParamSet(ctx, &params) })
+
+	require.NoError(t, v2.MigrateParams(ctx, paramSpace))
+
+	require.NotPanics(t, func() { paramSpace.GetParamSet(ctx, &params) })
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultParams(), params)
+}
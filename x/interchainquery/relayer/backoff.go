@@ -0,0 +1,50 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// Backoff retries a failing operation with an exponentially growing delay.
+type Backoff struct {
+	// Interval is the delay before the first retry, doubled on every retry.
+	Interval time.Duration
+	// MaxInterval caps the delay between two retries.
+	MaxInterval time.Duration
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+}
+
+// DefaultBackoff returns the backoff retrying five times from a second up to
+// half a minute apart.
+func DefaultBackoff() Backoff {
+	return Backoff{
+		Interval:    time.Second,
+		MaxInterval: 30 * time.Second,
+		MaxRetries:  5,
+	}
+}
+
+// Retry runs fn until it succeeds, the retries are exhausted or the context is
+// cancelled, and returns its last error.
+func (b Backoff) Retry(ctx context.Context, fn func() error) error {
+	interval := b.Interval
+
+	for retry := 0; ; retry++ {
+		err := fn()
+		if err == nil || retry >= b.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if b.MaxInterval > 0 && interval > b.MaxInterval {
+			interval = b.MaxInterval
+		}
+	}
+}
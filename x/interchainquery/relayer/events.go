@@ -0,0 +1,81 @@
+package relayer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+// PendingQuery is an interchain query emitted by the querying chain and
+// waiting for its response.
+type PendingQuery struct {
+	ID           string
+	ConnectionID string
+	ChainID      string
+	QueryType    string
+	Request      []byte
+	// Height is the remote height to query at, zero for the latest height the
+	// light client of the connection can verify.
+	Height int64
+}
+
+// pendingQueryFromQuery returns the pending query of a stored query.
+func pendingQueryFromQuery(query types.Query) PendingQuery {
+	return PendingQuery{
+		ID:           query.Id,
+		ConnectionID: query.ConnectionId,
+		ChainID:      query.ChainId,
+		QueryType:    query.QueryType,
+		Request:      query.Request,
+		Height:       query.Height,
+	}
+}
+
+// ParseQueryEvents returns the queries of the chain emitted by the
+// interchainquery EndBlocker among the events.
+func ParseQueryEvents(events []abci.Event, chainID string) ([]PendingQuery, error) {
+	var queries []PendingQuery
+
+	for _, event := range events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+
+		if attrs[sdk.AttributeKeyModule] != types.AttributeValueCategory ||
+			attrs[sdk.AttributeKeyAction] != types.AttributeValueQuery ||
+			attrs[types.AttributeKeyChainID] != chainID {
+			continue
+		}
+
+		request, err := hex.DecodeString(attrs[types.AttributeKeyRequest])
+		if err != nil {
+			return nil, fmt.Errorf("invalid request of query %s: %w", attrs[types.AttributeKeyQueryID], err)
+		}
+
+		height, err := strconv.ParseInt(attrs[types.AttributeKeyHeight], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height of query %s: %w", attrs[types.AttributeKeyQueryID], err)
+		}
+
+		queries = append(queries, PendingQuery{
+			ID:           attrs[types.AttributeKeyQueryID],
+			ConnectionID: attrs[types.AttributeKeyConnectionID],
+			ChainID:      chainID,
+			QueryType:    attrs[types.AttributeKeyType],
+			Request:      request,
+			Height:       height,
+		})
+	}
+
+	return queries, nil
+}
@@ -0,0 +1,146 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+// Host is the querying chain, which emits the interchain queries and receives
+// their responses.
+type Host interface {
+	// Signer returns the account submitting the responses.
+	Signer() sdk.AccAddress
+	// LatestClientHeight returns the latest height of the light client of the
+	// connection, which the proofs of the responses are verified against.
+	LatestClientHeight(ctx context.Context, connectionID string) (int64, error)
+	// PendingQueries returns the queries of the chain emitted and not
+	// answered yet.
+	PendingQueries(ctx context.Context, chainID string) ([]PendingQuery, error)
+	// Submit broadcasts the messages in a single transaction.
+	Submit(ctx context.Context, msgs []sdk.Msg) error
+}
+
+var _ Host = TxHost{}
+
+// TxHost is the Host of a node, queried over gRPC and submitting the responses
+// in transactions signed with the key of the client context's from address.
+type TxHost struct {
+	clientCtx client.Context
+	txf       tx.Factory
+}
+
+// NewTxHost returns a Host of the node of the client context.
+func NewTxHost(clientCtx client.Context, txf tx.Factory) TxHost {
+	return TxHost{
+		clientCtx: clientCtx,
+		txf:       txf,
+	}
+}
+
+// Signer implements Host.
+func (h TxHost) Signer() sdk.AccAddress {
+	return h.clientCtx.GetFromAddress()
+}
+
+// LatestClientHeight implements Host.
+func (h TxHost) LatestClientHeight(ctx context.Context, connectionID string) (int64, error) {
+	res, err := connectiontypes.NewQueryClient(h.clientCtx).ConnectionClientState(ctx, &connectiontypes.QueryConnectionClientStateRequest{
+		ConnectionId: connectionID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if res.IdentifiedClientState == nil {
+		return 0, fmt.Errorf("no client state for connection %s", connectionID)
+	}
+
+	clientState, err := clienttypes.UnpackClientState(res.IdentifiedClientState.ClientState)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(clientState.GetLatestHeight().GetRevisionHeight()), nil
+}
+
+// PendingQueries implements Host.
+func (h TxHost) PendingQueries(ctx context.Context, chainID string) ([]PendingQuery, error) {
+	queryClient := types.NewQuerySrvrClient(h.clientCtx)
+
+	var (
+		queries []PendingQuery
+		nextKey []byte
+	)
+
+	for {
+		res, err := queryClient.Queries(ctx, &types.QueryRequestsRequest{
+			ChainId:    chainID,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, query := range res.Queries {
+			queries = append(queries, pendingQueryFromQuery(query))
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return queries, nil
+		}
+
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// Submit implements Host.
+func (h TxHost) Submit(_ context.Context, msgs []sdk.Msg) error {
+	// refresh the account sequence on every transaction
+	txf, err := h.txf.WithAccountNumber(0).WithSequence(0).Prepare(h.clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(h.clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	txb, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Sign(txf, h.clientCtx.GetFromName(), txb, true); err != nil {
+		return err
+	}
+
+	txBytes, err := h.clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := h.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	if res.Code != 0 {
+		return fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	return nil
+}
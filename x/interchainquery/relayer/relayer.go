@@ -0,0 +1,201 @@
+// Package relayer implements a long-running interchain query relayer, which
+// answers the queries emitted by the interchainquery EndBlocker of the host
+// chain with the proven results of the ABCI queries on the queried chain.
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+const (
+	subscriber = "icq-relayer"
+
+	// newBlockCapacity is the number of blocks buffered while the responses
+	// of a block are relayed.
+	newBlockCapacity = 100
+)
+
+// Relayer answers the interchain queries of a queried chain emitted by its
+// Host.
+type Relayer struct {
+	host      Host
+	remote    rpcclient.ABCIClient
+	chainID   string
+	batchSize int
+	backoff   Backoff
+	logger    log.Logger
+}
+
+// NewRelayer returns a Relayer answering the queries of the chain through the
+// remote client of one of its nodes. The responses are submitted in batches
+// of at most batchSize messages.
+func NewRelayer(
+	host Host,
+	remote rpcclient.ABCIClient,
+	chainID string,
+	batchSize int,
+	backoff Backoff,
+	logger log.Logger,
+) *Relayer {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	return &Relayer{
+		host:      host,
+		remote:    remote,
+		chainID:   chainID,
+		batchSize: batchSize,
+		backoff:   backoff,
+		logger:    logger,
+	}
+}
+
+// Start answers the pending queries, then runs the relayer on the new blocks
+// of the host until the context is cancelled. Failures are logged, and the
+// queries they affect are answered on their next emission.
+func (r *Relayer) Start(ctx context.Context, events rpcclient.EventsClient) error {
+	pending, err := r.host.PendingQueries(ctx, r.chainID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.Relay(ctx, pending); err != nil {
+		r.logger.Error("failed to relay the pending queries", "err", err)
+	}
+
+	blocks, err := events.Subscribe(ctx, subscriber, tmtypes.EventQueryNewBlock.String(), newBlockCapacity)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = events.UnsubscribeAll(context.Background(), subscriber)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-blocks:
+			if !ok {
+				return fmt.Errorf("new block subscription closed")
+			}
+
+			block, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+
+			if err := r.HandleEvents(ctx, block.ResultEndBlock.Events); err != nil {
+				r.logger.Error("failed to relay queries", "height", block.Block.Height, "err", err)
+			}
+		}
+	}
+}
+
+// HandleEvents answers the queries of the chain emitted among the events.
+func (r *Relayer) HandleEvents(ctx context.Context, events []abci.Event) error {
+	queries, err := ParseQueryEvents(events, r.chainID)
+	if err != nil {
+		return err
+	}
+
+	return r.Relay(ctx, queries)
+}
+
+// Relay runs the queries on the queried chain and submits their responses in
+// batches. The queries which can not be run are skipped.
+func (r *Relayer) Relay(ctx context.Context, queries []PendingQuery) error {
+	msgs := make([]sdk.Msg, 0, len(queries))
+
+	for _, query := range queries {
+		var msg sdk.Msg
+
+		err := r.backoff.Retry(ctx, func() (err error) {
+			msg, err = r.respond(ctx, query)
+			return err
+		})
+		if err != nil {
+			r.logger.Error("failed to run query", "id", query.ID, "type", query.QueryType, "err", err)
+			continue
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	var failed int
+
+	for start := 0; start < len(msgs); start += r.batchSize {
+		end := start + r.batchSize
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+
+		batch := msgs[start:end]
+
+		err := r.backoff.Retry(ctx, func() error {
+			return r.host.Submit(ctx, batch)
+		})
+		if err != nil {
+			r.logger.Error("failed to submit query responses", "responses", len(batch), "err", err)
+			failed += len(batch)
+
+			continue
+		}
+
+		r.logger.Info("submitted query responses", "responses", len(batch))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to submit %d of %d query responses", failed, len(msgs))
+	}
+
+	return nil
+}
+
+// respond runs the query on the queried chain and returns its response.
+func (r *Relayer) respond(ctx context.Context, query PendingQuery) (*types.MsgSubmitQueryResponse, error) {
+	height := query.Height
+	if height == 0 {
+		clientHeight, err := r.host.LatestClientHeight(ctx, query.ConnectionID)
+		if err != nil {
+			return nil, err
+		}
+
+		// the state of a height is committed to by the app hash of the next
+		// header, which is the latest consensus state of the light client
+		height = clientHeight - 1
+	}
+
+	res, err := r.remote.ABCIQueryWithOptions(ctx, "/"+query.QueryType, query.Request, rpcclient.ABCIQueryOptions{
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Response.IsErr() {
+		return nil, fmt.Errorf("query failed with code %d: %s", res.Response.Code, res.Response.Log)
+	}
+
+	return &types.MsgSubmitQueryResponse{
+		ChainId:     query.ChainID,
+		QueryId:     query.ID,
+		Result:      res.Response.Value,
+		ProofOps:    res.Response.ProofOps,
+		Height:      res.Response.Height,
+		FromAddress: r.host.Signer().String(),
+	}, nil
+}
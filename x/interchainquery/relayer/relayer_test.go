@@ -0,0 +1,330 @@
+package relayer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	furyapp "github.com/incubus-network/fanfury-sdk/v2/app"
+	"github.com/incubus-network/fanfury-sdk/v2/ibctesting"
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/keeper"
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/relayer"
+	icqtypes "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+const (
+	storeQueryType = "store/bank/key"
+	grpcQueryType  = "cosmos.bank.v1beta1.Query/AllBalances"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = furyapp.SetupTestingApp
+}
+
+// testHost is the Host of an in-process chain, delivering the responses
+// through its application.
+type testHost struct {
+	chain *ibctesting.TestChain
+
+	failures  int // number of submissions failing before the next succeeds
+	submitted [][]sdk.Msg
+}
+
+func (h *testHost) Signer() sdk.AccAddress {
+	return h.chain.SenderAccount.GetAddress()
+}
+
+func (h *testHost) LatestClientHeight(_ context.Context, connectionID string) (int64, error) {
+	connection, found := h.chain.App.GetIBCKeeper().ConnectionKeeper.GetConnection(h.chain.GetContext(), connectionID)
+	if !found {
+		return 0, errors.New("connection not found")
+	}
+
+	return int64(h.chain.GetClientState(connection.ClientId).GetLatestHeight().GetRevisionHeight()), nil
+}
+
+func (h *testHost) PendingQueries(ctx context.Context, chainID string) ([]relayer.PendingQuery, error) {
+	app, ok := h.chain.App.(*furyapp.FuryApp)
+	if !ok {
+		return nil, errors.New("not fury app")
+	}
+
+	res, err := keeper.NewQuerier(app.InterchainQueryKeeper).Queries(
+		sdk.WrapSDKContext(h.chain.GetContext()),
+		&icqtypes.QueryRequestsRequest{ChainId: chainID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]relayer.PendingQuery, 0, len(res.Queries))
+	for _, query := range res.Queries {
+		queries = append(queries, relayer.PendingQuery{
+			ID:           query.Id,
+			ConnectionID: query.ConnectionId,
+			ChainID:      query.ChainId,
+			QueryType:    query.QueryType,
+			Request:      query.Request,
+		})
+	}
+
+	return queries, nil
+}
+
+func (h *testHost) Submit(_ context.Context, msgs []sdk.Msg) error {
+	if h.failures > 0 {
+		h.failures--
+		return errors.New("node unavailable")
+	}
+
+	if _, err := h.chain.SendMsgs(msgs...); err != nil {
+		return err
+	}
+
+	h.submitted = append(h.submitted, msgs)
+
+	return nil
+}
+
+// testRemote runs the ABCI queries against the application of an in-process
+// chain.
+type testRemote struct {
+	rpcclient.ABCIClient
+
+	chain *ibctesting.TestChain
+}
+
+func (r testRemote) ABCIQueryWithOptions(
+	_ context.Context,
+	path string,
+	data tmbytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	res := r.chain.App.Query(abci.RequestQuery{
+		Path:   path,
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
+
+type RelayerTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// chainA emits the queries of chainB
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func TestRelayerTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerTestSuite))
+}
+
+func (suite *RelayerTestSuite) SetupTest() {
+	// the chain IDs of the responses must carry a revision number
+	suite.coordinator = &ibctesting.Coordinator{
+		T:           suite.T(),
+		CurrentTime: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		Chains:      make(map[string]*ibctesting.TestChain),
+	}
+
+	for _, chainID := range []string{"testchain-1", "testchain-2"} {
+		suite.coordinator.Chains[chainID] = ibctesting.NewTestChain(suite.T(), suite.coordinator, chainID)
+	}
+
+	suite.chainA = suite.coordinator.GetChain("testchain-1")
+	suite.chainB = suite.coordinator.GetChain("testchain-2")
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.coordinator.SetupConnections(suite.path)
+}
+
+func (suite *RelayerTestSuite) GetFuryApp(chain *ibctesting.TestChain) *furyapp.FuryApp {
+	app, ok := chain.App.(*furyapp.FuryApp)
+	if !ok {
+		panic("not fury app")
+	}
+
+	return app
+}
+
+// registerQueries registers a proven store query of the balance of the chainB
+// sender on chainA, and requests a gRPC query of its balances as modules do,
+// and returns their IDs.
+func (suite *RelayerTestSuite) registerQueries() (storeQueryID, grpcQueryID string) {
+	owner := suite.chainA.SenderAccount.GetAddress()
+	remoteAddr := suite.chainB.SenderAccount.GetAddress()
+	period := int64(icqtypes.DefaultMinQueryPeriod)
+
+	storeRequest := append(banktypes.CreateAccountBalancesPrefix(remoteAddr), []byte(sdk.DefaultBondDenom)...)
+
+	grpcRequest, err := (&banktypes.QueryAllBalancesRequest{Address: remoteAddr.String()}).Marshal()
+	suite.Require().NoError(err)
+
+	// the accounts only register proven queries
+	suite.GetFuryApp(suite.chainA).InterchainQueryKeeper.MakeRequest(
+		suite.chainA.GetContext(), suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, grpcQueryType, grpcRequest,
+		sdk.NewInt(period), "", "", 100,
+	)
+
+	_, err = suite.chainA.SendMsgs(
+		icqtypes.NewMsgRegisterInterchainQuery(owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, storeQueryType, storeRequest, period, 100, 0, 0, nil, nil, 0),
+	)
+	suite.Require().NoError(err)
+
+	storeQueryID = keeper.GenerateQueryHash(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, storeQueryType, storeRequest, owner.String())
+	grpcQueryID = keeper.GenerateQueryHash(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, grpcQueryType, grpcRequest, "")
+
+	return storeQueryID, grpcQueryID
+}
+
+func (suite *RelayerTestSuite) newRelayer(host relayer.Host, batchSize int) *relayer.Relayer {
+	return relayer.NewRelayer(
+		host,
+		testRemote{chain: suite.chainB},
+		suite.chainB.ChainID,
+		batchSize,
+		relayer.Backoff{Interval: time.Millisecond, MaxRetries: 2},
+		log.NewNopLogger(),
+	)
+}
+
+func (suite *RelayerTestSuite) TestRelayEmittedQueries() {
+	storeQueryID, grpcQueryID := suite.registerQueries()
+
+	// the queries are emitted by the block including their registration
+	queries, err := relayer.ParseQueryEvents(suite.chainA.LastEvents, suite.chainB.ChainID)
+	suite.Require().NoError(err)
+	suite.Require().Len(queries, 2)
+
+	host := &testHost{chain: suite.chainA, failures: 1}
+	suite.Require().NoError(suite.newRelayer(host, 10).HandleEvents(context.Background(), suite.chainA.LastEvents))
+
+	// both responses are submitted in a single transaction, after a retry
+	suite.Require().Len(host.submitted, 1)
+	suite.Require().Len(host.submitted[0], 2)
+
+	app := suite.GetFuryApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	// the proven balance is stored at the height the light client verifies
+	clientHeight, err := host.LatestClientHeight(context.Background(), suite.path.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+
+	dataPoint, err := app.InterchainQueryKeeper.GetDatapointForID(ctx, storeQueryID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clientHeight-1, dataPoint.RemoteHeight.Int64())
+
+	// the bank store holds the amount of each balance
+	var amount sdk.Int
+	suite.Require().NoError(amount.Unmarshal(dataPoint.Value))
+
+	balance := suite.GetFuryApp(suite.chainB).BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(balance.Amount, amount)
+
+	dataPoint, err = app.InterchainQueryKeeper.GetDatapointForID(ctx, grpcQueryID)
+	suite.Require().NoError(err)
+
+	var balances banktypes.QueryAllBalancesResponse
+	suite.Require().NoError(app.AppCodec().Unmarshal(dataPoint.Value, &balances))
+	suite.Require().Equal(balance.Amount, balances.Balances.AmountOf(sdk.DefaultBondDenom))
+
+	// the events of other chains are ignored
+	queries, err = relayer.ParseQueryEvents(suite.chainA.LastEvents, "otherchain-1")
+	suite.Require().NoError(err)
+	suite.Require().Empty(queries)
+}
+
+func (suite *RelayerTestSuite) TestRelayBatches() {
+	suite.registerQueries()
+
+	host := &testHost{chain: suite.chainA}
+	suite.Require().NoError(suite.newRelayer(host, 1).HandleEvents(context.Background(), suite.chainA.LastEvents))
+
+	suite.Require().Len(host.submitted, 2)
+	suite.Require().Len(host.submitted[0], 1)
+	suite.Require().Len(host.submitted[1], 1)
+}
+
+func (suite *RelayerTestSuite) TestRelayPendingQueries() {
+	storeQueryID, grpcQueryID := suite.registerQueries()
+
+	host := &testHost{chain: suite.chainA}
+	pending, err := host.PendingQueries(context.Background(), suite.chainB.ChainID)
+	suite.Require().NoError(err)
+	suite.Require().Len(pending, 2)
+
+	suite.Require().NoError(suite.newRelayer(host, 10).Relay(context.Background(), pending))
+	suite.Require().Len(host.submitted, 1)
+
+	app := suite.GetFuryApp(suite.chainA)
+	for _, id := range []string{storeQueryID, grpcQueryID} {
+		_, err := app.InterchainQueryKeeper.GetDatapointForID(suite.chainA.GetContext(), id)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *RelayerTestSuite) TestRelayFixedHeight() {
+	owner := suite.chainA.SenderAccount.GetAddress()
+	remoteAddr := suite.chainB.SenderAccount.GetAddress()
+	request := append(banktypes.CreateAccountBalancesPrefix(remoteAddr), []byte(sdk.DefaultBondDenom)...)
+
+	host := &testHost{chain: suite.chainA}
+	clientHeight, err := host.LatestClientHeight(context.Background(), suite.path.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+
+	// a query of a past height the light client can verify
+	height := clientHeight - 1
+	_, err = suite.chainA.SendMsgs(icqtypes.NewMsgRegisterInterchainQuery(
+		owner, suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, storeQueryType, request, -1, 100, 0, 0, nil, nil, height,
+	))
+	suite.Require().NoError(err)
+
+	events := suite.chainA.LastEvents
+	queries, err := relayer.ParseQueryEvents(events, suite.chainB.ChainID)
+	suite.Require().NoError(err)
+	suite.Require().Len(queries, 1)
+	suite.Require().Equal(height, queries[0].Height)
+
+	// the light client moves past the height of the query
+	suite.coordinator.CommitNBlocks(suite.chainB, 3)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	suite.Require().NoError(suite.newRelayer(host, 10).HandleEvents(context.Background(), events))
+	suite.Require().Len(host.submitted, 1)
+
+	msg, ok := host.submitted[0][0].(*icqtypes.MsgSubmitQueryResponse)
+	suite.Require().True(ok)
+	suite.Require().Equal(height, msg.Height)
+
+	// the query answered once is deleted after its response is verified
+	id := keeper.GenerateQueryHash(suite.path.EndpointA.ConnectionID, suite.chainB.ChainID, storeQueryType, request, owner.String())
+	_, found := suite.GetFuryApp(suite.chainA).InterchainQueryKeeper.GetQuery(suite.chainA.GetContext(), id)
+	suite.Require().False(found)
+}
+
+func (suite *RelayerTestSuite) TestRelayFailure() {
+	suite.registerQueries()
+
+	// the submissions fail after all the retries
+	host := &testHost{chain: suite.chainA, failures: 10}
+	suite.Require().Error(suite.newRelayer(host, 10).HandleEvents(context.Background(), suite.chainA.LastEvents))
+	suite.Require().Empty(host.submitted)
+}
@@ -10,3 +10,10 @@ type QueryCallbacks interface {
 	Call(ctx sdk.Context, id string, args []byte, query Query) error
 	Has(id string) bool
 }
+
+// QueryTimeoutCallbacks is optionally implemented by the QueryCallbacks of a
+// module to be notified of its queries timing out.
+type QueryTimeoutCallbacks interface {
+	QueryCallbacks
+	OnTimeout(ctx sdk.Context, query Query) error
+}
@@ -15,11 +15,17 @@ var (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitQueryResponse{}, "persistence-sdk/MsgSubmitQueryResponse", nil)
+	cdc.RegisterConcrete(&MsgRegisterInterchainQuery{}, "persistence-sdk/MsgRegisterInterchainQuery", nil)
+	cdc.RegisterConcrete(&MsgRemoveInterchainQuery{}, "persistence-sdk/MsgRemoveInterchainQuery", nil)
+	cdc.RegisterConcrete(&MsgRequeryInterchainQuery{}, "persistence-sdk/MsgRequeryInterchainQuery", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitQueryResponse{},
+		&MsgRegisterInterchainQuery{},
+		&MsgRemoveInterchainQuery{},
+		&MsgRequeryInterchainQuery{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,8 +1,23 @@
 package types
 
-import "errors"
+import (
+	"errors"
+
+	sdkerrors "cosmossdk.io/errors"
+)
 
 var (
 	ErrAlreadyFulfilled  = errors.New("query already fulfilled")
 	ErrSucceededNoDelete = errors.New("query succeeded; do not not execute default behavior")
 )
+
+// interchainquery module sentinel errors
+var (
+	ErrUnknownCallback = sdkerrors.Register(ModuleName, 2, "unknown callback")
+	ErrInvalidQuery    = sdkerrors.Register(ModuleName, 3, "invalid query")
+	ErrQueryExists     = sdkerrors.Register(ModuleName, 4, "query already registered")
+	ErrQueryNotFound   = sdkerrors.Register(ModuleName, 5, "query not found")
+	ErrUnauthorized    = sdkerrors.Register(ModuleName, 6, "query is not owned by the account")
+	ErrInvalidProof    = sdkerrors.Register(ModuleName, 7, "invalid proof")
+	ErrInvalidHeight   = sdkerrors.Register(ModuleName, 8, "invalid response height")
+)
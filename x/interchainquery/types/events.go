@@ -1,6 +1,12 @@
 package types
 
 const (
+	EventTypeRegisterQuery = "register_query"
+	EventTypeRemoveQuery   = "remove_query"
+	EventTypeQueryResponse = "query_response"
+	EventTypeQueryTimeout  = "query_timeout"
+	EventTypeRequeryQuery  = "requery_query"
+
 	AttributeKeyQueryID      = "query_id"
 	AttributeKeyChainID      = "chain_id"
 	AttributeKeyConnectionID = "connection_id"
@@ -8,6 +14,13 @@ const (
 	AttributeKeyParams       = "parameters"
 	AttributeKeyRequest      = "request"
 	AttributeKeyHeight       = "height"
+	AttributeKeyOwner        = "owner"
+	AttributeKeyDeposit      = "deposit"
+	AttributeKeyResult       = "result"
+	AttributeKeyRetries      = "retries"
+	AttributeKeyFee          = "fee"
+	AttributeKeyFeeEscrow    = "fee_escrow"
+	AttributeKeyRelayer      = "relayer"
 
 	AttributeValueCategory = ModuleName
 	AttributeValueQuery    = "query"
@@ -1,18 +1,86 @@
 package types
 
-func NewGenesisState(queries []Query) *GenesisState {
-	return &GenesisState{Queries: queries}
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+func NewGenesisState(queries []Query, params Params) *GenesisState {
+	return &GenesisState{Queries: queries, Params: params}
 }
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	queries := []Query{}
-	return NewGenesisState(queries)
+	return NewGenesisState(queries, DefaultParams())
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: validate genesis state.
+	ids := make(map[string]bool, len(gs.Queries))
+
+	for _, query := range gs.Queries {
+		if err := query.Validate(); err != nil {
+			return err
+		}
+
+		if ids[query.Id] {
+			return fmt.Errorf("duplicate query %s", query.Id)
+		}
+
+		ids[query.Id] = true
+	}
+
+	return gs.Params.Validate()
+}
+
+// Validate performs a basic validation of the query fields.
+func (q Query) Validate() error {
+	if strings.TrimSpace(q.Id) == "" {
+		return fmt.Errorf("query id cannot be empty")
+	}
+
+	if err := host.ConnectionIdentifierValidator(q.ConnectionId); err != nil {
+		return fmt.Errorf("query %s has invalid connection id: %w", q.Id, err)
+	}
+
+	if strings.TrimSpace(q.ChainId) == "" {
+		return fmt.Errorf("query %s has empty chain id", q.Id)
+	}
+
+	if strings.TrimSpace(q.QueryType) == "" {
+		return fmt.Errorf("query %s has empty query type", q.Id)
+	}
+
+	if q.Period.IsNil() {
+		return fmt.Errorf("query %s has no period", q.Id)
+	}
+
+	if q.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(q.Owner); err != nil {
+			return fmt.Errorf("query %s has invalid owner: %w", q.Id, err)
+		}
+	}
+
+	if q.MaxRetries > 0 && q.Timeout == 0 {
+		return fmt.Errorf("query %s has retries without a timeout", q.Id)
+	}
+
+	if err := q.Deposit.Validate(); err != nil {
+		return fmt.Errorf("query %s has invalid deposit: %w", q.Id, err)
+	}
+
+	if err := q.Fee.Validate(); err != nil {
+		return fmt.Errorf("query %s has invalid fee: %w", q.Id, err)
+	}
+
+	if err := q.FeeEscrow.Validate(); err != nil {
+		return fmt.Errorf("query %s has invalid fee escrow: %w", q.Id, err)
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -36,6 +37,38 @@ type Query struct {
 	CallbackId   string                                 `protobuf:"bytes,8,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	Ttl          uint64                                 `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	LastEmission github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=last_emission,json=lastEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_emission"`
+	// owner is the account that registered the query through
+	// MsgRegisterInterchainQuery, empty for the queries of modules.
+	Owner string `protobuf:"bytes,11,opt,name=owner,proto3" json:"owner,omitempty"`
+	// deposit is escrowed from the owner and refunded on removal.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// module is the module that registered the query, empty for the queries
+	// registered through MsgRegisterInterchainQuery.
+	Module string `protobuf:"bytes,13,opt,name=module,proto3" json:"module,omitempty"`
+	// timeout is the number of blocks after an emission of the query without
+	// response before the query is emitted again, doubling with each retry, or
+	// 0 for a query never timing out.
+	Timeout uint64 `protobuf:"varint,14,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// max_retries is the number of times an emission of the query is retried
+	// before the query times out.
+	MaxRetries uint64 `protobuf:"varint,15,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// retries is the number of times the pending emission of the query was
+	// retried.
+	Retries uint64 `protobuf:"varint,16,opt,name=retries,proto3" json:"retries,omitempty"`
+	// timed_out is set once the retries of the query are exhausted. A timed out
+	// query is no longer emitted, and ignores the responses submitted for it. It
+	// is deleted if not re-requested within a retention period.
+	TimedOut bool `protobuf:"varint,17,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// fee is paid from the fee escrow to the first valid response of each
+	// emission of the query.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// fee_escrow holds the outstanding fees escrowed from the owner, refunded
+	// once the query is removed, answered for the last time or timed out.
+	FeeEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=fee_escrow,json=feeEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_escrow"`
+	// height is the remote height the query is run at, or 0 for the latest
+	// height the light client of the connection can verify. The responses of
+	// another height are rejected.
+	Height int64 `protobuf:"varint,20,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -120,6 +153,76 @@ func (m *Query) GetTtl() uint64 {
 	return 0
 }
 
+func (m *Query) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Query) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Query) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *Query) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *Query) GetMaxRetries() uint64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *Query) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Query) GetTimedOut() bool {
+	if m != nil {
+		return m.TimedOut
+	}
+	return false
+}
+
+func (m *Query) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *Query) GetFeeEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeEscrow
+	}
+	return nil
+}
+
+func (m *Query) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type DataPoint struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// change these to uint64 in v0.5.0
@@ -175,16 +278,93 @@ func (m *DataPoint) GetValue() []byte {
 	return nil
 }
 
+// Params defines the parameters of the interchainquery module.
+type Params struct {
+	// query_deposit is escrowed from the owner of every query registered
+	// through MsgRegisterInterchainQuery.
+	QueryDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=query_deposit,json=queryDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"query_deposit" yaml:"query_deposit"`
+	// min_query_period is the minimum number of blocks between two emissions
+	// of a periodic query registered through MsgRegisterInterchainQuery.
+	MinQueryPeriod uint64 `protobuf:"varint,2,opt,name=min_query_period,json=minQueryPeriod,proto3" json:"min_query_period,omitempty" yaml:"min_query_period"`
+	// max_query_ttl is the maximum number of blocks the results of a query
+	// registered through MsgRegisterInterchainQuery are stored.
+	MaxQueryTtl uint64 `protobuf:"varint,3,opt,name=max_query_ttl,json=maxQueryTtl,proto3" json:"max_query_ttl,omitempty" yaml:"max_query_ttl"`
+	// max_query_retries is the maximum number of retries of a query registered
+	// through MsgRegisterInterchainQuery.
+	MaxQueryRetries uint64 `protobuf:"varint,4,opt,name=max_query_retries,json=maxQueryRetries,proto3" json:"max_query_retries,omitempty" yaml:"max_query_retries"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85a77029fc4dd912, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetQueryDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.QueryDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetMinQueryPeriod() uint64 {
+	if m != nil {
+		return m.MinQueryPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxQueryTtl() uint64 {
+	if m != nil {
+		return m.MaxQueryTtl
+	}
+	return 0
+}
+
+func (m *Params) GetMaxQueryRetries() uint64 {
+	if m != nil {
+		return m.MaxQueryRetries
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Queries []Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Params  Params  `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85a77029fc4dd912, []int{2}
+	return fileDescriptor_85a77029fc4dd912, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,9 +400,17 @@ func (m *GenesisState) GetQueries() []Query {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*Query)(nil), "persistence.interchainquery.v1beta1.Query")
 	proto.RegisterType((*DataPoint)(nil), "persistence.interchainquery.v1beta1.DataPoint")
+	proto.RegisterType((*Params)(nil), "persistence.interchainquery.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "persistence.interchainquery.v1beta1.GenesisState")
 }
 
@@ -231,39 +419,61 @@ func init() {
 }
 
 var fileDescriptor_85a77029fc4dd912 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xda, 0x40,
-	0x10, 0xc6, 0x31, 0x04, 0x08, 0x83, 0xa9, 0xa2, 0x55, 0x0e, 0x6e, 0xa5, 0x1a, 0x44, 0xa4, 0x0a,
-	0x45, 0xad, 0x2d, 0xd2, 0x37, 0x40, 0xe9, 0x1f, 0x7a, 0x49, 0x63, 0x7a, 0xca, 0xa1, 0xc8, 0xd8,
-	0x23, 0x58, 0xc5, 0xde, 0x75, 0xbc, 0x03, 0x2a, 0x6f, 0xd1, 0x57, 0xe9, 0x5b, 0xe4, 0x98, 0x63,
-	0xd5, 0x03, 0xaa, 0xe0, 0xd6, 0x4b, 0x5f, 0xa1, 0xda, 0xc5, 0xa8, 0xa8, 0xb9, 0x54, 0x9c, 0xbc,
-	0xbb, 0x33, 0xdf, 0x6f, 0xe6, 0x93, 0x67, 0xa0, 0x9f, 0x61, 0xae, 0xb8, 0x22, 0x14, 0x11, 0xfa,
-	0x5c, 0x10, 0xe6, 0xd1, 0x2c, 0xe4, 0xe2, 0x6e, 0x8e, 0xf9, 0xd2, 0x5f, 0xf4, 0x27, 0x48, 0x61,
-	0xdf, 0x9f, 0xa2, 0x40, 0xc5, 0x95, 0x97, 0xe5, 0x92, 0x24, 0x3b, 0xdb, 0x93, 0x78, 0xff, 0x48,
-	0xbc, 0x42, 0xf2, 0xec, 0x74, 0x2a, 0xa7, 0xd2, 0xe4, 0xfb, 0xfa, 0xb4, 0x95, 0x76, 0xbf, 0x55,
-	0xa0, 0x7a, 0xad, 0xf3, 0xd8, 0x13, 0x28, 0xf3, 0xd8, 0xb1, 0x3a, 0x56, 0xaf, 0x11, 0x94, 0x79,
-	0xcc, 0xce, 0xa0, 0x15, 0x49, 0x21, 0x30, 0x22, 0x2e, 0xc5, 0x98, 0xc7, 0x4e, 0xd9, 0x84, 0xec,
-	0xbf, 0x8f, 0xc3, 0x98, 0x3d, 0x85, 0x63, 0x53, 0x4a, 0xc7, 0x2b, 0x26, 0x5e, 0x37, 0xf7, 0x61,
-	0xcc, 0x9e, 0x03, 0x98, 0x06, 0xc6, 0xb4, 0xcc, 0xd0, 0x39, 0x32, 0xc1, 0x86, 0x79, 0xf9, 0xb4,
-	0xcc, 0x90, 0x39, 0x50, 0xcf, 0xf1, 0x6e, 0x8e, 0x8a, 0x9c, 0x6a, 0xc7, 0xea, 0xd9, 0xc1, 0xee,
-	0xca, 0xde, 0x42, 0x2d, 0xc3, 0x9c, 0xcb, 0xd8, 0xa9, 0x69, 0xd1, 0xc0, 0xbb, 0x5f, 0xb5, 0x4b,
-	0x3f, 0x56, 0xed, 0x17, 0x53, 0x4e, 0xb3, 0xf9, 0xc4, 0x8b, 0x64, 0xea, 0x47, 0x52, 0xa5, 0x52,
-	0x15, 0x9f, 0x57, 0x2a, 0xbe, 0xf5, 0x75, 0x15, 0xe5, 0x0d, 0x05, 0x05, 0x85, 0x9a, 0x5d, 0x41,
-	0x33, 0x09, 0x15, 0x8d, 0x67, 0xc8, 0xa7, 0x33, 0x72, 0xea, 0x07, 0xc1, 0x40, 0x23, 0xde, 0x1b,
-	0x02, 0x6b, 0x43, 0x33, 0x0a, 0x93, 0x64, 0x12, 0x46, 0xb7, 0xda, 0xef, 0xb1, 0xb1, 0x04, 0xbb,
-	0xa7, 0x61, 0xcc, 0x4e, 0xa0, 0x42, 0x94, 0x38, 0x8d, 0x8e, 0xd5, 0x3b, 0x0a, 0xf4, 0x91, 0x8d,
-	0xa0, 0x65, 0x7a, 0xc0, 0x94, 0x2b, 0xc5, 0xa5, 0x70, 0xe0, 0xa0, 0x2e, 0x6c, 0x0d, 0x79, 0x53,
-	0x30, 0xba, 0xbf, 0x2d, 0x68, 0x5c, 0x86, 0x14, 0x7e, 0x94, 0x5c, 0xd0, 0xa3, 0xff, 0x36, 0x82,
-	0x56, 0x8e, 0xa9, 0x24, 0xdc, 0x19, 0x2f, 0x1f, 0x56, 0x72, 0x0b, 0x29, 0xac, 0x5f, 0x83, 0x9d,
-	0xc8, 0x28, 0x4c, 0x76, 0xcc, 0xca, 0x41, 0xcc, 0xa6, 0x61, 0x14, 0xc8, 0x73, 0xa8, 0x2e, 0xc2,
-	0x64, 0xbe, 0x1d, 0x0d, 0x7b, 0x70, 0xfa, 0x6b, 0xd5, 0x3e, 0xc9, 0x51, 0xcd, 0x13, 0x7a, 0x29,
-	0x53, 0x4e, 0x98, 0x66, 0xb4, 0x0c, 0xb6, 0x29, 0xdd, 0x1b, 0xb0, 0xdf, 0x6d, 0x27, 0x7e, 0x44,
-	0x21, 0x21, 0xfb, 0x00, 0x75, 0x3d, 0x49, 0x1c, 0x95, 0x63, 0x75, 0x2a, 0xbd, 0xe6, 0xc5, 0xb9,
-	0xf7, 0x1f, 0x2b, 0xe0, 0x99, 0x41, 0x1f, 0x1c, 0xe9, 0xae, 0x83, 0x1d, 0x60, 0xf0, 0xf9, 0x7e,
-	0xed, 0x5a, 0x0f, 0x6b, 0xd7, 0xfa, 0xb9, 0x76, 0xad, 0xaf, 0x1b, 0xb7, 0xf4, 0xb0, 0x71, 0x4b,
-	0xdf, 0x37, 0x6e, 0xe9, 0xe6, 0x72, 0xcf, 0xd6, 0x1e, 0xfe, 0x4a, 0xe0, 0xfe, 0xd5, 0x78, 0x5c,
-	0x5c, 0xf8, 0x5f, 0x1e, 0x2d, 0xab, 0x31, 0x3e, 0xa9, 0x99, 0x45, 0x7b, 0xfd, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xb4, 0x4c, 0x7e, 0x0d, 0xd8, 0x03, 0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0x80, 0xbd, 0xb6, 0x63, 0xc7, 0xcf, 0x76, 0x9a, 0x0e, 0x16, 0x4c, 0x5b, 0xb0, 0x2d, 0x57,
+	0x42, 0x56, 0xa1, 0xbb, 0x24, 0xdc, 0x2a, 0x2e, 0x98, 0x06, 0x62, 0x2e, 0x4d, 0x37, 0x3d, 0x81,
+	0x90, 0xb5, 0xde, 0x7d, 0x76, 0x86, 0xec, 0xce, 0xb8, 0x3b, 0xb3, 0x49, 0xfc, 0x0f, 0x38, 0x72,
+	0x44, 0xe2, 0xc2, 0x85, 0x0b, 0xbf, 0xa4, 0xc7, 0x1e, 0x11, 0x07, 0x83, 0x92, 0x0b, 0xe2, 0x82,
+	0x94, 0x5f, 0x80, 0x66, 0x66, 0x97, 0xb4, 0xe9, 0x81, 0x10, 0xe5, 0xb4, 0xfb, 0xde, 0x9b, 0xf7,
+	0xbd, 0x37, 0xef, 0xbd, 0x99, 0x81, 0xad, 0x05, 0xa6, 0x92, 0x49, 0x85, 0x3c, 0x44, 0x8f, 0x71,
+	0x85, 0x69, 0x78, 0x10, 0x30, 0xfe, 0x3c, 0xc3, 0x74, 0xe9, 0x1d, 0x6d, 0x4d, 0x51, 0x05, 0x5b,
+	0xde, 0x1c, 0x39, 0x4a, 0x26, 0xdd, 0x45, 0x2a, 0x94, 0x20, 0xf7, 0x5f, 0x71, 0x71, 0x2f, 0xb9,
+	0xb8, 0xb9, 0xcb, 0xdd, 0xce, 0x5c, 0xcc, 0x85, 0x59, 0xef, 0xe9, 0x3f, 0xeb, 0x7a, 0xb7, 0x1b,
+	0x0a, 0x99, 0x08, 0xe9, 0x4d, 0x03, 0x89, 0xff, 0xd2, 0x43, 0xc1, 0xb8, 0xb5, 0x0f, 0x7e, 0xac,
+	0xc3, 0xda, 0x53, 0xcd, 0x21, 0x1b, 0x50, 0x66, 0x11, 0x75, 0xfa, 0xce, 0xb0, 0xe1, 0x97, 0x59,
+	0x44, 0xee, 0x43, 0x3b, 0x14, 0x9c, 0x63, 0xa8, 0x98, 0xe0, 0x13, 0x16, 0xd1, 0xb2, 0x31, 0xb5,
+	0x2e, 0x94, 0xe3, 0x88, 0xdc, 0x81, 0x75, 0x93, 0x8a, 0xb6, 0x57, 0x8c, 0xbd, 0x6e, 0xe4, 0x71,
+	0x44, 0xde, 0x03, 0x30, 0x09, 0x4e, 0xd4, 0x72, 0x81, 0xb4, 0x6a, 0x8c, 0x0d, 0xa3, 0x79, 0xb6,
+	0x5c, 0x20, 0xa1, 0x50, 0x4f, 0xf1, 0x79, 0x86, 0x52, 0xd1, 0xb5, 0xbe, 0x33, 0x6c, 0xf9, 0x85,
+	0x48, 0x3e, 0x87, 0xda, 0x02, 0x53, 0x26, 0x22, 0x5a, 0xd3, 0x4e, 0x23, 0xf7, 0xc5, 0xaa, 0x57,
+	0xfa, 0x6d, 0xd5, 0x7b, 0x7f, 0xce, 0xd4, 0x41, 0x36, 0x75, 0x43, 0x91, 0x78, 0xf9, 0xae, 0xec,
+	0xe7, 0xa1, 0x8c, 0x0e, 0x3d, 0x1d, 0x45, 0xba, 0x63, 0xae, 0xfc, 0xdc, 0x9b, 0x3c, 0x81, 0x66,
+	0x1c, 0x48, 0x35, 0x39, 0x40, 0x36, 0x3f, 0x50, 0xb4, 0x7e, 0x2d, 0x18, 0x68, 0xc4, 0xae, 0x21,
+	0x90, 0x1e, 0x34, 0xc3, 0x20, 0x8e, 0xa7, 0x41, 0x78, 0xa8, 0xf7, 0xbb, 0x6e, 0xb6, 0x04, 0x85,
+	0x6a, 0x1c, 0x91, 0x4d, 0xa8, 0x28, 0x15, 0xd3, 0x46, 0xdf, 0x19, 0x56, 0x7d, 0xfd, 0x4b, 0xf6,
+	0xa1, 0x6d, 0x72, 0xc0, 0x84, 0x49, 0xc9, 0x04, 0xa7, 0x70, 0xad, 0x2c, 0x5a, 0x1a, 0xb2, 0x93,
+	0x33, 0x48, 0x07, 0xd6, 0xc4, 0x31, 0xc7, 0x94, 0x36, 0x4d, 0x06, 0x56, 0x20, 0x08, 0xf5, 0x08,
+	0x17, 0x42, 0x32, 0x45, 0x5b, 0xfd, 0xca, 0xb0, 0xb9, 0x7d, 0xc7, 0xb5, 0x2c, 0x57, 0xf7, 0xbe,
+	0x18, 0x13, 0xf7, 0x33, 0xc1, 0xf8, 0xe8, 0x23, 0x1d, 0xff, 0x97, 0xdf, 0x7b, 0xc3, 0x2b, 0xc4,
+	0xd7, 0x0e, 0xd2, 0x2f, 0xd8, 0xe4, 0x6d, 0xa8, 0x25, 0x22, 0xca, 0x62, 0xa4, 0x6d, 0x13, 0x3d,
+	0x97, 0x74, 0x3f, 0x15, 0x4b, 0x50, 0x64, 0x8a, 0x6e, 0x98, 0xfd, 0x17, 0xa2, 0x2e, 0x5b, 0x12,
+	0x9c, 0x4c, 0x52, 0x54, 0x29, 0x43, 0x49, 0x6f, 0x19, 0x2b, 0x24, 0xc1, 0x89, 0x6f, 0x35, 0x76,
+	0x14, 0xac, 0x71, 0xd3, 0xba, 0xe6, 0x22, 0xb9, 0x07, 0x0d, 0x4d, 0x89, 0x26, 0x1a, 0x7b, 0xbb,
+	0xef, 0x0c, 0xd7, 0xfd, 0x75, 0xa3, 0x78, 0x92, 0x29, 0xf2, 0x0d, 0x54, 0x66, 0x88, 0x94, 0xdc,
+	0xfc, 0x66, 0x35, 0x97, 0x7c, 0x0b, 0x30, 0x43, 0x9c, 0xa0, 0x0c, 0x53, 0x71, 0x4c, 0xdf, 0xba,
+	0xf9, 0x28, 0x8d, 0x19, 0xe2, 0x8e, 0xa1, 0xeb, 0xa2, 0xe6, 0x53, 0xda, 0xe9, 0x3b, 0xc3, 0x8a,
+	0x9f, 0x4b, 0x83, 0xbf, 0x1d, 0x68, 0x3c, 0x0e, 0x54, 0xb0, 0x27, 0x18, 0x57, 0x6f, 0x9c, 0xd0,
+	0x7d, 0x68, 0xa7, 0x98, 0x08, 0x85, 0xc5, 0x88, 0x97, 0xaf, 0x37, 0x5c, 0x16, 0x92, 0x0f, 0xf9,
+	0x53, 0x68, 0xc5, 0x22, 0x0c, 0xe2, 0x82, 0x59, 0xb9, 0x16, 0xb3, 0x69, 0x18, 0x39, 0xf2, 0x01,
+	0xac, 0x1d, 0x05, 0x71, 0x66, 0x2f, 0x81, 0xd6, 0xa8, 0xf3, 0xd7, 0xaa, 0xb7, 0x99, 0xa2, 0xcc,
+	0x62, 0xf5, 0xa1, 0x48, 0x98, 0xc2, 0x64, 0xa1, 0x96, 0xbe, 0x5d, 0x32, 0xf8, 0xb3, 0x0c, 0xb5,
+	0xbd, 0x20, 0x0d, 0x12, 0x49, 0xbe, 0x73, 0xa0, 0x6d, 0x6f, 0x90, 0x62, 0xae, 0x9d, 0xff, 0x6a,
+	0xc2, 0xae, 0x4e, 0xf3, 0x7c, 0xd5, 0xeb, 0x2c, 0x83, 0x24, 0x7e, 0x34, 0x78, 0xcd, 0x7b, 0xf0,
+	0xbf, 0x9a, 0xd3, 0x32, 0xbe, 0x8f, 0xf3, 0xa1, 0xdf, 0x81, 0xcd, 0x84, 0xf1, 0x89, 0xe5, 0xe5,
+	0x97, 0x93, 0x2e, 0x76, 0x75, 0x74, 0xef, 0x7c, 0xd5, 0x7b, 0xc7, 0x46, 0xbb, 0xbc, 0x62, 0xe0,
+	0x6f, 0x24, 0x8c, 0x9b, 0xcb, 0x75, 0xcf, 0x28, 0xc8, 0x27, 0xd0, 0xd6, 0x27, 0xc1, 0x2e, 0xd2,
+	0x37, 0x45, 0xc5, 0x30, 0xe8, 0x45, 0xc6, 0xaf, 0x99, 0x07, 0xbe, 0x3e, 0x38, 0x06, 0xf0, 0x4c,
+	0xc5, 0x64, 0x17, 0x6e, 0x5f, 0x98, 0x8b, 0x03, 0x53, 0x35, 0x84, 0x77, 0xcf, 0x57, 0x3d, 0x7a,
+	0x99, 0x90, 0x2f, 0x19, 0xf8, 0xb7, 0x0a, 0x4a, 0x7e, 0xe0, 0x1e, 0x55, 0x7f, 0xf8, 0xa9, 0x57,
+	0x1a, 0xfc, 0xec, 0x40, 0xeb, 0x0b, 0xfb, 0xce, 0xec, 0xab, 0x40, 0x21, 0xf9, 0x12, 0xea, 0xda,
+	0x53, 0x63, 0x6d, 0xa5, 0x1f, 0xb8, 0x57, 0x78, 0x78, 0x5c, 0x83, 0x1e, 0x55, 0x75, 0xe9, 0xfd,
+	0x02, 0x40, 0xc6, 0x50, 0x5b, 0x98, 0x36, 0x9a, 0x3a, 0x35, 0xb7, 0x3f, 0xb8, 0x12, 0xca, 0x76,
+	0x3e, 0x67, 0xe5, 0x80, 0xd1, 0xd7, 0x2f, 0x4e, 0xbb, 0xce, 0xcb, 0xd3, 0xae, 0xf3, 0xc7, 0x69,
+	0xd7, 0xf9, 0xfe, 0xac, 0x5b, 0x7a, 0x79, 0xd6, 0x2d, 0xfd, 0x7a, 0xd6, 0x2d, 0x7d, 0xf5, 0xe9,
+	0x2b, 0xed, 0x64, 0x3c, 0xcc, 0xa6, 0x99, 0x7c, 0xc8, 0x51, 0x1d, 0x8b, 0xf4, 0xd0, 0x9b, 0x05,
+	0x7c, 0x96, 0xa5, 0x4b, 0xd3, 0xd8, 0xa3, 0x6d, 0xef, 0xe4, 0x8d, 0xa7, 0xd6, 0x74, 0x7b, 0x5a,
+	0x33, 0xcf, 0xe0, 0xc7, 0xff, 0x0c, 0x00, 0x22, 0x95, 0x29, 0x74, 0x96, 0x07, 0x00, 0x00,
 }
 
 func (m *Query) Marshal() (dAtA []byte, err error) {
@@ -286,6 +496,102 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.FeeEscrow) > 0 {
+		for iNdEx := len(m.FeeEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.TimedOut {
+		i--
+		if m.TimedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Retries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size := m.LastEmission.Size()
 		i -= size
@@ -423,6 +729,58 @@ func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxQueryRetries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxQueryRetries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxQueryTtl != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxQueryTtl))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinQueryPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinQueryPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.QueryDeposit) > 0 {
+		for iNdEx := len(m.QueryDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -443,6 +801,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Queries) > 0 {
 		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -510,6 +878,47 @@ func (m *Query) Size() (n int) {
 	}
 	l = m.LastEmission.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGenesis(uint64(m.Timeout))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRetries))
+	}
+	if m.Retries != 0 {
+		n += 2 + sovGenesis(uint64(m.Retries))
+	}
+	if m.TimedOut {
+		n += 3
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeEscrow) > 0 {
+		for _, e := range m.FeeEscrow {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 2 + sovGenesis(uint64(m.Height))
+	}
 	return n
 }
 
@@ -534,6 +943,30 @@ func (m *DataPoint) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueryDeposit) > 0 {
+		for _, e := range m.QueryDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MinQueryPeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.MinQueryPeriod))
+	}
+	if m.MaxQueryTtl != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxQueryTtl))
+	}
+	if m.MaxQueryRetries != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxQueryRetries))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -546,6 +979,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -899,17 +1334,279 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimedOut = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeEscrow = append(m.FeeEscrow, types.Coin{})
+			if err := m.FeeEscrow[len(m.FeeEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1104,6 +1801,147 @@ func (m *DataPoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryDeposit = append(m.QueryDeposit, types.Coin{})
+			if err := m.QueryDeposit[len(m.QueryDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQueryPeriod", wireType)
+			}
+			m.MinQueryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinQueryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryTtl", wireType)
+			}
+			m.MaxQueryTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryTtl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryRetries", wireType)
+			}
+			m.MaxQueryRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1167,6 +2005,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	newQuery := func() types.Query {
+		return types.Query{
+			Id:           "a7b1c2d3",
+			ConnectionId: "connection-0",
+			ChainId:      "testchain-1",
+			QueryType:    "store/bank/key",
+			Request:      []byte{0x01},
+			Period:       sdk.NewInt(100),
+			LastHeight:   sdk.ZeroInt(),
+			Ttl:          10,
+			Owner:        TestOwnerAddress,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.Query)
+		expErr   string
+	}{
+		{"valid", func(*types.Query) {}, ""},
+		{"module query", func(q *types.Query) { q.Owner = "" }, ""},
+		{"no id", func(q *types.Query) { q.Id = "" }, "query id cannot be empty"},
+		{"invalid connection", func(q *types.Query) { q.ConnectionId = "conn" }, "invalid connection id"},
+		{"no chain id", func(q *types.Query) { q.ChainId = "" }, "empty chain id"},
+		{"no query type", func(q *types.Query) { q.QueryType = "" }, "empty query type"},
+		{"no period", func(q *types.Query) { q.Period = sdk.Int{} }, "no period"},
+		{"invalid owner", func(q *types.Query) { q.Owner = "owner" }, "invalid owner"},
+		{"retries without timeout", func(q *types.Query) { q.MaxRetries = 1 }, "retries without a timeout"},
+		{"invalid fee", func(q *types.Query) { q.Fee = sdk.Coins{{Denom: "stake", Amount: sdk.NewInt(-1)}} }, "invalid fee"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query := newQuery()
+			tc.malleate(&query)
+
+			err := types.NewGenesisState([]types.Query{query}, types.DefaultParams()).Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+
+	query := newQuery()
+	err := types.NewGenesisState([]types.Query{query, query}, types.DefaultParams()).Validate()
+	require.ErrorContains(t, err, "duplicate query")
+}
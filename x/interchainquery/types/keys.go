@@ -1,5 +1,10 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "interchainquery"
@@ -12,6 +17,10 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// QueryTypeTx is the query type of a remote transaction, requested by its
+	// hash and answered with a GetTxWithProofResponse.
+	QueryTypeTx = "tendermint.Tx"
 )
 
 // prefix bytes for the interchainquery persistent store
@@ -25,6 +34,29 @@ var (
 	KeyPrefixQuery = []byte{prefixQuery}
 )
 
+// ValidateQueryType returns an error if the responses to the queries of the
+// type are not proven against the consensus state of the remote chain, i.e.
+// if it is neither the query of a key of a remote store, of the form
+// store/<store name>/key, nor a remote transaction.
+func ValidateQueryType(queryType string) error {
+	if queryType == QueryTypeTx {
+		return nil
+	}
+
+	parts := strings.Split(queryType, "/")
+	if len(parts) != 3 || parts[0] != "store" || parts[1] == "" || parts[2] != "key" {
+		return fmt.Errorf("query type %s is neither of the form store/<store name>/key nor %s", queryType, QueryTypeTx)
+	}
+
+	return nil
+}
+
+// IsProvenQueryType returns true if the responses to the queries of the type
+// are proven against the consensus state of the remote chain.
+func IsProvenQueryType(queryType string) bool {
+	return ValidateQueryType(queryType) == nil
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
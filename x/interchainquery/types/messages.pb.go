@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -111,9 +113,286 @@ func (m *MsgSubmitQueryResponseResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitQueryResponseResponse proto.InternalMessageInfo
 
+// MsgRegisterInterchainQuery represents a message to register a query whose
+// results are delivered through events and stored datapoints.
+type MsgRegisterInterchainQuery struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	ChainId      string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// query_type is either store/<store name>/key, reading a key of a remote
+	// store, or tendermint.Tx, reading a remote transaction, as only their
+	// responses are proven.
+	QueryType string `protobuf:"bytes,4,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty" yaml:"query_type"`
+	Request   []byte `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty" yaml:"request"`
+	// period is the number of blocks between two emissions of the query, or -1
+	// for a query emitted once.
+	Period int64 `protobuf:"varint,6,opt,name=period,proto3" json:"period,omitempty" yaml:"period"`
+	// ttl is the number of blocks the results of the query are stored.
+	Ttl uint64 `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty" yaml:"ttl"`
+	// timeout is the number of blocks after an emission of the query without
+	// response before the query is retried, or 0 for a query never timing out.
+	Timeout uint64 `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty" yaml:"timeout"`
+	// max_retries is the number of retries of an emission of the query before
+	// the query times out.
+	MaxRetries uint64 `protobuf:"varint,9,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty" yaml:"max_retries"`
+	// fee is paid from the fee escrow of the query to the first valid response
+	// of each of its emissions. Only the queries whose responses are proven,
+	// i.e. the store queries of a key and the remote transactions, take fees.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee" yaml:"fee"`
+	// fee_escrow is escrowed from the owner to pay the fees of the responses.
+	FeeEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=fee_escrow,json=feeEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_escrow" yaml:"fee_escrow"`
+	// height is the remote height the query is run at, or 0 for the latest
+	// height.
+	Height int64 `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *MsgRegisterInterchainQuery) Reset()         { *m = MsgRegisterInterchainQuery{} }
+func (m *MsgRegisterInterchainQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterInterchainQuery) ProtoMessage()    {}
+func (*MsgRegisterInterchainQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8334dd1e1e60b470, []int{2}
+}
+func (m *MsgRegisterInterchainQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterInterchainQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterInterchainQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterInterchainQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterInterchainQuery.Merge(m, src)
+}
+func (m *MsgRegisterInterchainQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterInterchainQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterInterchainQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterInterchainQuery proto.InternalMessageInfo
+
+// MsgRegisterInterchainQueryResponse defines the MsgRegisterInterchainQuery
+// response type.
+type MsgRegisterInterchainQueryResponse struct {
+	QueryId string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty" yaml:"query_id"`
+}
+
+func (m *MsgRegisterInterchainQueryResponse) Reset()         { *m = MsgRegisterInterchainQueryResponse{} }
+func (m *MsgRegisterInterchainQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterInterchainQueryResponse) ProtoMessage()    {}
+func (*MsgRegisterInterchainQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8334dd1e1e60b470, []int{3}
+}
+func (m *MsgRegisterInterchainQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterInterchainQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterInterchainQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterInterchainQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterInterchainQueryResponse.Merge(m, src)
+}
+func (m *MsgRegisterInterchainQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterInterchainQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterInterchainQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterInterchainQueryResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterInterchainQueryResponse) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+// MsgRemoveInterchainQuery represents a message to remove a query registered
+// through MsgRegisterInterchainQuery.
+type MsgRemoveInterchainQuery struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	QueryId string `protobuf:"bytes,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty" yaml:"query_id"`
+}
+
+func (m *MsgRemoveInterchainQuery) Reset()         { *m = MsgRemoveInterchainQuery{} }
+func (m *MsgRemoveInterchainQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveInterchainQuery) ProtoMessage()    {}
+func (*MsgRemoveInterchainQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8334dd1e1e60b470, []int{4}
+}
+func (m *MsgRemoveInterchainQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveInterchainQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveInterchainQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveInterchainQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveInterchainQuery.Merge(m, src)
+}
+func (m *MsgRemoveInterchainQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveInterchainQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveInterchainQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveInterchainQuery proto.InternalMessageInfo
+
+// MsgRemoveInterchainQueryResponse defines the MsgRemoveInterchainQuery
+// response type.
+type MsgRemoveInterchainQueryResponse struct {
+}
+
+func (m *MsgRemoveInterchainQueryResponse) Reset()         { *m = MsgRemoveInterchainQueryResponse{} }
+func (m *MsgRemoveInterchainQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveInterchainQueryResponse) ProtoMessage()    {}
+func (*MsgRemoveInterchainQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8334dd1e1e60b470, []int{5}
+}
+func (m *MsgRemoveInterchainQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveInterchainQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveInterchainQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveInterchainQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveInterchainQueryResponse.Merge(m, src)
+}
+func (m *MsgRemoveInterchainQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveInterchainQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveInterchainQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveInterchainQueryResponse proto.InternalMessageInfo
+
+// MsgRequeryInterchainQuery represents a message to emit again a query
+// registered through MsgRegisterInterchainQuery, timed out or not, at the end
+// of the block.
+type MsgRequeryInterchainQuery struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	QueryId string `protobuf:"bytes,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty" yaml:"query_id"`
+	// fee_escrow is added to the fee escrow of the query.
+	FeeEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee_escrow,json=feeEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_escrow" yaml:"fee_escrow"`
+}
+
+func (m *MsgRequeryInterchainQuery) Reset()         { *m = MsgRequeryInterchainQuery{} }
+func (m *MsgRequeryInterchainQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgRequeryInterchainQuery) ProtoMessage()    {}
+func (*MsgRequeryInterchainQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8334dd1e1e60b470, []int{6}
+}
+func (m *MsgRequeryInterchainQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequeryInterchainQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequeryInterchainQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequeryInterchainQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequeryInterchainQuery.Merge(m, src)
+}
+func (m *MsgRequeryInterchainQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequeryInterchainQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequeryInterchainQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequeryInterchainQuery proto.InternalMessageInfo
+
+// MsgRequeryInterchainQueryResponse defines the MsgRequeryInterchainQuery
+// response type.
+type MsgRequeryInterchainQueryResponse struct {
+}
+
+func (m *MsgRequeryInterchainQueryResponse) Reset()         { *m = MsgRequeryInterchainQueryResponse{} }
+func (m *MsgRequeryInterchainQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequeryInterchainQueryResponse) ProtoMessage()    {}
+func (*MsgRequeryInterchainQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8334dd1e1e60b470, []int{7}
+}
+func (m *MsgRequeryInterchainQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequeryInterchainQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequeryInterchainQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequeryInterchainQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequeryInterchainQueryResponse.Merge(m, src)
+}
+func (m *MsgRequeryInterchainQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequeryInterchainQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequeryInterchainQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequeryInterchainQueryResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitQueryResponse)(nil), "persistence.interchainquery.v1beta1.MsgSubmitQueryResponse")
 	proto.RegisterType((*MsgSubmitQueryResponseResponse)(nil), "persistence.interchainquery.v1beta1.MsgSubmitQueryResponseResponse")
+	proto.RegisterType((*MsgRegisterInterchainQuery)(nil), "persistence.interchainquery.v1beta1.MsgRegisterInterchainQuery")
+	proto.RegisterType((*MsgRegisterInterchainQueryResponse)(nil), "persistence.interchainquery.v1beta1.MsgRegisterInterchainQueryResponse")
+	proto.RegisterType((*MsgRemoveInterchainQuery)(nil), "persistence.interchainquery.v1beta1.MsgRemoveInterchainQuery")
+	proto.RegisterType((*MsgRemoveInterchainQueryResponse)(nil), "persistence.interchainquery.v1beta1.MsgRemoveInterchainQueryResponse")
+	proto.RegisterType((*MsgRequeryInterchainQuery)(nil), "persistence.interchainquery.v1beta1.MsgRequeryInterchainQuery")
+	proto.RegisterType((*MsgRequeryInterchainQueryResponse)(nil), "persistence.interchainquery.v1beta1.MsgRequeryInterchainQueryResponse")
 }
 
 func init() {
@@ -121,39 +400,68 @@ func init() {
 }
 
 var fileDescriptor_8334dd1e1e60b470 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xf6, 0x25, 0xbf, 0x5f, 0xda, 0xba, 0x41, 0x80, 0x53, 0x81, 0x15, 0xc0, 0xb6, 0x8e, 0x25,
-	0x20, 0x71, 0xa7, 0xa6, 0x0b, 0x0a, 0x12, 0x12, 0x81, 0xa5, 0x43, 0x29, 0x98, 0x8d, 0x81, 0xc8,
-	0x89, 0xaf, 0xce, 0x89, 0xf8, 0xee, 0xb8, 0xbb, 0x54, 0xcd, 0xca, 0xc4, 0x88, 0xc4, 0xc2, 0x98,
-	0x3f, 0x87, 0xb1, 0x12, 0x03, 0x4c, 0x01, 0x25, 0x0c, 0x9d, 0xf3, 0x17, 0x20, 0x9f, 0x9d, 0x62,
-	0x95, 0x08, 0x21, 0x31, 0xdd, 0x7b, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0x67, 0x3f, 0xbb, 0x2d, 0x88,
-	0x54, 0x54, 0x69, 0xc2, 0x06, 0x04, 0x53, 0xa6, 0x89, 0x1c, 0x0c, 0x23, 0xca, 0xde, 0x8c, 0x89,
-	0x9c, 0xe0, 0xe3, 0xdd, 0x3e, 0xd1, 0xd1, 0x2e, 0x4e, 0x89, 0x52, 0x51, 0x42, 0x14, 0x12, 0x92,
-	0x6b, 0xee, 0xdc, 0x2e, 0x69, 0xd0, 0x05, 0x0d, 0x2a, 0x34, 0xcd, 0x9d, 0x84, 0x27, 0xdc, 0xf0,
-	0x71, 0x16, 0xe5, 0xd2, 0xe6, 0xcd, 0x84, 0xf3, 0x64, 0x44, 0x70, 0x24, 0x28, 0x8e, 0x18, 0xe3,
-	0x3a, 0xd2, 0x94, 0xb3, 0xa2, 0x71, 0xf3, 0x96, 0x26, 0x2c, 0x26, 0x32, 0xa5, 0x4c, 0xe3, 0x81,
-	0x9c, 0x08, 0xcd, 0xb1, 0x90, 0x9c, 0x1f, 0xe5, 0x30, 0x3c, 0xab, 0xd8, 0xd7, 0x0e, 0x54, 0xf2,
-	0x62, 0xdc, 0x4f, 0xa9, 0x7e, 0x9e, 0xb9, 0x85, 0x44, 0x09, 0xce, 0x14, 0x71, 0x90, 0xbd, 0x69,
-	0x66, 0xe8, 0xd1, 0xd8, 0x05, 0x01, 0x68, 0x6d, 0x75, 0x1b, 0xcb, 0x99, 0x7f, 0x79, 0x12, 0xa5,
-	0xa3, 0x0e, 0x5c, 0x21, 0x30, 0xdc, 0x30, 0xe1, 0x7e, 0x9c, 0xf1, 0xcd, 0xb8, 0x19, 0xbf, 0x72,
-	0x91, 0xbf, 0x42, 0x60, 0xb8, 0x61, 0xc2, 0xfd, 0xd8, 0xb9, 0x63, 0xd7, 0x24, 0x51, 0xe3, 0x91,
-	0x76, 0xab, 0x01, 0x68, 0xd5, 0xbb, 0x57, 0x97, 0x33, 0xff, 0x52, 0xce, 0xce, 0xeb, 0x30, 0x2c,
-	0x08, 0xce, 0x53, 0x7b, 0xcb, 0x0c, 0xdd, 0xe3, 0x42, 0xb9, 0xff, 0x05, 0xa0, 0xb5, 0xdd, 0xbe,
-	0x81, 0x7e, 0x2d, 0x86, 0xf2, 0xc5, 0xd0, 0xb3, 0x8c, 0x73, 0x28, 0x54, 0x77, 0x67, 0x39, 0xf3,
-	0xaf, 0xe4, 0xad, 0xce, 0x75, 0x30, 0xdc, 0x14, 0x05, 0x9e, 0x59, 0x0f, 0x09, 0x4d, 0x86, 0xda,
-	0xfd, 0x3f, 0x00, 0xad, 0x6a, 0xd9, 0x3a, 0xaf, 0xc3, 0xb0, 0x20, 0x38, 0x1d, 0xbb, 0x7e, 0x24,
-	0x79, 0xda, 0x8b, 0xe2, 0x58, 0x12, 0xa5, 0xdc, 0x9a, 0xd9, 0xec, 0xfa, 0x72, 0xe6, 0x37, 0x72,
-	0x41, 0x19, 0x85, 0xe1, 0x76, 0x96, 0x3e, 0xca, 0xb3, 0x4e, 0xfd, 0xdd, 0xd4, 0xb7, 0x3e, 0x4e,
-	0x7d, 0x70, 0x36, 0xf5, 0x2d, 0x18, 0xd8, 0xde, 0xfa, 0x2f, 0xbd, 0x7a, 0xdb, 0xdf, 0x80, 0x5d,
-	0x3d, 0x50, 0x89, 0xf3, 0x05, 0xd8, 0x8d, 0x75, 0x7f, 0xe4, 0x01, 0xfa, 0x8b, 0x2b, 0x41, 0xeb,
-	0x4d, 0x9a, 0x8f, 0xff, 0x41, 0xbc, 0x7a, 0xe1, 0xc3, 0xb7, 0x9f, 0x7f, 0x7c, 0xa8, 0xdc, 0x87,
-	0x7b, 0xf8, 0x4f, 0x37, 0xae, 0x4f, 0xce, 0xcf, 0x5c, 0x99, 0x66, 0xa6, 0xdc, 0x01, 0x77, 0xbb,
-	0xaf, 0x3e, 0xcd, 0x3d, 0x70, 0x3a, 0xf7, 0xc0, 0xf7, 0xb9, 0x07, 0xde, 0x2f, 0x3c, 0xeb, 0x74,
-	0xe1, 0x59, 0x5f, 0x17, 0x9e, 0xf5, 0xf2, 0x49, 0x42, 0xf5, 0x70, 0xdc, 0x47, 0x03, 0x9e, 0x96,
-	0x7b, 0x1f, 0x32, 0x52, 0x4e, 0xef, 0xa9, 0xf8, 0x35, 0x3e, 0x6e, 0xe3, 0x93, 0xdf, 0x3d, 0x27,
-	0x82, 0xa8, 0x7e, 0xcd, 0x5c, 0xf5, 0xde, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x97, 0x4a,
-	0x8e, 0x83, 0x03, 0x00, 0x00,
+	// 967 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0xed, 0x8f, 0x4c, 0xb2, 0xcb, 0xd6, 0xed, 0x76, 0xbd, 0x01, 0xe2, 0x30, 0x2b,
+	0xa1, 0x80, 0x58, 0x5b, 0x9b, 0x45, 0x02, 0x05, 0x6d, 0xd1, 0x66, 0x55, 0x50, 0x0f, 0xe5, 0x87,
+	0xd9, 0x13, 0x1c, 0x22, 0xc7, 0x79, 0x71, 0xad, 0xc6, 0x1e, 0xef, 0xcc, 0xa4, 0x6d, 0x24, 0x24,
+	0x24, 0x4e, 0x1c, 0x91, 0xb8, 0x70, 0x41, 0xaa, 0xc4, 0x8d, 0xbf, 0xa4, 0xc7, 0x95, 0x38, 0xc0,
+	0xc9, 0xa0, 0x96, 0xc3, 0x9e, 0x38, 0xf8, 0x0f, 0x40, 0xc8, 0x33, 0xb6, 0x6b, 0x95, 0x84, 0x66,
+	0xb7, 0x52, 0x4f, 0xf6, 0xcc, 0xfb, 0xbe, 0x79, 0x6f, 0xe6, 0x7b, 0xdf, 0xd8, 0xa8, 0x1d, 0x02,
+	0x65, 0x1e, 0xe3, 0x10, 0x38, 0x60, 0x7a, 0x01, 0x07, 0xea, 0xec, 0xda, 0x5e, 0xf0, 0x74, 0x0c,
+	0x74, 0x62, 0xee, 0xdf, 0xef, 0x03, 0xb7, 0xef, 0x9b, 0x3e, 0x30, 0x66, 0xbb, 0xc0, 0x8c, 0x90,
+	0x12, 0x4e, 0xd4, 0xbb, 0x05, 0x8e, 0x71, 0x8e, 0x63, 0xa4, 0x9c, 0xfa, 0xba, 0x4b, 0x5c, 0x22,
+	0xf0, 0x66, 0xf2, 0x26, 0xa9, 0xf5, 0xd7, 0x5c, 0x42, 0xdc, 0x11, 0x98, 0x76, 0xe8, 0x99, 0x76,
+	0x10, 0x10, 0x6e, 0x73, 0x8f, 0x04, 0xe9, 0xc2, 0xf5, 0xd7, 0x39, 0x04, 0x03, 0xa0, 0xbe, 0x17,
+	0x70, 0xd3, 0xa1, 0x93, 0x90, 0x13, 0x33, 0xa4, 0x84, 0x0c, 0xd3, 0x70, 0xc3, 0x21, 0xcc, 0x27,
+	0xcc, 0xec, 0xdb, 0x0c, 0xf2, 0xda, 0x1c, 0xe2, 0x05, 0x32, 0x8e, 0x9f, 0x2f, 0xa0, 0x8d, 0x1d,
+	0xe6, 0x7e, 0x31, 0xee, 0xfb, 0x1e, 0xff, 0x3c, 0xa9, 0xc6, 0x02, 0x16, 0x92, 0x80, 0x81, 0x6a,
+	0xa0, 0x15, 0x51, 0x63, 0xcf, 0x1b, 0x68, 0x4a, 0x53, 0x69, 0x55, 0xba, 0x6b, 0x71, 0xa4, 0xbf,
+	0x32, 0xb1, 0xfd, 0x51, 0x07, 0x67, 0x11, 0x6c, 0x2d, 0x8b, 0xd7, 0xed, 0x41, 0x82, 0x17, 0xdb,
+	0x49, 0xf0, 0x0b, 0xe7, 0xf1, 0x59, 0x04, 0x5b, 0xcb, 0xe2, 0x75, 0x7b, 0xa0, 0xbe, 0x85, 0x96,
+	0x28, 0xb0, 0xf1, 0x88, 0x6b, 0xe5, 0xa6, 0xd2, 0xaa, 0x75, 0x57, 0xe3, 0x48, 0xbf, 0x2e, 0xd1,
+	0x72, 0x1e, 0x5b, 0x29, 0x40, 0xfd, 0x04, 0x55, 0xc4, 0xa6, 0x7a, 0x24, 0x64, 0xda, 0xb5, 0xa6,
+	0xd2, 0xaa, 0xb6, 0x5f, 0x35, 0xce, 0x36, 0x6e, 0xc8, 0x8d, 0x1b, 0x9f, 0x25, 0x98, 0x4f, 0x43,
+	0xd6, 0x5d, 0x8f, 0x23, 0xfd, 0xa6, 0x5c, 0x2a, 0xe7, 0x61, 0x6b, 0x25, 0x4c, 0xe3, 0x49, 0xea,
+	0x5d, 0xf0, 0xdc, 0x5d, 0xae, 0x2d, 0x36, 0x95, 0x56, 0xb9, 0x98, 0x5a, 0xce, 0x63, 0x2b, 0x05,
+	0xa8, 0x1d, 0x54, 0x1b, 0x52, 0xe2, 0xf7, 0xec, 0xc1, 0x80, 0x02, 0x63, 0xda, 0x92, 0xd8, 0xd9,
+	0xed, 0x38, 0xd2, 0xd7, 0x24, 0xa1, 0x18, 0xc5, 0x56, 0x35, 0x19, 0x3e, 0x92, 0xa3, 0x4e, 0xed,
+	0xbb, 0x23, 0xbd, 0xf4, 0xe3, 0x91, 0xae, 0x3c, 0x3f, 0xd2, 0x4b, 0xb8, 0x89, 0x1a, 0xd3, 0x4f,
+	0x3a, 0x7b, 0xe2, 0xbf, 0x17, 0x51, 0x7d, 0x87, 0xb9, 0x16, 0xb8, 0x49, 0xa7, 0xd0, 0xed, 0xbc,
+	0x4d, 0x04, 0x5c, 0x7d, 0x13, 0x2d, 0x92, 0x83, 0x00, 0x68, 0xaa, 0xc6, 0xcd, 0x38, 0xd2, 0x6b,
+	0xb2, 0x06, 0x31, 0x8d, 0x2d, 0x19, 0x56, 0x1f, 0xa2, 0xeb, 0x0e, 0x09, 0x02, 0x70, 0x92, 0x3e,
+	0x39, 0x53, 0x43, 0x8b, 0x23, 0x7d, 0x3d, 0x55, 0xaf, 0x18, 0xc6, 0x56, 0xed, 0x6c, 0x2c, 0x75,
+	0xcc, 0x75, 0x2f, 0xcf, 0xa1, 0xfb, 0xbb, 0x08, 0x49, 0x75, 0xf9, 0x24, 0x04, 0xa1, 0x4e, 0xa5,
+	0x7b, 0x2b, 0x8e, 0xf4, 0xd5, 0xa2, 0xf2, 0x49, 0x0c, 0x5b, 0x15, 0x31, 0x78, 0x32, 0x09, 0x41,
+	0x7d, 0x07, 0x2d, 0x53, 0x78, 0x3a, 0x06, 0x26, 0x35, 0xa8, 0x75, 0xd5, 0x38, 0xd2, 0x6f, 0x64,
+	0xf2, 0x8b, 0x00, 0xb6, 0x32, 0x48, 0x22, 0x58, 0x08, 0xd4, 0x23, 0x03, 0x6d, 0xe9, 0xbc, 0x60,
+	0x72, 0x1e, 0x5b, 0x29, 0x40, 0x6d, 0xa2, 0x32, 0xe7, 0x23, 0x6d, 0xb9, 0xa9, 0xb4, 0xae, 0x75,
+	0x6f, 0xc4, 0x91, 0x8e, 0x24, 0x8e, 0xf3, 0x11, 0xb6, 0x92, 0x50, 0x92, 0x9a, 0x7b, 0x3e, 0x90,
+	0x31, 0xd7, 0x56, 0x04, 0xaa, 0x90, 0x3a, 0x0d, 0x60, 0x2b, 0x83, 0xa8, 0xef, 0xa1, 0xaa, 0x6f,
+	0x1f, 0xf6, 0x28, 0x70, 0xea, 0x01, 0xd3, 0x2a, 0x82, 0xb1, 0x11, 0x47, 0xba, 0x2a, 0x19, 0x85,
+	0x20, 0xb6, 0x90, 0x6f, 0x1f, 0x5a, 0x72, 0xa0, 0xee, 0xa1, 0xf2, 0x10, 0x40, 0x43, 0xcd, 0x72,
+	0xab, 0xda, 0xbe, 0x63, 0x48, 0x23, 0x1a, 0x89, 0x11, 0x33, 0xc3, 0x1b, 0x8f, 0x89, 0x17, 0x74,
+	0x37, 0x8f, 0x23, 0xbd, 0x74, 0x56, 0xe7, 0x10, 0x00, 0xff, 0xf2, 0x87, 0xde, 0x72, 0x3d, 0xbe,
+	0x3b, 0xee, 0x1b, 0x0e, 0xf1, 0xcd, 0xd4, 0xc3, 0xf2, 0x71, 0x8f, 0x0d, 0xf6, 0xcc, 0xe4, 0x38,
+	0x99, 0xa0, 0x33, 0x2b, 0xc9, 0xa2, 0x7e, 0x83, 0xd0, 0x10, 0xa0, 0x07, 0xcc, 0xa1, 0xe4, 0x40,
+	0xab, 0x5e, 0x94, 0x73, 0x2b, 0xcd, 0xb9, 0x9a, 0xe7, 0x4c, 0xa9, 0x2f, 0x96, 0xba, 0x32, 0x04,
+	0xd8, 0x12, 0xbc, 0x82, 0xa5, 0x6a, 0x17, 0x58, 0xaa, 0xb3, 0x92, 0xd8, 0x42, 0x58, 0xe2, 0x09,
+	0xc2, 0xb3, 0xfb, 0xbd, 0x78, 0x11, 0xe5, 0x17, 0x8b, 0x72, 0xf1, 0xc5, 0x82, 0xbf, 0x46, 0x9a,
+	0x58, 0xd5, 0x27, 0xfb, 0xf0, 0xb2, 0x1e, 0x7a, 0xc1, 0xcb, 0xac, 0xb0, 0x27, 0x8c, 0x9a, 0xb3,
+	0xb2, 0xe7, 0x46, 0xff, 0x47, 0x41, 0x77, 0x04, 0x48, 0xd2, 0xaf, 0xa6, 0xc6, 0x73, 0x3d, 0x52,
+	0xbe, 0xf2, 0x1e, 0x29, 0x1c, 0xd2, 0x5d, 0xf4, 0xc6, 0xcc, 0xfd, 0x67, 0xa7, 0xd4, 0x3e, 0x5e,
+	0x44, 0xe5, 0x1d, 0xe6, 0xaa, 0xbf, 0x29, 0x68, 0x6d, 0xda, 0x07, 0xea, 0x03, 0x63, 0x8e, 0x8f,
+	0xaa, 0x31, 0xfd, 0xce, 0xad, 0x3f, 0xbe, 0x04, 0x39, 0xd7, 0x71, 0xf3, 0xdb, 0x5f, 0xff, 0xfa,
+	0x61, 0xe1, 0xfd, 0x8e, 0xf2, 0x36, 0x7e, 0x60, 0xfe, 0xdf, 0x5f, 0x01, 0x3f, 0xcc, 0x3f, 0xbe,
+	0x4c, 0xac, 0x27, 0xa6, 0xd5, 0x9f, 0x15, 0x74, 0x7b, 0xd6, 0x6d, 0xff, 0xe1, 0xbc, 0x05, 0xce,
+	0x58, 0xa0, 0xfe, 0xf1, 0x25, 0x17, 0xc8, 0xcf, 0xf9, 0x27, 0x05, 0xdd, 0x9a, 0xee, 0xa6, 0x87,
+	0xf3, 0xa7, 0x98, 0x42, 0xaf, 0x6f, 0x5d, 0x8a, 0x9e, 0xd7, 0x77, 0xa4, 0xa0, 0x8d, 0x19, 0x56,
+	0xda, 0x9c, 0x3f, 0xc3, 0x34, 0x7e, 0xfd, 0xa3, 0xcb, 0xf1, 0xb3, 0x12, 0xbb, 0x5f, 0x1d, 0x9f,
+	0x34, 0x94, 0x67, 0x27, 0x0d, 0xe5, 0xcf, 0x93, 0x86, 0xf2, 0xfd, 0x69, 0xa3, 0xf4, 0xec, 0xb4,
+	0x51, 0xfa, 0xfd, 0xb4, 0x51, 0xfa, 0xf2, 0x51, 0xc1, 0x48, 0x5e, 0xe0, 0x8c, 0xfb, 0x63, 0x76,
+	0x2f, 0x00, 0x7e, 0x40, 0xe8, 0x9e, 0x39, 0xb4, 0x83, 0xe1, 0x98, 0x4e, 0x84, 0xa5, 0xf6, 0xdb,
+	0xe6, 0xe1, 0x7f, 0xdb, 0x2a, 0xf1, 0x59, 0x7f, 0x49, 0xfc, 0xca, 0x3d, 0xf8, 0x77, 0x00, 0x86,
+	0x76, 0x6b, 0x3e, 0x98, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +478,15 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// SubmitQueryResponse defines a method for submit query responses.
 	SubmitQueryResponse(ctx context.Context, in *MsgSubmitQueryResponse, opts ...grpc.CallOption) (*MsgSubmitQueryResponseResponse, error)
+	// RegisterInterchainQuery defines a method for registering a query on
+	// behalf of an account.
+	RegisterInterchainQuery(ctx context.Context, in *MsgRegisterInterchainQuery, opts ...grpc.CallOption) (*MsgRegisterInterchainQueryResponse, error)
+	// RemoveInterchainQuery defines a method for removing a query registered
+	// through RegisterInterchainQuery and refunding its deposit.
+	RemoveInterchainQuery(ctx context.Context, in *MsgRemoveInterchainQuery, opts ...grpc.CallOption) (*MsgRemoveInterchainQueryResponse, error)
+	// RequeryInterchainQuery defines a method for emitting again a query
+	// registered through RegisterInterchainQuery and escrowing its fees.
+	RequeryInterchainQuery(ctx context.Context, in *MsgRequeryInterchainQuery, opts ...grpc.CallOption) (*MsgRequeryInterchainQueryResponse, error)
 }
 
 type msgClient struct {
@@ -189,10 +506,46 @@ func (c *msgClient) SubmitQueryResponse(ctx context.Context, in *MsgSubmitQueryR
 	return out, nil
 }
 
+func (c *msgClient) RegisterInterchainQuery(ctx context.Context, in *MsgRegisterInterchainQuery, opts ...grpc.CallOption) (*MsgRegisterInterchainQueryResponse, error) {
+	out := new(MsgRegisterInterchainQueryResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.Msg/RegisterInterchainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveInterchainQuery(ctx context.Context, in *MsgRemoveInterchainQuery, opts ...grpc.CallOption) (*MsgRemoveInterchainQueryResponse, error) {
+	out := new(MsgRemoveInterchainQueryResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.Msg/RemoveInterchainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequeryInterchainQuery(ctx context.Context, in *MsgRequeryInterchainQuery, opts ...grpc.CallOption) (*MsgRequeryInterchainQueryResponse, error) {
+	out := new(MsgRequeryInterchainQueryResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.Msg/RequeryInterchainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitQueryResponse defines a method for submit query responses.
 	SubmitQueryResponse(context.Context, *MsgSubmitQueryResponse) (*MsgSubmitQueryResponseResponse, error)
+	// RegisterInterchainQuery defines a method for registering a query on
+	// behalf of an account.
+	RegisterInterchainQuery(context.Context, *MsgRegisterInterchainQuery) (*MsgRegisterInterchainQueryResponse, error)
+	// RemoveInterchainQuery defines a method for removing a query registered
+	// through RegisterInterchainQuery and refunding its deposit.
+	RemoveInterchainQuery(context.Context, *MsgRemoveInterchainQuery) (*MsgRemoveInterchainQueryResponse, error)
+	// RequeryInterchainQuery defines a method for emitting again a query
+	// registered through RegisterInterchainQuery and escrowing its fees.
+	RequeryInterchainQuery(context.Context, *MsgRequeryInterchainQuery) (*MsgRequeryInterchainQueryResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -202,6 +555,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitQueryResponse(ctx context.Context, req *MsgSubmitQueryResponse) (*MsgSubmitQueryResponseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResponse not implemented")
 }
+func (*UnimplementedMsgServer) RegisterInterchainQuery(ctx context.Context, req *MsgRegisterInterchainQuery) (*MsgRegisterInterchainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterInterchainQuery not implemented")
+}
+func (*UnimplementedMsgServer) RemoveInterchainQuery(ctx context.Context, req *MsgRemoveInterchainQuery) (*MsgRemoveInterchainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInterchainQuery not implemented")
+}
+func (*UnimplementedMsgServer) RequeryInterchainQuery(ctx context.Context, req *MsgRequeryInterchainQuery) (*MsgRequeryInterchainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeryInterchainQuery not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -225,6 +587,60 @@ func _Msg_SubmitQueryResponse_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterInterchainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterInterchainQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterInterchainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.Msg/RegisterInterchainQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterInterchainQuery(ctx, req.(*MsgRegisterInterchainQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveInterchainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveInterchainQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveInterchainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.Msg/RemoveInterchainQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveInterchainQuery(ctx, req.(*MsgRemoveInterchainQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequeryInterchainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequeryInterchainQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequeryInterchainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.Msg/RequeryInterchainQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequeryInterchainQuery(ctx, req.(*MsgRequeryInterchainQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "persistence.interchainquery.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -233,6 +649,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitQueryResponse",
 			Handler:    _Msg_SubmitQueryResponse_Handler,
 		},
+		{
+			MethodName: "RegisterInterchainQuery",
+			Handler:    _Msg_RegisterInterchainQuery_Handler,
+		},
+		{
+			MethodName: "RemoveInterchainQuery",
+			Handler:    _Msg_RemoveInterchainQuery_Handler,
+		},
+		{
+			MethodName: "RequeryInterchainQuery",
+			Handler:    _Msg_RequeryInterchainQuery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "persistence/interchainquery/v1beta1/messages.proto",
@@ -329,65 +757,1127 @@ func (m *MsgSubmitQueryResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessages(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterInterchainQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitQueryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterInterchainQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterInterchainQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.QueryId)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if len(m.FeeEscrow) > 0 {
+		for iNdEx := len(m.FeeEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.ProofOps != nil {
-		l = m.ProofOps.Size()
-		n += 1 + l + sovMessages(uint64(l))
+	if m.MaxRetries != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Height != 0 {
-		n += 1 + sovMessages(uint64(m.Height))
+	if m.Timeout != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.Ttl != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Period != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Request) > 0 {
+		i -= len(m.Request)
+		copy(dAtA[i:], m.Request)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Request)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterInterchainQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterInterchainQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterInterchainQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveInterchainQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveInterchainQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveInterchainQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveInterchainQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveInterchainQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveInterchainQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequeryInterchainQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequeryInterchainQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequeryInterchainQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeEscrow) > 0 {
+		for iNdEx := len(m.FeeEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequeryInterchainQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequeryInterchainQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequeryInterchainQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMessages(uint64(m.Height))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitQueryResponseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterInterchainQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovMessages(uint64(m.Period))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovMessages(uint64(m.Ttl))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovMessages(uint64(m.Timeout))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovMessages(uint64(m.MaxRetries))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if len(m.FeeEscrow) > 0 {
+		for _, e := range m.FeeEscrow {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovMessages(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgRegisterInterchainQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveInterchainQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveInterchainQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequeryInterchainQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.FeeEscrow) > 0 {
+		for _, e := range m.FeeEscrow {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRequeryInterchainQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofOps == nil {
+				m.ProofOps = &crypto.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterchainQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterchainQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeEscrow = append(m.FeeEscrow, types.Coin{})
+			if err := m.FeeEscrow[len(m.FeeEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSubmitQueryResponseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMessages(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -410,15 +1900,15 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterInterchainQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -446,11 +1936,61 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.QueryId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveInterchainQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveInterchainQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveInterchainQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -478,13 +2018,13 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -494,31 +2034,129 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveInterchainQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveInterchainQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequeryInterchainQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequeryInterchainQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequeryInterchainQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -528,33 +2166,29 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProofOps == nil {
-				m.ProofOps = &crypto.ProofOps{}
-			}
-			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -564,16 +2198,29 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEscrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -583,23 +2230,25 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.FeeEscrow = append(m.FeeEscrow, types.Coin{})
+			if err := m.FeeEscrow[len(m.FeeEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -622,7 +2271,7 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequeryInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -645,10 +2294,10 @@ func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequeryInterchainQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequeryInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
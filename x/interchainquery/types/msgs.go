@@ -5,14 +5,23 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
 // interchainquery message types
 const (
-	TypeMsgSubmitQueryResponse = "submitqueryresponse"
+	TypeMsgSubmitQueryResponse     = "submitqueryresponse"
+	TypeMsgRegisterInterchainQuery = "registerinterchainquery"
+	TypeMsgRemoveInterchainQuery   = "removeinterchainquery"
+	TypeMsgRequeryInterchainQuery  = "requeryinterchainquery"
 )
 
-var _ sdk.Msg = &MsgSubmitQueryResponse{}
+var (
+	_ sdk.Msg = &MsgSubmitQueryResponse{}
+	_ sdk.Msg = &MsgRegisterInterchainQuery{}
+	_ sdk.Msg = &MsgRemoveInterchainQuery{}
+	_ sdk.Msg = &MsgRequeryInterchainQuery{}
+)
 
 // Route Implements Msg.
 func (msg MsgSubmitQueryResponse) Route() string { return RouterKey }
@@ -54,3 +63,179 @@ func (msg MsgSubmitQueryResponse) GetSigners() []sdk.AccAddress {
 	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
 	return []sdk.AccAddress{fromAddress}
 }
+
+// NewMsgRegisterInterchainQuery creates a MsgRegisterInterchainQuery instance
+func NewMsgRegisterInterchainQuery(
+	owner sdk.AccAddress,
+	connectionID string,
+	chainID string,
+	queryType string,
+	request []byte,
+	period int64,
+	ttl uint64,
+	timeout uint64,
+	maxRetries uint64,
+	fee sdk.Coins,
+	feeEscrow sdk.Coins,
+	height int64,
+) *MsgRegisterInterchainQuery {
+	return &MsgRegisterInterchainQuery{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+		ChainId:      chainID,
+		QueryType:    queryType,
+		Request:      request,
+		Period:       period,
+		Ttl:          ttl,
+		Timeout:      timeout,
+		MaxRetries:   maxRetries,
+		Fee:          fee,
+		FeeEscrow:    feeEscrow,
+		Height:       height,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRegisterInterchainQuery) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRegisterInterchainQuery) Type() string { return TypeMsgRegisterInterchainQuery }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRegisterInterchainQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(msg.ChainId) == "" {
+		return fmt.Errorf("chain id cannot be empty")
+	}
+
+	// the responses to the queries of other types would be trusted as is
+	if err := ValidateQueryType(msg.QueryType); err != nil {
+		return err
+	}
+
+	// a negative period indicates a query emitted once
+	if msg.Period != -1 && msg.Period <= 0 {
+		return fmt.Errorf("period must be positive or -1, is %d", msg.Period)
+	}
+
+	if msg.Ttl == 0 {
+		return fmt.Errorf("ttl must be positive")
+	}
+
+	if msg.MaxRetries > 0 && msg.Timeout == 0 {
+		return fmt.Errorf("retries require a timeout")
+	}
+
+	if err := msg.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid fee: %w", err)
+	}
+
+	if err := msg.FeeEscrow.Validate(); err != nil {
+		return fmt.Errorf("invalid fee escrow: %w", err)
+	}
+
+	if msg.Height < 0 {
+		return fmt.Errorf("height must be non-negative")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRegisterInterchainQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRegisterInterchainQuery) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgRemoveInterchainQuery creates a MsgRemoveInterchainQuery instance
+func NewMsgRemoveInterchainQuery(owner sdk.AccAddress, queryID string) *MsgRemoveInterchainQuery {
+	return &MsgRemoveInterchainQuery{
+		Owner:   owner.String(),
+		QueryId: queryID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRemoveInterchainQuery) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRemoveInterchainQuery) Type() string { return TypeMsgRemoveInterchainQuery }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRemoveInterchainQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+
+	if len(msg.QueryId) != 64 {
+		return fmt.Errorf("invalid query id")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRemoveInterchainQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRemoveInterchainQuery) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgRequeryInterchainQuery creates a MsgRequeryInterchainQuery instance
+func NewMsgRequeryInterchainQuery(owner sdk.AccAddress, queryID string, feeEscrow sdk.Coins) *MsgRequeryInterchainQuery {
+	return &MsgRequeryInterchainQuery{
+		Owner:     owner.String(),
+		QueryId:   queryID,
+		FeeEscrow: feeEscrow,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRequeryInterchainQuery) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRequeryInterchainQuery) Type() string { return TypeMsgRequeryInterchainQuery }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRequeryInterchainQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+
+	if len(msg.QueryId) != 64 {
+		return fmt.Errorf("invalid query id")
+	}
+
+	if err := msg.FeeEscrow.Validate(); err != nil {
+		return fmt.Errorf("invalid fee escrow: %w", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRequeryInterchainQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRequeryInterchainQuery) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
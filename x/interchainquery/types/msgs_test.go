@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/incubus-network/fanfury-sdk/v2/app"
@@ -71,3 +72,86 @@ func TestMsgSubmitQueryResponse(t *testing.T) {
 	require.Equal(t, types.TypeMsgSubmitQueryResponse, msg.Type())
 	require.Equal(t, TestOwnerAddress, msg.GetSigners()[0].String())
 }
+
+func TestMsgRegisterInterchainQuery(t *testing.T) {
+	owner := sdk.MustAccAddressFromBech32(TestOwnerAddress)
+
+	tests := []struct {
+		name      string
+		malleate  func(msg *types.MsgRegisterInterchainQuery)
+		expectErr bool
+	}{
+		{"valid periodic query", func(msg *types.MsgRegisterInterchainQuery) {}, false},
+		{"valid query emitted once", func(msg *types.MsgRegisterInterchainQuery) { msg.Period = -1 }, false},
+		{"invalid owner", func(msg *types.MsgRegisterInterchainQuery) { msg.Owner = "invalid" }, true},
+		{"invalid connection", func(msg *types.MsgRegisterInterchainQuery) { msg.ConnectionId = "channel-0" }, true},
+		{"empty chain id", func(msg *types.MsgRegisterInterchainQuery) { msg.ChainId = "" }, true},
+		{"empty query type", func(msg *types.MsgRegisterInterchainQuery) { msg.QueryType = "" }, true},
+		{"transaction query", func(msg *types.MsgRegisterInterchainQuery) { msg.QueryType = types.QueryTypeTx }, false},
+		{"key without store", func(msg *types.MsgRegisterInterchainQuery) { msg.QueryType = "key" }, true},
+		{"store without name", func(msg *types.MsgRegisterInterchainQuery) { msg.QueryType = "store//key" }, true},
+		{"unproven grpc query", func(msg *types.MsgRegisterInterchainQuery) {
+			msg.QueryType = "cosmos.bank.v1beta1.Query/AllBalances"
+		}, true},
+		{"zero period", func(msg *types.MsgRegisterInterchainQuery) { msg.Period = 0 }, true},
+		{"negative period", func(msg *types.MsgRegisterInterchainQuery) { msg.Period = -2 }, true},
+		{"zero ttl", func(msg *types.MsgRegisterInterchainQuery) { msg.Ttl = 0 }, true},
+		{"valid retries", func(msg *types.MsgRegisterInterchainQuery) { msg.Timeout, msg.MaxRetries = 10, 3 }, false},
+		{"fixed height", func(msg *types.MsgRegisterInterchainQuery) { msg.Height = 10 }, false},
+		{"negative height", func(msg *types.MsgRegisterInterchainQuery) { msg.Height = -1 }, true},
+		{"retries without timeout", func(msg *types.MsgRegisterInterchainQuery) { msg.MaxRetries = 3 }, true},
+		{"invalid fee", func(msg *types.MsgRegisterInterchainQuery) {
+			msg.Fee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}}
+		}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgRegisterInterchainQuery(owner, "connection-0", "testchain-1", "store/bank/key", []byte{0x01}, 100, 10, 0, 0, nil, nil, 0)
+			tc.malleate(msg)
+
+			if tc.expectErr {
+				require.Error(t, msg.ValidateBasic())
+				return
+			}
+
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.TypeMsgRegisterInterchainQuery, msg.Type())
+			require.Equal(t, []sdk.AccAddress{owner}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgRemoveInterchainQuery(t *testing.T) {
+	owner := sdk.MustAccAddressFromBech32(TestOwnerAddress)
+	queryID := keeper.GenerateQueryHash("connection-0", "testchain-1", "store/bank/key", []byte{0x01}, TestOwnerAddress)
+
+	msg := types.NewMsgRemoveInterchainQuery(owner, queryID)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, types.TypeMsgRemoveInterchainQuery, msg.Type())
+	require.Equal(t, []sdk.AccAddress{owner}, msg.GetSigners())
+
+	msg.QueryId = "invalid"
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.NewMsgRemoveInterchainQuery(owner, queryID)
+	msg.Owner = ""
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgRequeryInterchainQuery(t *testing.T) {
+	owner := sdk.MustAccAddressFromBech32(TestOwnerAddress)
+	queryID := keeper.GenerateQueryHash("connection-0", "testchain-1", "store/bank/key", []byte{0x01}, TestOwnerAddress)
+	feeEscrow := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	msg := types.NewMsgRequeryInterchainQuery(owner, queryID, feeEscrow)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, types.TypeMsgRequeryInterchainQuery, msg.Type())
+	require.Equal(t, []sdk.AccAddress{owner}, msg.GetSigners())
+
+	msg.QueryId = "invalid"
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.NewMsgRequeryInterchainQuery(owner, queryID, sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}})
+	require.Error(t, msg.ValidateBasic())
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Parameter keys
+var (
+	KeyQueryDeposit    = []byte("QueryDeposit")
+	KeyMinQueryPeriod  = []byte("MinQueryPeriod")
+	KeyMaxQueryTtl     = []byte("MaxQueryTtl")
+	KeyMaxQueryRetries = []byte("MaxQueryRetries")
+)
+
+// Default parameter values
+const (
+	DefaultMinQueryPeriod  = uint64(10)     // a minute of blocks
+	DefaultMaxQueryTtl     = uint64(100800) // a week of blocks
+	DefaultMaxQueryRetries = uint64(5)
+
+	// MaxQueryRetriesLimit bounds the retries of a query, whose timeout
+	// doubles with each retry.
+	MaxQueryRetriesLimit = uint64(32)
+)
+
+// Default parameter values
+var (
+	DefaultQueryDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000))
+)
+
+var _ paramstypes.ParamSet = &Params{}
+
+// DefaultParams creates default interchainquery module parameters
+func DefaultParams() Params {
+	return Params{
+		QueryDeposit:    DefaultQueryDeposit,
+		MinQueryPeriod:  DefaultMinQueryPeriod,
+		MaxQueryTtl:     DefaultMaxQueryTtl,
+		MaxQueryRetries: DefaultMaxQueryRetries,
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of interchainquery module's parameters.
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyQueryDeposit, &p.QueryDeposit, validateQueryDeposit),
+		paramstypes.NewParamSetPair(KeyMinQueryPeriod, &p.MinQueryPeriod, validateMinQueryPeriod),
+		paramstypes.NewParamSetPair(KeyMaxQueryTtl, &p.MaxQueryTtl, validateMaxQueryTtl),
+		paramstypes.NewParamSetPair(KeyMaxQueryRetries, &p.MaxQueryRetries, validateMaxQueryRetries),
+	}
+}
+
+// String implements fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate performs basic validation on interchainquery parameters.
+func (p Params) Validate() error {
+	if err := validateQueryDeposit(p.QueryDeposit); err != nil {
+		return err
+	}
+
+	if err := validateMinQueryPeriod(p.MinQueryPeriod); err != nil {
+		return err
+	}
+
+	if err := validateMaxQueryTtl(p.MaxQueryTtl); err != nil {
+		return err
+	}
+
+	return validateMaxQueryRetries(p.MaxQueryRetries)
+}
+
+func validateQueryDeposit(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("interchainquery parameter QueryDeposit is invalid: %w", err)
+	}
+
+	return nil
+}
+
+func validateMinQueryPeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("interchainquery parameter MinQueryPeriod must be > 0")
+	}
+
+	return nil
+}
+
+func validateMaxQueryTtl(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("interchainquery parameter MaxQueryTtl must be > 0")
+	}
+
+	return nil
+}
+
+func validateMaxQueryRetries(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxQueryRetriesLimit {
+		return fmt.Errorf("interchainquery parameter MaxQueryRetries must be <= %d", MaxQueryRetriesLimit)
+	}
+
+	return nil
+}
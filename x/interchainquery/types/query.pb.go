@@ -141,30 +141,23 @@ func (m *QueryRequestsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// GetTxResponse is the response type for the Service.GetTx method.
-type GetTxWithProofResponse struct {
-	// tx is the queried transaction.
-	Tx *tx.Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// tx_response is the queried TxResponses.
-	TxResponse *types.TxResponse `protobuf:"bytes,2,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
-	// proof is the tmproto.TxProof for the queried tx
-	Proof *types1.TxProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
-	// ibc-go header to validate txs
-	Header *types2.Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+// QueryQueryRequest is the request type for the Query/Query RPC method.
+type QueryQueryRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *GetTxWithProofResponse) Reset()         { *m = GetTxWithProofResponse{} }
-func (m *GetTxWithProofResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTxWithProofResponse) ProtoMessage()    {}
-func (*GetTxWithProofResponse) Descriptor() ([]byte, []int) {
+func (m *QueryQueryRequest) Reset()         { *m = QueryQueryRequest{} }
+func (m *QueryQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryRequest) ProtoMessage()    {}
+func (*QueryQueryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9bd62a9cc0c2e078, []int{2}
 }
-func (m *GetTxWithProofResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTxWithProofResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryQueryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -174,419 +167,2491 @@ func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetTxWithProofResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTxWithProofResponse.Merge(m, src)
+func (m *QueryQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryRequest.Merge(m, src)
 }
-func (m *GetTxWithProofResponse) XXX_Size() int {
+func (m *QueryQueryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTxWithProofResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTxWithProofResponse.DiscardUnknown(m)
+func (m *QueryQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTxWithProofResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryQueryRequest proto.InternalMessageInfo
 
-func (m *GetTxWithProofResponse) GetTx() *tx.Tx {
+func (m *QueryQueryRequest) GetId() string {
 	if m != nil {
-		return m.Tx
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *GetTxWithProofResponse) GetTxResponse() *types.TxResponse {
-	if m != nil {
-		return m.TxResponse
-	}
-	return nil
+// QueryQueryResponse is the response type for the Query/Query RPC method.
+type QueryQueryResponse struct {
+	Query Query `protobuf:"bytes,1,opt,name=query,proto3" json:"query"`
 }
 
-func (m *GetTxWithProofResponse) GetProof() *types1.TxProof {
-	if m != nil {
-		return m.Proof
+func (m *QueryQueryResponse) Reset()         { *m = QueryQueryResponse{} }
+func (m *QueryQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryResponse) ProtoMessage()    {}
+func (*QueryQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{3}
+}
+func (m *QueryQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QueryQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryResponse.Merge(m, src)
+}
+func (m *QueryQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryResponse.DiscardUnknown(m)
 }
 
-func (m *GetTxWithProofResponse) GetHeader() *types2.Header {
+var xxx_messageInfo_QueryQueryResponse proto.InternalMessageInfo
+
+func (m *QueryQueryResponse) GetQuery() Query {
 	if m != nil {
-		return m.Header
+		return m.Query
 	}
-	return nil
+	return Query{}
 }
 
-func init() {
-	proto.RegisterType((*QueryRequestsRequest)(nil), "persistence.interchainquery.v1beta1.QueryRequestsRequest")
-	proto.RegisterType((*QueryRequestsResponse)(nil), "persistence.interchainquery.v1beta1.QueryRequestsResponse")
-	proto.RegisterType((*GetTxWithProofResponse)(nil), "persistence.interchainquery.v1beta1.GetTxWithProofResponse")
+// QueryAllQueriesRequest is the request type for the Query/AllQueries RPC
+// method. The empty filters match all the queries.
+type QueryAllQueriesRequest struct {
+	Pagination   *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ConnectionId string             `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Module       string             `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+	QueryType    string             `protobuf:"bytes,4,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	Owner        string             `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("persistence/interchainquery/v1beta1/query.proto", fileDescriptor_9bd62a9cc0c2e078)
+func (m *QueryAllQueriesRequest) Reset()         { *m = QueryAllQueriesRequest{} }
+func (m *QueryAllQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueriesRequest) ProtoMessage()    {}
+func (*QueryAllQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{4}
 }
-
-var fileDescriptor_9bd62a9cc0c2e078 = []byte{
-	// 570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xa4, 0xb5, 0xb1, 0x93, 0xdb, 0xd2, 0x4a, 0x1a, 0x64, 0x0d, 0xa9, 0x1f, 0xa1, 0xe0,
-	0x0c, 0x89, 0x07, 0xa9, 0x07, 0x85, 0xa2, 0x56, 0xbd, 0xd8, 0xae, 0x01, 0xc1, 0x83, 0x65, 0x77,
-	0xf3, 0xba, 0x19, 0x4c, 0x66, 0xb6, 0x3b, 0x93, 0xb0, 0x41, 0xbc, 0xf8, 0x0b, 0x04, 0xff, 0x86,
-	0x7f, 0xc2, 0x5b, 0x6f, 0x16, 0xbc, 0x78, 0x12, 0x49, 0xfc, 0x0f, 0x5e, 0x65, 0x67, 0x27, 0xeb,
-	0x24, 0x1e, 0x0c, 0xbd, 0xec, 0xce, 0xcb, 0x3c, 0xcf, 0xf3, 0x3e, 0xef, 0xc7, 0x2e, 0xa6, 0x31,
-	0x24, 0x92, 0x49, 0x05, 0x3c, 0x04, 0xca, 0xb8, 0x82, 0x24, 0xec, 0xfb, 0x8c, 0x9f, 0x8e, 0x20,
-	0x99, 0xd0, 0x71, 0x3b, 0x00, 0xe5, 0xb7, 0xa9, 0x8e, 0x48, 0x9c, 0x08, 0x25, 0x9c, 0x5d, 0x8b,
-	0x40, 0x96, 0x08, 0xc4, 0x10, 0xea, 0x5b, 0x91, 0x88, 0x84, 0xc6, 0xd3, 0xec, 0x94, 0x53, 0xeb,
-	0x57, 0x23, 0x21, 0xa2, 0x01, 0x50, 0x3f, 0x66, 0xd4, 0xe7, 0x5c, 0x28, 0x5f, 0x31, 0xc1, 0xa5,
-	0xb9, 0x6d, 0xaf, 0xe2, 0x24, 0x02, 0x0e, 0x92, 0xcd, 0x29, 0x7b, 0xa1, 0x90, 0x43, 0x21, 0x69,
-	0xe0, 0x4b, 0xa0, 0x8b, 0xc0, 0xd8, 0x8f, 0x18, 0xd7, 0xfa, 0x06, 0xbb, 0x6b, 0x63, 0xfd, 0x20,
-	0x64, 0x05, 0x34, 0x0b, 0x0c, 0xa8, 0x6e, 0x40, 0x2a, 0x2d, 0x6e, 0x55, 0x3a, 0x77, 0xaf, 0x80,
-	0xf7, 0x20, 0x19, 0x32, 0xae, 0xa8, 0x9a, 0xc4, 0x20, 0xf3, 0xa7, 0xb9, 0xa5, 0x2c, 0x08, 0xe9,
-	0x80, 0x45, 0x7d, 0x15, 0x0e, 0x18, 0x70, 0x25, 0xa9, 0x05, 0x1f, 0xb7, 0xad, 0x28, 0x27, 0x34,
-	0x27, 0x78, 0xeb, 0x38, 0x73, 0xec, 0xc1, 0xe9, 0x08, 0xa4, 0x92, 0xe6, 0xed, 0x3c, 0xc6, 0xf8,
-	0xaf, 0xf7, 0x1a, 0x6a, 0xa0, 0x56, 0xb5, 0x73, 0x93, 0xe4, 0xbe, 0x48, 0x66, 0x9e, 0x2c, 0xb4,
-	0x9a, 0x1c, 0xf9, 0x11, 0x18, 0xae, 0x67, 0x31, 0x9d, 0x1d, 0x7c, 0x59, 0xf7, 0xef, 0x84, 0xf5,
-	0x6a, 0xe5, 0x06, 0x6a, 0x6d, 0x7a, 0x15, 0x1d, 0x3f, 0xed, 0x35, 0x3f, 0x23, 0xbc, 0xbd, 0x94,
-	0x5b, 0xc6, 0x82, 0x4b, 0x70, 0x9e, 0xe1, 0x4a, 0xa6, 0xce, 0x40, 0xd6, 0x50, 0x63, 0xad, 0x55,
-	0xed, 0xec, 0x91, 0x15, 0xc6, 0x4d, 0xb4, 0xd8, 0xc1, 0xfa, 0xd9, 0x8f, 0x6b, 0x25, 0x6f, 0x2e,
-	0xe0, 0x1c, 0x2e, 0x14, 0x52, 0xd6, 0x85, 0xdc, 0xfa, 0x6f, 0x21, 0xb9, 0x11, 0xbb, 0x92, 0xe6,
-	0x6f, 0x84, 0xaf, 0x1c, 0x82, 0xea, 0xa6, 0x2f, 0x99, 0xea, 0x1f, 0x25, 0x42, 0xbc, 0x29, 0xfc,
-	0xde, 0xc0, 0x65, 0x95, 0x9a, 0x26, 0x6d, 0xcf, 0xb5, 0x55, 0x5a, 0x68, 0x76, 0x53, 0xaf, 0xac,
-	0x52, 0xe7, 0x11, 0xae, 0xaa, 0xf4, 0x24, 0x31, 0x2c, 0xe3, 0xe5, 0xfa, 0x82, 0x17, 0xbd, 0x04,
-	0x16, 0xad, 0x30, 0xa2, 0x8a, 0xb3, 0x43, 0xf1, 0xa5, 0x38, 0x4b, 0x5f, 0x5b, 0xd3, 0x02, 0x3b,
-	0xc4, 0x1a, 0x6a, 0xbe, 0x0b, 0xdd, 0x34, 0xf7, 0x97, 0xe3, 0x9c, 0xfb, 0x78, 0xa3, 0x0f, 0x7e,
-	0x0f, 0x92, 0xda, 0xba, 0x99, 0x23, 0x0b, 0x42, 0x62, 0x6f, 0x89, 0x2d, 0x31, 0x6e, 0x93, 0x27,
-	0x1a, 0xed, 0x19, 0x56, 0xe7, 0x2b, 0xc2, 0x9b, 0xba, 0xb7, 0x2f, 0x92, 0x71, 0xe2, 0x7c, 0x41,
-	0xb8, 0x72, 0x6c, 0x9a, 0xbb, 0xbf, 0xfa, 0x5c, 0x96, 0x16, 0xac, 0x7e, 0xef, 0x22, 0xd4, 0xbc,
-	0x03, 0xcd, 0x07, 0x1f, 0xbe, 0xfd, 0xfa, 0x54, 0xde, 0x77, 0xee, 0xae, 0xfc, 0xdb, 0x60, 0x20,
-	0xe9, 0xbb, 0xf9, 0x22, 0xbe, 0x3f, 0x78, 0x7d, 0x36, 0x75, 0xd1, 0xf9, 0xd4, 0x45, 0x3f, 0xa7,
-	0x2e, 0xfa, 0x38, 0x73, 0x4b, 0xe7, 0x33, 0xb7, 0xf4, 0x7d, 0xe6, 0x96, 0x5e, 0x3d, 0x8c, 0x98,
-	0xea, 0x8f, 0x02, 0x12, 0x8a, 0xa1, 0x2d, 0xfe, 0x9c, 0x83, 0x1d, 0xde, 0x96, 0xbd, 0xb7, 0x74,
-	0xdc, 0xa1, 0xe9, 0x3f, 0x49, 0xf5, 0x00, 0x82, 0x0d, 0xfd, 0x71, 0xdd, 0xf9, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0xf9, 0x09, 0xa9, 0xb2, 0xd7, 0x04, 0x00, 0x00,
+func (m *QueryAllQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueriesRequest.Merge(m, src)
+}
+func (m *QueryAllQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueriesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAllQueriesRequest proto.InternalMessageInfo
 
-// QuerySrvrClient is the client API for QuerySrvr service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QuerySrvrClient interface {
-	// Params returns the total set of minting parameters.
-	Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error)
+func (m *QueryAllQueriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type querySrvrClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAllQueriesRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
 }
 
-func NewQuerySrvrClient(cc grpc1.ClientConn) QuerySrvrClient {
-	return &querySrvrClient{cc}
+func (m *QueryAllQueriesRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
 }
 
-func (c *querySrvrClient) Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error) {
-	out := new(QueryRequestsResponse)
-	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.QuerySrvr/Queries", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllQueriesRequest) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
 	}
-	return out, nil
+	return ""
 }
 
-// QuerySrvrServer is the server API for QuerySrvr service.
-type QuerySrvrServer interface {
-	// Params returns the total set of minting parameters.
-	Queries(context.Context, *QueryRequestsRequest) (*QueryRequestsResponse, error)
+func (m *QueryAllQueriesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-// UnimplementedQuerySrvrServer can be embedded to have forward compatible implementations.
-type UnimplementedQuerySrvrServer struct {
+// QueryAllQueriesResponse is the response type for the Query/AllQueries RPC
+// method.
+type QueryAllQueriesResponse struct {
+	Queries    []Query             `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQuerySrvrServer) Queries(ctx context.Context, req *QueryRequestsRequest) (*QueryRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Queries not implemented")
+func (m *QueryAllQueriesResponse) Reset()         { *m = QueryAllQueriesResponse{} }
+func (m *QueryAllQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueriesResponse) ProtoMessage()    {}
+func (*QueryAllQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{5}
 }
-
-func RegisterQuerySrvrServer(s grpc1.Server, srv QuerySrvrServer) {
-	s.RegisterService(&_QuerySrvr_serviceDesc, srv)
+func (m *QueryAllQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _QuerySrvr_Queries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QuerySrvrServer).Queries(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.interchainquery.v1beta1.QuerySrvr/Queries",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QuerySrvrServer).Queries(ctx, req.(*QueryRequestsRequest))
+func (m *QueryAllQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueriesResponse.Merge(m, src)
+}
+func (m *QueryAllQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueriesResponse.DiscardUnknown(m)
 }
 
-var _QuerySrvr_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "persistence.interchainquery.v1beta1.QuerySrvr",
-	HandlerType: (*QuerySrvrServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Queries",
-			Handler:    _QuerySrvr_Queries_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "persistence/interchainquery/v1beta1/query.proto",
+var xxx_messageInfo_QueryAllQueriesResponse proto.InternalMessageInfo
+
+func (m *QueryAllQueriesResponse) GetQueries() []Query {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
 }
 
-func (m *QueryRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllQueriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDatapointRequest is the request type for the Query/Datapoint RPC
+// method.
+type QueryDatapointRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDatapointRequest) Reset()         { *m = QueryDatapointRequest{} }
+func (m *QueryDatapointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDatapointRequest) ProtoMessage()    {}
+func (*QueryDatapointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{6}
+}
+func (m *QueryDatapointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatapointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatapointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDatapointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatapointRequest.Merge(m, src)
+}
+func (m *QueryDatapointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatapointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatapointRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDatapointRequest proto.InternalMessageInfo
+
+func (m *QueryDatapointRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDatapointResponse is the response type for the Query/Datapoint RPC
+// method.
+type QueryDatapointResponse struct {
+	Datapoint DataPoint `protobuf:"bytes,1,opt,name=datapoint,proto3" json:"datapoint"`
 }
 
-func (m *QueryRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Queries) > 0 {
-		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDatapointResponse) Reset()         { *m = QueryDatapointResponse{} }
+func (m *QueryDatapointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDatapointResponse) ProtoMessage()    {}
+func (*QueryDatapointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{7}
+}
+func (m *QueryDatapointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatapointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatapointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDatapointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatapointResponse.Merge(m, src)
+}
+func (m *QueryDatapointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatapointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatapointResponse.DiscardUnknown(m)
 }
 
-func (m *GetTxWithProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDatapointResponse proto.InternalMessageInfo
+
+func (m *QueryDatapointResponse) GetDatapoint() DataPoint {
+	if m != nil {
+		return m.Datapoint
 	}
-	return dAtA[:n], nil
+	return DataPoint{}
 }
 
-func (m *GetTxWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDatapointsRequest is the request type for the Query/Datapoints RPC
+// method.
+type QueryDatapointsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetTxWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDatapointsRequest) Reset()         { *m = QueryDatapointsRequest{} }
+func (m *QueryDatapointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDatapointsRequest) ProtoMessage()    {}
+func (*QueryDatapointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{8}
+}
+func (m *QueryDatapointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatapointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatapointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *QueryDatapointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatapointsRequest.Merge(m, src)
+}
+func (m *QueryDatapointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatapointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatapointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDatapointsRequest proto.InternalMessageInfo
+
+func (m *QueryDatapointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.TxResponse != nil {
-		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return nil
+}
+
+// QueryDatapointsResponse is the response type for the Query/Datapoints RPC
+// method.
+type QueryDatapointsResponse struct {
+	Datapoints []DataPoint         `protobuf:"bytes,1,rep,name=datapoints,proto3" json:"datapoints"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDatapointsResponse) Reset()         { *m = QueryDatapointsResponse{} }
+func (m *QueryDatapointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDatapointsResponse) ProtoMessage()    {}
+func (*QueryDatapointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{9}
+}
+func (m *QueryDatapointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatapointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatapointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryDatapointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatapointsResponse.Merge(m, src)
+}
+func (m *QueryDatapointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatapointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatapointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDatapointsResponse proto.InternalMessageInfo
+
+func (m *QueryDatapointsResponse) GetDatapoints() []DataPoint {
+	if m != nil {
+		return m.Datapoints
+	}
+	return nil
+}
+
+func (m *QueryDatapointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{10}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{11}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return Params{}
+}
+
+// GetTxResponse is the response type for the Service.GetTx method.
+type GetTxWithProofResponse struct {
+	// tx is the queried transaction.
+	Tx *tx.Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// tx_response is the queried TxResponses.
+	TxResponse *types.TxResponse `protobuf:"bytes,2,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
+	// proof is the tmproto.TxProof for the queried tx
+	Proof *types1.TxProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// ibc-go header to validate txs
+	Header *types2.Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *GetTxWithProofResponse) Reset()         { *m = GetTxWithProofResponse{} }
+func (m *GetTxWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxWithProofResponse) ProtoMessage()    {}
+func (*GetTxWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bd62a9cc0c2e078, []int{12}
+}
+func (m *GetTxWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *GetTxWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxWithProofResponse.Merge(m, src)
+}
+func (m *GetTxWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxWithProofResponse proto.InternalMessageInfo
+
+func (m *GetTxWithProofResponse) GetTx() *tx.Tx {
+	if m != nil {
+		return m.Tx
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTxWithProofResponse) GetTxResponse() *types.TxResponse {
+	if m != nil {
+		return m.TxResponse
 	}
-	var l int
-	_ = l
-	if len(m.Queries) > 0 {
+	return nil
+}
+
+func (m *GetTxWithProofResponse) GetProof() *types1.TxProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *GetTxWithProofResponse) GetHeader() *types2.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryRequestsRequest)(nil), "persistence.interchainquery.v1beta1.QueryRequestsRequest")
+	proto.RegisterType((*QueryRequestsResponse)(nil), "persistence.interchainquery.v1beta1.QueryRequestsResponse")
+	proto.RegisterType((*QueryQueryRequest)(nil), "persistence.interchainquery.v1beta1.QueryQueryRequest")
+	proto.RegisterType((*QueryQueryResponse)(nil), "persistence.interchainquery.v1beta1.QueryQueryResponse")
+	proto.RegisterType((*QueryAllQueriesRequest)(nil), "persistence.interchainquery.v1beta1.QueryAllQueriesRequest")
+	proto.RegisterType((*QueryAllQueriesResponse)(nil), "persistence.interchainquery.v1beta1.QueryAllQueriesResponse")
+	proto.RegisterType((*QueryDatapointRequest)(nil), "persistence.interchainquery.v1beta1.QueryDatapointRequest")
+	proto.RegisterType((*QueryDatapointResponse)(nil), "persistence.interchainquery.v1beta1.QueryDatapointResponse")
+	proto.RegisterType((*QueryDatapointsRequest)(nil), "persistence.interchainquery.v1beta1.QueryDatapointsRequest")
+	proto.RegisterType((*QueryDatapointsResponse)(nil), "persistence.interchainquery.v1beta1.QueryDatapointsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "persistence.interchainquery.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "persistence.interchainquery.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*GetTxWithProofResponse)(nil), "persistence.interchainquery.v1beta1.GetTxWithProofResponse")
+}
+
+func init() {
+	proto.RegisterFile("persistence/interchainquery/v1beta1/query.proto", fileDescriptor_9bd62a9cc0c2e078)
+}
+
+var fileDescriptor_9bd62a9cc0c2e078 = []byte{
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xb8, 0x89, 0x53, 0xbf, 0x00, 0x12, 0x43, 0x1a, 0xdc, 0x15, 0x98, 0x68, 0x03, 0x34,
+	0x6a, 0xc9, 0x8e, 0xec, 0x56, 0x0d, 0xa5, 0x11, 0xa8, 0x15, 0xb4, 0x84, 0x53, 0x6a, 0x2c, 0x21,
+	0x01, 0x52, 0xba, 0xde, 0x9d, 0xac, 0x47, 0xb5, 0x67, 0xb6, 0x3b, 0x63, 0x77, 0xad, 0x8a, 0x0b,
+	0xbf, 0x00, 0x89, 0xbf, 0x81, 0x10, 0xdc, 0xb8, 0x20, 0x01, 0xa7, 0x1e, 0x2b, 0xb8, 0x70, 0x42,
+	0x28, 0xe1, 0x3f, 0x70, 0x43, 0x68, 0x67, 0x67, 0xd7, 0x6b, 0x47, 0x88, 0xb5, 0x95, 0x43, 0x2f,
+	0x89, 0x67, 0xe6, 0x7d, 0xef, 0x7d, 0xdf, 0x7b, 0x6f, 0xde, 0x2c, 0x90, 0x90, 0x46, 0x92, 0x49,
+	0x45, 0xb9, 0x47, 0x09, 0xe3, 0x8a, 0x46, 0x5e, 0xcf, 0x65, 0xfc, 0xe1, 0x90, 0x46, 0x63, 0x32,
+	0x6a, 0x76, 0xa9, 0x72, 0x9b, 0x44, 0xaf, 0x9c, 0x30, 0x12, 0x4a, 0xe0, 0xad, 0x02, 0xc0, 0x99,
+	0x01, 0x38, 0x06, 0x60, 0xad, 0x07, 0x22, 0x10, 0xda, 0x9e, 0x24, 0xbf, 0x52, 0xa8, 0xf5, 0x4a,
+	0x20, 0x44, 0xd0, 0xa7, 0xc4, 0x0d, 0x19, 0x71, 0x39, 0x17, 0xca, 0x55, 0x4c, 0x70, 0x69, 0x4e,
+	0x9b, 0x65, 0x98, 0x04, 0x94, 0x53, 0xc9, 0x32, 0xc8, 0x65, 0x4f, 0xc8, 0x81, 0x90, 0xa4, 0xeb,
+	0x4a, 0x4a, 0xa6, 0x0d, 0x43, 0x37, 0x60, 0x5c, 0xfb, 0x37, 0xb6, 0x5b, 0x45, 0x5b, 0xb7, 0xeb,
+	0xb1, 0xdc, 0x34, 0x59, 0x18, 0x23, 0xcb, 0x18, 0xa9, 0x38, 0x3f, 0x55, 0x71, 0xc6, 0x5e, 0x51,
+	0xee, 0xd3, 0x68, 0xc0, 0xb8, 0x22, 0x6a, 0x1c, 0x52, 0x99, 0xfe, 0x35, 0xa7, 0x84, 0x75, 0x3d,
+	0xd2, 0x67, 0x41, 0x4f, 0x79, 0x7d, 0x46, 0xb9, 0x92, 0xa4, 0x60, 0x3e, 0x6a, 0x16, 0x56, 0x29,
+	0xc0, 0x1e, 0xc3, 0xfa, 0xbd, 0x84, 0x71, 0x9b, 0x3e, 0x1c, 0x52, 0xa9, 0xa4, 0xf9, 0x8f, 0xef,
+	0x00, 0x4c, 0xb8, 0xd7, 0xd1, 0x26, 0xda, 0x5e, 0x6b, 0xbd, 0xe9, 0xa4, 0xbc, 0x9c, 0x84, 0xbc,
+	0x33, 0x95, 0x6a, 0xe7, 0xc0, 0x0d, 0xa8, 0xc1, 0xb6, 0x0b, 0x48, 0x7c, 0x11, 0xce, 0xeb, 0xfc,
+	0x1d, 0x32, 0xbf, 0x5e, 0xd9, 0x44, 0xdb, 0xb5, 0xf6, 0xaa, 0x5e, 0xef, 0xfb, 0xf6, 0x37, 0x08,
+	0x2e, 0xcc, 0xc4, 0x96, 0xa1, 0xe0, 0x92, 0xe2, 0x8f, 0x60, 0x35, 0xf1, 0xce, 0xa8, 0xac, 0xa3,
+	0xcd, 0x73, 0xdb, 0x6b, 0xad, 0xcb, 0x4e, 0x89, 0x72, 0x3b, 0xda, 0xd9, 0xed, 0xe5, 0x27, 0x7f,
+	0xbc, 0xb6, 0xd4, 0xce, 0x1c, 0xe0, 0xbb, 0x53, 0x42, 0x2a, 0x5a, 0xc8, 0xa5, 0xff, 0x15, 0x92,
+	0x12, 0x29, 0x2a, 0xb1, 0xb7, 0xe0, 0x45, 0x1d, 0xa0, 0x48, 0x19, 0xbf, 0x00, 0x15, 0xe6, 0xeb,
+	0xf4, 0xd4, 0xda, 0x15, 0xe6, 0xdb, 0x9f, 0x03, 0x2e, 0x1a, 0x19, 0x3d, 0x77, 0x60, 0x45, 0x07,
+	0x31, 0x79, 0x9c, 0x5f, 0x4d, 0x0a, 0xb7, 0x7f, 0x45, 0xb0, 0xa1, 0xb7, 0x6f, 0xf5, 0xfb, 0xf7,
+	0x52, 0x7d, 0x67, 0x5d, 0xaf, 0x2d, 0x78, 0xde, 0x13, 0x9c, 0x53, 0x2f, 0x59, 0x4d, 0x8a, 0xf6,
+	0xdc, 0x64, 0x73, 0xdf, 0xc7, 0x1b, 0x50, 0x1d, 0x08, 0x7f, 0xd8, 0xa7, 0xf5, 0x73, 0xfa, 0xd4,
+	0xac, 0xf0, 0xab, 0x00, 0x3a, 0xca, 0x61, 0xd2, 0x92, 0xf5, 0x65, 0x7d, 0x56, 0xd3, 0x3b, 0x9d,
+	0x71, 0x48, 0xf1, 0x3a, 0xac, 0x88, 0x47, 0x9c, 0x46, 0xf5, 0x15, 0x7d, 0x92, 0x2e, 0xec, 0x6f,
+	0x11, 0xbc, 0x7c, 0x4a, 0xd4, 0xb3, 0xdc, 0x08, 0x97, 0x4c, 0xdb, 0xbe, 0xef, 0x2a, 0x37, 0x14,
+	0x8c, 0xab, 0xff, 0x6a, 0x86, 0x3e, 0x6c, 0xcc, 0x1a, 0x1a, 0x5d, 0x6d, 0xa8, 0xf9, 0xd9, 0xa6,
+	0x29, 0x96, 0x53, 0x4a, 0x59, 0xe2, 0xea, 0x20, 0x41, 0x19, 0x75, 0x13, 0x37, 0xf6, 0xfd, 0xd9,
+	0x68, 0x67, 0xdd, 0x1b, 0xf6, 0x0f, 0x59, 0xa5, 0x8a, 0x21, 0x8c, 0xa2, 0x0e, 0x40, 0x4e, 0x25,
+	0x2b, 0xd6, 0x62, 0x92, 0x0a, 0x7e, 0xce, 0xae, 0x66, 0xeb, 0xe6, 0x5e, 0x1e, 0xb8, 0x91, 0x3b,
+	0xc8, 0x12, 0x63, 0xdf, 0x87, 0x97, 0xa6, 0x76, 0x8d, 0x96, 0x7d, 0xa8, 0x86, 0x7a, 0xc7, 0xe4,
+	0xea, 0x4a, 0x29, 0x1d, 0xa9, 0x13, 0x23, 0xc2, 0x38, 0xb0, 0xff, 0x46, 0xb0, 0x71, 0x97, 0xaa,
+	0x4e, 0xfc, 0x09, 0x53, 0xbd, 0x83, 0x48, 0x88, 0xa3, 0x3c, 0xca, 0x1b, 0x50, 0x51, 0xb1, 0x89,
+	0x70, 0x21, 0xd3, 0xa4, 0xe2, 0xdc, 0x5f, 0x27, 0x6e, 0x57, 0x54, 0x8c, 0x3f, 0x80, 0x35, 0x15,
+	0x1f, 0x46, 0x06, 0x65, 0x72, 0xf0, 0xfa, 0x54, 0x0e, 0xf4, 0xcb, 0x51, 0x80, 0xe5, 0x09, 0x50,
+	0xf9, 0x6f, 0x4c, 0x60, 0x25, 0x4c, 0xc2, 0xeb, 0x1b, 0xbb, 0xd6, 0xba, 0xe8, 0x14, 0x5e, 0x82,
+	0xf4, 0x01, 0xe9, 0xc4, 0x29, 0xbf, 0xd4, 0x0e, 0xbf, 0x0b, 0xd5, 0x1e, 0x75, 0x7d, 0x1a, 0xd5,
+	0x97, 0x4d, 0xc3, 0xb0, 0xae, 0xe7, 0x14, 0x9f, 0x96, 0xa2, 0x8b, 0x51, 0xd3, 0xf9, 0x50, 0x5b,
+	0xb7, 0x0d, 0xaa, 0xf5, 0xcf, 0x79, 0xa8, 0xe9, 0xe4, 0x7e, 0x1c, 0x8d, 0x22, 0xfc, 0x33, 0x82,
+	0x55, 0x73, 0xb9, 0xf1, 0x8d, 0xf2, 0x77, 0x78, 0xe6, 0x55, 0xb2, 0xde, 0x59, 0x04, 0x9a, 0x66,
+	0xc0, 0x7e, 0xef, 0xcb, 0xdf, 0xfe, 0xfa, 0xba, 0x72, 0x03, 0xef, 0x96, 0xfe, 0xd6, 0x60, 0x54,
+	0x92, 0xc7, 0xd9, 0xeb, 0xf5, 0x05, 0xfe, 0x1e, 0xc1, 0x8a, 0x76, 0x8d, 0xaf, 0x97, 0xa7, 0x51,
+	0xe4, 0x62, 0xed, 0xce, 0x8d, 0x33, 0xdc, 0x77, 0x35, 0xf7, 0x26, 0x26, 0xe5, 0xbf, 0x93, 0xc8,
+	0xe3, 0x84, 0xf3, 0x8f, 0x08, 0x60, 0x32, 0x57, 0xf1, 0xcd, 0xf2, 0x04, 0x4e, 0x3d, 0x31, 0xd6,
+	0xde, 0x62, 0x60, 0x23, 0xe1, 0x9a, 0x96, 0xe0, 0xe0, 0xb7, 0xe6, 0x49, 0x3f, 0xfe, 0x05, 0x41,
+	0x2d, 0x9f, 0x36, 0x78, 0x8e, 0xf2, 0xcf, 0x0e, 0x67, 0xeb, 0xe6, 0x42, 0x58, 0x43, 0x7e, 0x4f,
+	0x93, 0xbf, 0x8e, 0xaf, 0x95, 0x22, 0x3f, 0x19, 0x60, 0x69, 0x11, 0x7e, 0x42, 0x00, 0xb9, 0xcf,
+	0xb9, 0x8a, 0x70, 0x6a, 0x96, 0x5b, 0x7b, 0x8b, 0x81, 0x17, 0xea, 0xa3, 0xc2, 0x20, 0xfe, 0x0e,
+	0x41, 0x35, 0x1d, 0x70, 0x78, 0x8e, 0x26, 0x9e, 0x9a, 0xb6, 0xd6, 0xdb, 0xf3, 0x03, 0x0d, 0xed,
+	0xab, 0x9a, 0xf6, 0x0e, 0xbe, 0x52, 0x8a, 0x76, 0x3a, 0x7a, 0x6f, 0x7f, 0xf6, 0xe4, 0xb8, 0x81,
+	0x9e, 0x1e, 0x37, 0xd0, 0x9f, 0xc7, 0x0d, 0xf4, 0xd5, 0x49, 0x63, 0xe9, 0xe9, 0x49, 0x63, 0xe9,
+	0xf7, 0x93, 0xc6, 0xd2, 0xa7, 0xb7, 0x02, 0xa6, 0x7a, 0xc3, 0xae, 0xe3, 0x89, 0x01, 0x61, 0xdc,
+	0x1b, 0x76, 0x87, 0x72, 0x87, 0x53, 0xf5, 0x48, 0x44, 0x0f, 0xc8, 0x91, 0xcb, 0x8f, 0x86, 0xd1,
+	0x78, 0x47, 0xfa, 0x0f, 0xc8, 0xa8, 0x45, 0xe2, 0x53, 0x51, 0xf4, 0xb0, 0xec, 0x56, 0xf5, 0xd7,
+	0xf3, 0xd5, 0x7f, 0x07, 0x00, 0xb5, 0x16, 0x40, 0x8f, 0xb8, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QuerySrvrClient is the client API for QuerySrvr service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QuerySrvrClient interface {
+	// Params returns the total set of minting parameters.
+	Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error)
+	// Query returns a query by its ID.
+	Query(ctx context.Context, in *QueryQueryRequest, opts ...grpc.CallOption) (*QueryQueryResponse, error)
+	// AllQueries returns all the queries, optionally filtered.
+	AllQueries(ctx context.Context, in *QueryAllQueriesRequest, opts ...grpc.CallOption) (*QueryAllQueriesResponse, error)
+	// Datapoint returns the datapoint of a query by its ID.
+	Datapoint(ctx context.Context, in *QueryDatapointRequest, opts ...grpc.CallOption) (*QueryDatapointResponse, error)
+	// Datapoints returns all the datapoints.
+	Datapoints(ctx context.Context, in *QueryDatapointsRequest, opts ...grpc.CallOption) (*QueryDatapointsResponse, error)
+	// Params returns the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type querySrvrClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQuerySrvrClient(cc grpc1.ClientConn) QuerySrvrClient {
+	return &querySrvrClient{cc}
+}
+
+func (c *querySrvrClient) Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error) {
+	out := new(QueryRequestsResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.QuerySrvr/Queries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Query(ctx context.Context, in *QueryQueryRequest, opts ...grpc.CallOption) (*QueryQueryResponse, error) {
+	out := new(QueryQueryResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.QuerySrvr/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) AllQueries(ctx context.Context, in *QueryAllQueriesRequest, opts ...grpc.CallOption) (*QueryAllQueriesResponse, error) {
+	out := new(QueryAllQueriesResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.QuerySrvr/AllQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Datapoint(ctx context.Context, in *QueryDatapointRequest, opts ...grpc.CallOption) (*QueryDatapointResponse, error) {
+	out := new(QueryDatapointResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.QuerySrvr/Datapoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Datapoints(ctx context.Context, in *QueryDatapointsRequest, opts ...grpc.CallOption) (*QueryDatapointsResponse, error) {
+	out := new(QueryDatapointsResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.QuerySrvr/Datapoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/persistence.interchainquery.v1beta1.QuerySrvr/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuerySrvrServer is the server API for QuerySrvr service.
+type QuerySrvrServer interface {
+	// Params returns the total set of minting parameters.
+	Queries(context.Context, *QueryRequestsRequest) (*QueryRequestsResponse, error)
+	// Query returns a query by its ID.
+	Query(context.Context, *QueryQueryRequest) (*QueryQueryResponse, error)
+	// AllQueries returns all the queries, optionally filtered.
+	AllQueries(context.Context, *QueryAllQueriesRequest) (*QueryAllQueriesResponse, error)
+	// Datapoint returns the datapoint of a query by its ID.
+	Datapoint(context.Context, *QueryDatapointRequest) (*QueryDatapointResponse, error)
+	// Datapoints returns all the datapoints.
+	Datapoints(context.Context, *QueryDatapointsRequest) (*QueryDatapointsResponse, error)
+	// Params returns the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQuerySrvrServer can be embedded to have forward compatible implementations.
+type UnimplementedQuerySrvrServer struct {
+}
+
+func (*UnimplementedQuerySrvrServer) Queries(ctx context.Context, req *QueryRequestsRequest) (*QueryRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Queries not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Query(ctx context.Context, req *QueryQueryRequest) (*QueryQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (*UnimplementedQuerySrvrServer) AllQueries(ctx context.Context, req *QueryAllQueriesRequest) (*QueryAllQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllQueries not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Datapoint(ctx context.Context, req *QueryDatapointRequest) (*QueryDatapointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Datapoint not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Datapoints(ctx context.Context, req *QueryDatapointsRequest) (*QueryDatapointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Datapoints not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQuerySrvrServer(s grpc1.Server, srv QuerySrvrServer) {
+	s.RegisterService(&_QuerySrvr_serviceDesc, srv)
+}
+
+func _QuerySrvr_Queries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Queries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.QuerySrvr/Queries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Queries(ctx, req.(*QueryRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.QuerySrvr/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Query(ctx, req.(*QueryQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_AllQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).AllQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.QuerySrvr/AllQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).AllQueries(ctx, req.(*QueryAllQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Datapoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDatapointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Datapoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.QuerySrvr/Datapoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Datapoint(ctx, req.(*QueryDatapointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Datapoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDatapointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Datapoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.QuerySrvr/Datapoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Datapoints(ctx, req.(*QueryDatapointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.interchainquery.v1beta1.QuerySrvr/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QuerySrvr_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "persistence.interchainquery.v1beta1.QuerySrvr",
+	HandlerType: (*QuerySrvrServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Queries",
+			Handler:    _QuerySrvr_Queries_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _QuerySrvr_Query_Handler,
+		},
+		{
+			MethodName: "AllQueries",
+			Handler:    _QuerySrvr_AllQueries_Handler,
+		},
+		{
+			MethodName: "Datapoint",
+			Handler:    _QuerySrvr_Datapoint_Handler,
+		},
+		{
+			MethodName: "Datapoints",
+			Handler:    _QuerySrvr_Datapoints_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _QuerySrvr_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "persistence/interchainquery/v1beta1/query.proto",
+}
+
+func (m *QueryRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatapointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatapointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatapointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatapointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatapointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatapointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Datapoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatapointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatapointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatapointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatapointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatapointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatapointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Datapoints) > 0 {
+		for iNdEx := len(m.Datapoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datapoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Query.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
 		for _, e := range m.Queries {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDatapointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDatapointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Datapoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDatapointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDatapointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datapoints) > 0 {
+		for _, e := range m.Datapoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetTxWithProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDatapointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDatapointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDatapointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GetTxWithProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TxResponse != nil {
-		l = m.TxResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDatapointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -609,15 +2674,15 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDatapointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDatapointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -644,18 +2709,65 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datapoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDatapointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDatapointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDatapointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -665,23 +2777,27 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -704,7 +2820,7 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDatapointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -727,15 +2843,15 @@ func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDatapointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDatapointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -762,8 +2878,8 @@ func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queries = append(m.Queries, Query{})
-			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Datapoints = append(m.Datapoints, DataPoint{})
+			if err := m.Datapoints[len(m.Datapoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -824,6 +2940,139 @@ func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetTxWithProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,8 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	sk         types.StakingKeeper
 	paramspace types.ParamSubspace
+
+	unjailGuard types.UnjailGuard
 }
 
 // NewKeeper creates a slashing keeper
@@ -35,6 +37,17 @@ func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingK
 	}
 }
 
+// SetUnjailGuard sets the guard consulted before unjailing a validator.
+func (k *Keeper) SetUnjailGuard(ug types.UnjailGuard) *Keeper {
+	if k.unjailGuard != nil {
+		panic("cannot set unjail guard twice")
+	}
+
+	k.unjailGuard = ug
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -49,6 +49,13 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 		}
 	}
 
+	// cannot be unjailed while another module keeps the validator jailed
+	if k.unjailGuard != nil {
+		if err := k.unjailGuard.BeforeValidatorUnjailed(ctx, validatorAddr); err != nil {
+			return err
+		}
+	}
+
 	k.sk.Unjail(ctx, consAddr)
 	return nil
 }
@@ -62,3 +62,10 @@ type StakingHooks interface {
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator is bonded
 }
+
+// UnjailGuard defines the expected interface of a module that may keep a
+// validator jailed past its signing info, e.g. the oracle module
+type UnjailGuard interface {
+	// BeforeValidatorUnjailed returns an error if the validator may not be unjailed yet
+	BeforeValidatorUnjailed(ctx sdk.Context, valAddr sdk.ValAddress) error
+}
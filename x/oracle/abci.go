@@ -10,6 +10,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// BeginBlocker is called at the beginning of every block
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// move the fee share into the reward pool before distribution allocates
+	// the collected fees
+	return k.CollectFeeShare(ctx)
+}
+
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
@@ -19,6 +28,13 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 		if err := k.BuildClaimsMapAndTally(ctx, params); err != nil {
 			return err
 		}
+
+		k.PruneHistoricExchangeRates(ctx, params.HistoryRetention)
+		k.PruneValidatorPerformances(ctx, params)
+		k.PruneTallyResults(ctx, params)
+		k.PruneExpiredFeeders(ctx)
+		k.SyncRemotePriceQueries(ctx, params.AcceptList)
+		k.ReleaseEndedRewardSchedules(ctx)
 	}
 
 	// Slash oracle providers who missed voting over the threshold and
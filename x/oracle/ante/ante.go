@@ -0,0 +1,229 @@
+package ante
+
+import (
+	"math"
+	"sync"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/keeper"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// OracleTxPriority is the CheckTx priority of the fee exempt oracle vote
+// transactions, above the priority of any transaction paying fees.
+const OracleTxPriority = int64(math.MaxInt64)
+
+var _ sdk.AnteDecorator = (*OracleVoteDecorator)(nil)
+
+// OracleVoteDecorator exempts the transactions containing only oracle prevotes
+// and votes fed by registered feeders from the minimum gas prices of the node,
+// and gives them the highest CheckTx priority so that validators do not miss
+// votes under congestion. In CheckTx, only the first prevote and the first
+// vote of a validator in a vote period are exempted, the following ones pay
+// the fees like any other transaction. It rejects the votes of a validator
+// already submitted in the current vote period.
+//
+// It must run after the ValidateBasicDecorator and before the
+// DeductFeeDecorator.
+type OracleVoteDecorator struct {
+	oracleKeeper keeper.Keeper
+
+	mu        sync.Mutex
+	exemptTxs map[string]exemptTx // last transaction exempted in CheckTx, by message type and validator
+}
+
+// exemptTx is a transaction exempted from the fees in a vote period.
+type exemptTx struct {
+	period uint64
+	hash   string
+}
+
+// NewOracleVoteDecorator creates a new OracleVoteDecorator.
+func NewOracleVoteDecorator(oracleKeeper keeper.Keeper) *OracleVoteDecorator {
+	return &OracleVoteDecorator{
+		oracleKeeper: oracleKeeper,
+		exemptTxs:    make(map[string]exemptTx),
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (ovd *OracleVoteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	if !hasOracleVoteMsg(msgs) {
+		return next(ctx, tx, simulate)
+	}
+
+	// the duplicates are only checked against the state, so that a vote whose
+	// transaction failed or was evicted from the mempool can be resubmitted
+	if err := ovd.checkDuplicates(ctx, msgs); err != nil {
+		return ctx, err
+	}
+
+	period := uint64(ctx.BlockHeight()) / ovd.oracleKeeper.GetVotePeriod(ctx)
+
+	// the exempted transactions are identified by their hash, so that the same
+	// transaction stays exempted when rechecked or resubmitted after an eviction
+	trackExemption := ctx.IsCheckTx() && !simulate
+	txHash := string(tmhash.Sum(ctx.TxBytes()))
+
+	exempt := ovd.isFeederOnlyTx(ctx, msgs) && (!trackExemption || ovd.isExemptable(msgs, period, txHash))
+	if exempt {
+		ctx = ctx.WithMinGasPrices(sdk.DecCoins{})
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	if exempt {
+		newCtx = newCtx.WithPriority(OracleTxPriority)
+
+		if trackExemption {
+			ovd.setExemptTx(msgs, period, txHash)
+		}
+	}
+
+	return newCtx, nil
+}
+
+// isExemptable returns true if no other transaction than the given one was
+// exempted in the vote period for any of the prevotes and votes.
+func (ovd *OracleVoteDecorator) isExemptable(msgs []sdk.Msg, period uint64, txHash string) bool {
+	ovd.mu.Lock()
+	defer ovd.mu.Unlock()
+
+	for _, msg := range msgs {
+		exempted, found := ovd.exemptTxs[exemptionKey(msg)]
+		if found && exempted.period == period && exempted.hash != txHash {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setExemptTx records the transaction as the one exempted in the vote period
+// for its prevotes and votes.
+func (ovd *OracleVoteDecorator) setExemptTx(msgs []sdk.Msg, period uint64, txHash string) {
+	ovd.mu.Lock()
+	defer ovd.mu.Unlock()
+
+	for _, msg := range msgs {
+		ovd.exemptTxs[exemptionKey(msg)] = exemptTx{period: period, hash: txHash}
+	}
+}
+
+// checkDuplicates returns an error if a validator prevotes or votes twice in
+// the messages, or already voted in the vote period. A prevote of the vote
+// period can be updated.
+func (ovd *OracleVoteDecorator) checkDuplicates(ctx sdk.Context, msgs []sdk.Msg) error {
+	seenPrevote := make(map[string]bool)
+	seenVote := make(map[string]bool)
+
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *types.MsgAggregateExchangeRatePrevote:
+			if seenPrevote[msg.Validator] {
+				return errors.Wrap(types.ErrExistingPrevote, msg.Validator)
+			}
+
+			seenPrevote[msg.Validator] = true
+
+		case *types.MsgAggregateExchangeRateVote:
+			valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+			if err != nil {
+				return err
+			}
+
+			if seenVote[msg.Validator] {
+				return errors.Wrap(types.ErrExistingVote, msg.Validator)
+			}
+
+			// the votes are cleared at the end of every vote period
+			if _, err := ovd.oracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr); err == nil {
+				return errors.Wrap(types.ErrExistingVote, msg.Validator)
+			}
+
+			seenVote[msg.Validator] = true
+		}
+	}
+
+	return nil
+}
+
+// isFeederOnlyTx returns true if the messages are only oracle prevotes and
+// votes, each fed by a feeder the validator registered.
+func (ovd *OracleVoteDecorator) isFeederOnlyTx(ctx sdk.Context, msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		var (
+			validator, feeder string
+			denoms            []string
+		)
+
+		switch msg := msg.(type) {
+		case *types.MsgAggregateExchangeRatePrevote:
+			validator, feeder = msg.Validator, msg.Feeder
+
+		case *types.MsgAggregateExchangeRateVote:
+			validator, feeder = msg.Validator, msg.Feeder
+
+			tuples, err := types.ParseExchangeRateTuples(msg.ExchangeRates)
+			if err != nil {
+				return false
+			}
+
+			for _, tuple := range tuples {
+				denoms = append(denoms, tuple.Denom)
+			}
+
+		default:
+			return false
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return false
+		}
+
+		feederAddr, err := sdk.AccAddressFromBech32(feeder)
+		if err != nil {
+			return false
+		}
+
+		if err := ovd.oracleKeeper.ValidateFeeder(ctx, valAddr, feederAddr, denoms...); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// exemptionKey returns the key of the exemption of an oracle prevote or vote,
+// made of its message type and validator.
+func exemptionKey(msg sdk.Msg) string {
+	switch msg := msg.(type) {
+	case *types.MsgAggregateExchangeRatePrevote:
+		return sdk.MsgTypeURL(msg) + "/" + msg.Validator
+	case *types.MsgAggregateExchangeRateVote:
+		return sdk.MsgTypeURL(msg) + "/" + msg.Validator
+	default:
+		return sdk.MsgTypeURL(msg)
+	}
+}
+
+// hasOracleVoteMsg returns true if any of the messages is an oracle prevote or
+// vote.
+func hasOracleVoteMsg(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *types.MsgAggregateExchangeRatePrevote, *types.MsgAggregateExchangeRateVote:
+			return true
+		}
+	}
+
+	return false
+}
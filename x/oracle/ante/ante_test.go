@@ -0,0 +1,227 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	furyapp "github.com/incubus-network/fanfury-sdk/v2/app"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/ante"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/testutil"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+const (
+	initialHeight = 100
+	salt          = "1f2b1e6c8a4d3b5e7f9a0c2d4e6f8a1b3c5d7e9f0a2b4c6d8e0f1a3b5c7d9e0f"
+	exchangeRates = "ATOM:12.5"
+)
+
+type AnteTestSuite struct {
+	suite.Suite
+
+	app          *furyapp.FuryApp
+	ctx          sdk.Context
+	accAddresses []sdk.AccAddress
+	valAddresses []sdk.ValAddress
+	minGasPrices sdk.DecCoins
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+func (s *AnteTestSuite) SetupTest() {
+	s.app = furyapp.Setup(s.T(), false)
+	s.minGasPrices = sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1))
+	s.ctx = s.app.BaseApp.NewContext(true, tmproto.Header{
+		Height: initialHeight,
+		Time:   tmtime.Now(),
+	}).WithMinGasPrices(s.minGasPrices)
+
+	var err error
+	s.accAddresses, s.valAddresses, err = testutil.StakingAddValidators(
+		s.app.BankKeeper,
+		s.app.StakingKeeper,
+		s.ctx,
+		2,
+	)
+	s.Require().NoError(err)
+}
+
+func (s *AnteTestSuite) newTx(msgs ...sdk.Msg) sdk.Tx {
+	txBuilder := furyapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(msgs...))
+
+	return txBuilder.GetTx()
+}
+
+// updatedPrevote returns a prevote of other exchange rates than prevote.
+func (s *AnteTestSuite) updatedPrevote(i int) *types.MsgAggregateExchangeRatePrevote {
+	hash := types.GetAggregateVoteHash(salt, "ATOM:13.5", s.valAddresses[i])
+	return types.NewMsgAggregateExchangeRatePrevote(hash, s.accAddresses[i], s.valAddresses[i])
+}
+
+func (s *AnteTestSuite) prevote(i int) *types.MsgAggregateExchangeRatePrevote {
+	hash := types.GetAggregateVoteHash(salt, exchangeRates, s.valAddresses[i])
+	return types.NewMsgAggregateExchangeRatePrevote(hash, s.accAddresses[i], s.valAddresses[i])
+}
+
+func (s *AnteTestSuite) vote(i int) *types.MsgAggregateExchangeRateVote {
+	return types.NewMsgAggregateExchangeRateVote(salt, exchangeRates, s.accAddresses[i], s.valAddresses[i])
+}
+
+// anteHandle runs the decorator and returns the context given to the next ante
+// handler along with the context returned by the decorator.
+func (s *AnteTestSuite) anteHandle(
+	decorator *ante.OracleVoteDecorator,
+	ctx sdk.Context,
+	tx sdk.Tx,
+) (nextCtx, newCtx sdk.Context, err error) {
+	txBytes, err := furyapp.MakeTestEncodingConfig().TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	newCtx, err = decorator.AnteHandle(ctx.WithTxBytes(txBytes), tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCtx = ctx
+		return ctx.WithPriority(1), nil
+	})
+
+	return nextCtx, newCtx, err
+}
+
+func (s *AnteTestSuite) TestFeeExemption() {
+	feeder := sdk.AccAddress([]byte("feeder______________"))
+
+	send := banktypes.NewMsgSend(s.accAddresses[0], s.accAddresses[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	notFed := s.prevote(1)
+	notFed.Feeder = feeder.String()
+
+	testCases := []struct {
+		name   string
+		tx     sdk.Tx
+		exempt bool
+	}{
+		{"prevote", s.newTx(s.prevote(0)), true},
+		{"prevotes of several validators", s.newTx(s.prevote(0), s.prevote(1)), true},
+		{"unregistered feeder", s.newTx(notFed), false},
+		{"other message", s.newTx(s.prevote(0), send), false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			decorator := ante.NewOracleVoteDecorator(s.app.OracleKeeper)
+
+			nextCtx, newCtx, err := s.anteHandle(decorator, ctx, tc.tx)
+			s.Require().NoError(err)
+
+			if tc.exempt {
+				s.Require().True(nextCtx.MinGasPrices().IsZero())
+				s.Require().Equal(ante.OracleTxPriority, newCtx.Priority())
+			} else {
+				s.Require().Equal(s.minGasPrices, nextCtx.MinGasPrices())
+				s.Require().Equal(int64(1), newCtx.Priority())
+			}
+		})
+	}
+
+	// registered feeders are exempt as well
+	ctx, _ := s.ctx.CacheContext()
+	s.app.OracleKeeper.SetFeeder(ctx, types.NewFeeder(s.valAddresses[1], feeder, 0, nil))
+
+	nextCtx, newCtx, err := s.anteHandle(ante.NewOracleVoteDecorator(s.app.OracleKeeper), ctx, s.newTx(notFed))
+	s.Require().NoError(err)
+	s.Require().True(nextCtx.MinGasPrices().IsZero())
+	s.Require().Equal(ante.OracleTxPriority, newCtx.Priority())
+}
+
+func (s *AnteTestSuite) TestRejectDuplicatesInState() {
+	app, ctx := s.app, s.ctx.WithIsCheckTx(false)
+	valAddr := s.valAddresses[0]
+	decorator := ante.NewOracleVoteDecorator(app.OracleKeeper)
+	votePeriod := app.OracleKeeper.GetVotePeriod(ctx)
+
+	// a prevote of the previous vote period can be revealed along a new prevote
+	hash := types.GetAggregateVoteHash(salt, exchangeRates, valAddr)
+	app.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr,
+		types.NewAggregateExchangeRatePrevote(hash, valAddr, initialHeight-votePeriod))
+
+	_, _, err := s.anteHandle(decorator, ctx, s.newTx(s.vote(0), s.prevote(0)))
+	s.Require().NoError(err)
+
+	// a prevote of the current vote period can be updated
+	app.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr,
+		types.NewAggregateExchangeRatePrevote(hash, valAddr, initialHeight))
+
+	_, _, err = s.anteHandle(decorator, ctx, s.newTx(s.updatedPrevote(0)))
+	s.Require().NoError(err)
+
+	// a vote already submitted in the vote period is rejected
+	app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(nil, valAddr))
+
+	_, _, err = s.anteHandle(decorator, ctx, s.newTx(s.vote(0)))
+	s.Require().ErrorIs(err, types.ErrExistingVote)
+
+	// so are duplicates within the transaction
+	_, _, err = s.anteHandle(decorator, ctx, s.newTx(s.prevote(1), s.prevote(1)))
+	s.Require().ErrorIs(err, types.ErrExistingPrevote)
+}
+
+func (s *AnteTestSuite) TestResubmission() {
+	ctx := s.ctx
+	decorator := ante.NewOracleVoteDecorator(s.app.OracleKeeper)
+
+	// the transactions accepted in CheckTx but failed or evicted from the
+	// mempool before delivery can be submitted again, and stay exempted
+	for i := 0; i < 2; i++ {
+		_, newCtx, err := s.anteHandle(decorator, ctx, s.newTx(s.prevote(0)))
+		s.Require().NoError(err)
+		s.Require().Equal(ante.OracleTxPriority, newCtx.Priority())
+
+		_, newCtx, err = s.anteHandle(decorator, ctx, s.newTx(s.vote(0)))
+		s.Require().NoError(err)
+		s.Require().Equal(ante.OracleTxPriority, newCtx.Priority())
+	}
+
+	// the accepted transactions stay exempted when rechecked after a block
+	_, newCtx, err := s.anteHandle(decorator, ctx.WithIsReCheckTx(true), s.newTx(s.prevote(0)))
+	s.Require().NoError(err)
+	s.Require().Equal(ante.OracleTxPriority, newCtx.Priority())
+
+	// the prevote can be updated within the vote period, paying the fees
+	nextCtx, newCtx, err := s.anteHandle(decorator, ctx, s.newTx(s.updatedPrevote(0)))
+	s.Require().NoError(err)
+	s.Require().Equal(s.minGasPrices, nextCtx.MinGasPrices())
+	s.Require().Equal(int64(1), newCtx.Priority())
+
+	// the transactions failing in the next ante handlers do not use the
+	// exemption, so that a valid transaction can be submitted instead
+	_, err = decorator.AnteHandle(ctx.WithTxBytes([]byte("failing")), s.newTx(s.prevote(1)), false,
+		func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, sdkerrors.ErrInsufficientFee
+		})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	_, newCtx, err = s.anteHandle(decorator, ctx, s.newTx(s.updatedPrevote(1)))
+	s.Require().NoError(err)
+	s.Require().Equal(ante.OracleTxPriority, newCtx.Priority())
+
+	// the next vote period exempts the transactions again
+	ctx = ctx.WithBlockHeight(initialHeight + int64(s.app.OracleKeeper.GetVotePeriod(ctx)))
+
+	_, newCtx, err = s.anteHandle(decorator, ctx, s.newTx(s.updatedPrevote(0)))
+	s.Require().NoError(err)
+	s.Require().Equal(ante.OracleTxPriority, newCtx.Priority())
+
+	// the exemptions are only limited in CheckTx, where the minimum gas prices
+	// apply
+	_, newCtx, err = s.anteHandle(decorator, ctx.WithIsCheckTx(false), s.newTx(s.prevote(0)))
+	s.Require().NoError(err)
+	s.Require().Equal(ante.OracleTxPriority, newCtx.Priority())
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/feeder"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetOracleCmd returns the off-chain commands of the x/oracle module.
+func GetOracleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Off-chain commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdFeeder(),
+	)
+
+	return cmd
+}
+
+// GetCmdFeeder returns a CLI command handler running the oracle price feeder
+// of a validator.
+func GetCmdFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder",
+		Args:  cobra.NoArgs,
+		Short: "Run a price feeder submitting the oracle votes of a validator every vote period",
+		Long: `Run a price feeder submitting the oracle votes of a validator every vote period.
+
+The votes are signed with the --from key, which must be the feeder the validator
+delegated its feed consent to. The salt of the last prevote is persisted to the
+--salt-file so that it can still be revealed after a restart.`,
+		Example: fmt.Sprintf(
+			"$ %s oracle feeder --from feeder --validator persistencevaloper1... --provider file --price-file prices.json",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddrStr, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+			if err != nil {
+				return err
+			}
+
+			provider, err := priceProviderFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pollInterval, err := cmd.Flags().GetDuration(FlagPollInterval)
+			if err != nil {
+				return err
+			}
+
+			saltFile, err := cmd.Flags().GetString(FlagSaltFile)
+			if err != nil {
+				return err
+			}
+
+			if saltFile == "" {
+				saltFile = filepath.Join(clientCtx.HomeDir, "oracle-feeder", "salt.json")
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "oracle-feeder")
+
+			return feeder.NewFeeder(
+				clientCtx,
+				txf,
+				valAddr,
+				provider,
+				feeder.NewSaltStore(saltFile),
+				pollInterval,
+				logger,
+			).Start(cmd.Context())
+		},
+	}
+
+	cmd.Flags().String(FlagValidator, "", "Operator address of the validator to vote for")
+	cmd.Flags().String(FlagProvider, ProviderFile, "Price provider to use: static, file or http")
+	cmd.Flags().String(FlagPrices, "", "Exchange rates served by the static provider, e.g. ATOM:12.5,XPRT:0.8")
+	cmd.Flags().String(FlagPriceFile, "", "JSON file of exchange rates served by the file provider")
+	cmd.Flags().String(FlagPriceURL, "", "Local HTTP endpoint of exchange rates served by the http provider")
+	cmd.Flags().Duration(FlagPollInterval, 2*time.Second, "Interval at which the latest block height is polled")
+	cmd.Flags().String(FlagSaltFile, "", "File persisting the salt of the pending prevote (default: <home>/oracle-feeder/salt.json)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagValidator)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// priceProviderFromFlags builds the price provider selected by the flags.
+func priceProviderFromFlags(cmd *cobra.Command) (feeder.PriceProvider, error) {
+	providerName, err := cmd.Flags().GetString(FlagProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	switch providerName {
+	case ProviderStatic:
+		prices, err := cmd.Flags().GetString(FlagPrices)
+		if err != nil {
+			return nil, err
+		}
+
+		return feeder.NewStaticProvider(prices)
+
+	case ProviderFile:
+		path, err := cmd.Flags().GetString(FlagPriceFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if path == "" {
+			return nil, fmt.Errorf("--%s is required by the %s provider", FlagPriceFile, ProviderFile)
+		}
+
+		return feeder.NewFileProvider(path), nil
+
+	case ProviderHTTP:
+		url, err := cmd.Flags().GetString(FlagPriceURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if url == "" {
+			return nil, fmt.Errorf("--%s is required by the %s provider", FlagPriceURL, ProviderHTTP)
+		}
+
+		return feeder.NewHTTPProvider(url, 10*time.Second), nil
+
+	default:
+		return nil, fmt.Errorf("unknown price provider %s", providerName)
+	}
+}
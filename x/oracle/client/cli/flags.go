@@ -0,0 +1,28 @@
+package cli
+
+const (
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+	FlagStartPeriod = "start-period"
+	FlagEndPeriod   = "end-period"
+
+	FlagAmountPerPeriod = "amount-per-period"
+
+	FlagExpiryHeight = "expiry-height"
+	FlagDenoms       = "denoms"
+
+	FlagValidator    = "validator"
+	FlagProvider     = "provider"
+	FlagPrices       = "prices"
+	FlagPriceFile    = "price-file"
+	FlagPriceURL     = "price-url"
+	FlagPollInterval = "poll-interval"
+	FlagSaltFile     = "salt-file"
+)
+
+// The price providers supported by the feeder command.
+const (
+	ProviderStatic = "static"
+	ProviderFile   = "file"
+	ProviderHTTP   = "http"
+)
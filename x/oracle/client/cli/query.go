@@ -3,10 +3,12 @@ package cli
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
@@ -28,8 +30,21 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryAllExchangeRates(),
 		GetCmdQueryExchangeRate(),
+		GetCmdQueryExchangeRateMetadata(),
+		GetCmdQueryHistoricExchangeRates(),
+		GetCmdQueryTallyResults(),
+		GetCmdQueryRemotePrice(),
+		GetCmdQueryHaltedDenoms(),
+		GetCmdQueryCrossExchangeRate(),
+		GetCmdQueryValidatorPerformance(),
+		GetCmdQueryValidatorPerformances(),
+		GetCmdQueryOracleJail(),
+		GetCmdQueryOracleJails(),
+		GetCmdQueryTWAP(),
 		GetCmdQueryFeederDelegation(),
+		GetCmdQueryFeeders(),
 		GetCmdQueryRewardPoolBalance(),
+		GetCmdQueryRewardProjection(),
 	)
 
 	return cmd
@@ -212,6 +227,207 @@ func GetCmdQueryExchangeRate() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryExchangeRateMetadata implements the query exchange rate metadata
+// command. Without a denom, the metadata of all denoms is returned.
+func GetCmdQueryExchangeRateMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-metadata [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the freshness of the exchange rates as of their last successful tally",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.AllExchangeRateMetadata(
+					context.Background(),
+					&types.QueryAllExchangeRateMetadataRequest{},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.ExchangeRateMetadata(
+				context.Background(),
+				&types.QueryExchangeRateMetadataRequest{
+					Denom: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHistoricExchangeRates implements the query historic exchange rates
+// command.
+func GetCmdQueryHistoricExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historic-exchange-rates [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tallied exchange rates of a denom within a block height range",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := cmd.Flags().GetUint64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := cmd.Flags().GetUint64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HistoricExchangeRates(
+				context.Background(),
+				&types.QueryHistoricExchangeRatesRequest{
+					Denom:       args[0],
+					StartHeight: startHeight,
+					EndHeight:   endHeight,
+					Pagination:  pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartHeight, 0, "First block height to include")
+	cmd.Flags().Uint64(FlagEndHeight, 0, "Last block height to include, zero for the latest")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "historic-exchange-rates")
+
+	return cmd
+}
+
+// GetCmdQueryTallyResults implements the query tally results command.
+func GetCmdQueryTallyResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-results [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the statistics of the tallies of a denom within a vote period range",
+		Long:  "Without a denom, the latest tally of every denom of the accept list is returned.",
+		Example: fmt.Sprintf(`$ %[1]s query oracle tally-results ATOM --start-period 1000
+$ %[1]s query oracle tally-results`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.LatestTallyResults(context.Background(), &types.QueryLatestTallyResultsRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			startPeriod, err := cmd.Flags().GetUint64(FlagStartPeriod)
+			if err != nil {
+				return err
+			}
+
+			endPeriod, err := cmd.Flags().GetUint64(FlagEndPeriod)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TallyResults(
+				context.Background(),
+				&types.QueryTallyResultsRequest{
+					Denom:       args[0],
+					StartPeriod: startPeriod,
+					EndPeriod:   endPeriod,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartPeriod, 0, "First vote period to include")
+	cmd.Flags().Uint64(FlagEndPeriod, 0, "Last vote period to include, zero for the latest")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTWAP implements the query time-weighted average exchange rate
+// command.
+func GetCmdQueryTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "twap [denom] [window]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Query the time-weighted average exchange rate of a denom over a window",
+		Example: fmt.Sprintf("$ %s query oracle twap ATOM 1h", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			if window < time.Second {
+				return fmt.Errorf("window must be at least one second")
+			}
+
+			res, err := queryClient.TWAP(
+				context.Background(),
+				&types.QueryTWAPRequest{
+					Denom:         args[0],
+					WindowSeconds: uint64(window / time.Second),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryFeederDelegation implements the query feeder delegation command.
 func GetCmdQueryFeederDelegation() *cobra.Command {
 	cmd := &cobra.Command{
@@ -246,6 +462,69 @@ func GetCmdQueryFeederDelegation() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryFeeders implements the query feeders command.
+func GetCmdQueryFeeders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeders [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the feeder delegation and the registered feeders of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Feeders(context.Background(), &types.QueryFeedersRequest{
+				ValidatorAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRemotePrice implements the query remote price command.
+func GetCmdQueryRemotePrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-price [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the price of a denom last read from its remote DEX pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RemotePrice(context.Background(), &types.QueryRemotePriceRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdQueryRewardPoolBalance() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reward-pool-balance",
@@ -270,3 +549,214 @@ func GetCmdQueryRewardPoolBalance() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryHaltedDenoms implements the query halted denoms command.
+func GetCmdQueryHaltedDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halted-denoms",
+		Args:  cobra.NoArgs,
+		Short: "Query the denoms halted by the circuit breaker",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HaltedDenoms(context.Background(), &types.QueryHaltedDenomsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCrossExchangeRate implements the query cross exchange rate
+// command.
+func GetCmdQueryCrossExchangeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cross-exchange-rate [base] [quote]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Query the amount of quote asset per unit of base asset, derived from the USD rates",
+		Long:    "Symbol denoms are priced per display unit and base denoms per base unit. USD is a valid quote.",
+		Example: fmt.Sprintf("$ %s query oracle cross-exchange-rate ATOM XPRT", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CrossExchangeRate(context.Background(), &types.QueryCrossExchangeRateRequest{
+				Base:  args[0],
+				Quote: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorPerformance implements the query validator performance
+// command.
+func GetCmdQueryValidatorPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performance [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote statistics of a validator over the kept slash windows",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorPerformance(context.Background(), &types.QueryValidatorPerformanceRequest{
+				ValidatorAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorPerformances implements the query validator performances
+// command.
+func GetCmdQueryValidatorPerformances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performances",
+		Args:  cobra.NoArgs,
+		Short: "Query the vote statistics of all validators, ranked by uptime",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorPerformances(context.Background(), &types.QueryValidatorPerformancesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOracleJail implements the query oracle jail command.
+func GetCmdQueryOracleJail() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-jail [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query why and until when a validator is jailed for missing oracle votes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OracleJail(context.Background(), &types.QueryOracleJailRequest{
+				ValidatorAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOracleJails implements the query oracle jails command.
+func GetCmdQueryOracleJails() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-jails",
+		Args:  cobra.NoArgs,
+		Short: "Query the validators jailed for missing oracle votes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OracleJails(context.Background(), &types.QueryOracleJailsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewardProjection implements the query reward projection command.
+func GetCmdQueryRewardProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-projection",
+		Args:  cobra.NoArgs,
+		Short: "Query the projected reward payout per vote period and the runway of the reward schedules",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardProjection(context.Background(), &types.QueryRewardProjectionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
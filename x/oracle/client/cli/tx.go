@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
@@ -24,6 +26,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdDelegateFeedConsent(),
+		GetCmdAddFeeder(),
+		GetCmdRevokeFeeder(),
 		AddFundsToRewardPool(),
 	)
 
@@ -63,11 +67,104 @@ func GetCmdDelegateFeedConsent() *cobra.Command {
 	return cmd
 }
 
+// GetCmdAddFeeder returns a CLI command handler to generate or broadcast a
+// transaction with a MsgAddFeeder message.
+func GetCmdAddFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-feeder [operator] [feeder]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Register an additional feeder address allowed to vote on behalf of an operator",
+		Long: strings.TrimSpace(`Register an additional feeder address allowed to vote on behalf of an operator,
+besides its feeder delegation. With --expiry-height the feeder is no longer active
+from that block height on, and with --denoms it can only vote on those denoms.
+Registering a feeder again updates its expiry height and denoms.`),
+		Example: fmt.Sprintf(
+			"$ %s tx oracle add-feeder mykey persistence1... --expiry-height 500000 --denoms ATOM,XPRT",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feederAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := cmd.Flags().GetUint64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			denoms, err := cmd.Flags().GetStringSlice(FlagDenoms)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddFeeder(sdk.ValAddress(clientCtx.GetFromAddress()), feederAddr, expiryHeight, denoms)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagExpiryHeight, 0, "Block height from which on the feeder is no longer active, zero to never expire")
+	cmd.Flags().StringSlice(FlagDenoms, nil, "Comma separated symbol denoms the feeder is restricted to, empty for all denoms")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevokeFeeder returns a CLI command handler to generate or broadcast a
+// transaction with a MsgRevokeFeeder message.
+func GetCmdRevokeFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-feeder [operator] [feeder]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Revoke a registered feeder address of an operator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feederAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeder(sdk.ValAddress(clientCtx.GetFromAddress()), feederAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func AddFundsToRewardPool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-reward-pool [operator] [amount]",
 		Args:  cobra.ExactArgs(2),
 		Short: "Send the funds to the oracle reward pool from operator account",
+		Long: strings.TrimSpace(`Send the funds to the oracle reward pool from operator account.
+With --amount-per-period the funds are reserved for a reward schedule paying out
+the amount every vote period from --start-height (default now) to --end-height.`),
+		Example: fmt.Sprintf(
+			"$ %s tx oracle fund-reward-pool mykey 1000000uxprt --amount-per-period 1000uxprt --end-height 500000",
+			version.AppName,
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
 				return err
@@ -85,10 +182,38 @@ func AddFundsToRewardPool() *cobra.Command {
 
 			msg := types.NewMsgAddFundsToRewardPool(clientCtx.GetFromAddress(), funds)
 
+			amountPerPeriodStr, err := cmd.Flags().GetString(FlagAmountPerPeriod)
+			if err != nil {
+				return err
+			}
+
+			if amountPerPeriodStr != "" {
+				amountPerPeriod, err := sdk.ParseCoinsNormalized(amountPerPeriodStr)
+				if err != nil {
+					return err
+				}
+
+				startHeight, err := cmd.Flags().GetUint64(FlagStartHeight)
+				if err != nil {
+					return err
+				}
+
+				endHeight, err := cmd.Flags().GetUint64(FlagEndHeight)
+				if err != nil {
+					return err
+				}
+
+				plan := types.NewRewardSchedulePlan(startHeight, endHeight, amountPerPeriod)
+				msg = types.NewMsgAddFundsToRewardSchedule(clientCtx.GetFromAddress(), funds, plan)
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagAmountPerPeriod, "", "Reserve the funds for a reward schedule paying out the amount every vote period")
+	cmd.Flags().Uint64(FlagStartHeight, 0, "First block height the reward schedule pays out at, zero to start now")
+	cmd.Flags().Uint64(FlagEndHeight, 0, "Last block height the reward schedule pays out at")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,341 @@
+// Package feeder implements a long-running oracle price feeder, which submits
+// the aggregate exchange rate prevotes and votes of a validator every vote
+// period using its delegated feeder key.
+package feeder
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// Feeder submits the oracle votes of a validator. In every vote period it
+// reveals the prevote of the previous period, if any, and commits to the
+// current prices of its PriceProvider with a new prevote.
+type Feeder struct {
+	clientCtx    client.Context
+	txf          tx.Factory
+	queryClient  types.QueryClient
+	validator    sdk.ValAddress
+	provider     PriceProvider
+	salts        *SaltStore
+	pollInterval time.Duration
+	logger       log.Logger
+
+	// scope is the registered feeder the key signs as, if any, restricting
+	// the votes to its denoms.
+	scope types.Feeder
+
+	lastPeriod uint64
+}
+
+// NewFeeder returns a Feeder voting for the given validator. The transactions
+// are signed with the key of the client context's from address, which must be
+// the feeder the validator delegated its consent to or one of its registered
+// feeders.
+func NewFeeder(
+	clientCtx client.Context,
+	txf tx.Factory,
+	validator sdk.ValAddress,
+	provider PriceProvider,
+	salts *SaltStore,
+	pollInterval time.Duration,
+	logger log.Logger,
+) *Feeder {
+	return &Feeder{
+		clientCtx:    clientCtx,
+		txf:          txf,
+		queryClient:  types.NewQueryClient(clientCtx),
+		validator:    validator,
+		provider:     provider,
+		salts:        salts,
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+// Start runs the feeder until the context is cancelled. Failures within a vote
+// period are logged and retried in the next one.
+func (f *Feeder) Start(ctx context.Context) error {
+	if err := f.checkFeederDelegation(ctx); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.tick(ctx); err != nil {
+			f.logger.Error("failed to submit oracle vote", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkFeederDelegation ensures the signing key is allowed to feed prices on
+// behalf of the validator, either as its feeder delegation or as one of its
+// registered feeders.
+func (f *Feeder) checkFeederDelegation(ctx context.Context) error {
+	res, err := f.queryClient.Feeders(ctx, &types.QueryFeedersRequest{
+		ValidatorAddr: f.validator.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	from := f.clientCtx.GetFromAddress().String()
+	if res.FeederAddr == from {
+		return nil
+	}
+
+	for _, feeder := range res.Feeders {
+		if feeder.Address == from {
+			f.scope = feeder
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"%s is not a feeder of validator %s, expected %s",
+		from, f.validator, res.FeederAddr,
+	)
+}
+
+// tick submits the votes of the current vote period, unless they were already
+// submitted.
+func (f *Feeder) tick(ctx context.Context) error {
+	status, err := f.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	paramsRes, err := f.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	params := paramsRes.Params
+
+	// the transaction is included in the next block at the earliest
+	period := uint64(status.SyncInfo.LatestBlockHeight+1) / params.VotePeriod
+	if period == f.lastPeriod {
+		return nil
+	}
+
+	var onChain *types.AggregateExchangeRatePrevote
+
+	prevoteRes, err := f.queryClient.AggregatePrevote(ctx, &types.QueryAggregatePrevoteRequest{
+		ValidatorAddr: f.validator.String(),
+	})
+	if err == nil {
+		onChain = &prevoteRes.AggregatePrevote
+	}
+
+	pending, err := f.salts.Load()
+	if err != nil {
+		return err
+	}
+
+	reveal, prevote := planVotes(period, params.VotePeriod, f.validator, onChain, pending)
+
+	var msgs []sdk.Msg
+
+	if reveal {
+		msgs = append(msgs, types.NewMsgAggregateExchangeRateVote(
+			pending.Salt, pending.ExchangeRates, f.clientCtx.GetFromAddress(), f.validator,
+		))
+	}
+
+	var prevoteErr error
+
+	if prevote {
+		msg, err := f.prevote(ctx, period, params.AcceptList)
+		if err != nil {
+			prevoteErr = fmt.Errorf("failed to prepare oracle prevote: %w", err)
+			prevote = false
+		} else {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	if len(msgs) > 0 {
+		if err := f.broadcast(msgs...); err != nil {
+			return err
+		}
+
+		f.logger.Info("submitted oracle votes", "period", period, "revealed", reveal, "prevoted", prevote)
+	}
+
+	// the period is retried on the next tick until its votes are submitted
+	if prevoteErr != nil {
+		return prevoteErr
+	}
+
+	f.lastPeriod = period
+
+	return nil
+}
+
+// prevote fetches the current prices and returns a prevote committing to
+// them. The salt is persisted before the prevote is broadcast.
+func (f *Feeder) prevote(ctx context.Context, period uint64, acceptList types.DenomList) (sdk.Msg, error) {
+	denoms := make([]string, 0, len(acceptList))
+	for _, denom := range acceptList {
+		if f.scope.CoversDenom(denom.SymbolDenom) {
+			denoms = append(denoms, denom.SymbolDenom)
+		}
+	}
+
+	tuples, err := f.provider.Prices(ctx, denoms)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tuples) == 0 {
+		return nil, fmt.Errorf("no denoms to vote on in the accept list")
+	}
+
+	var abstained []string
+
+	for _, tuple := range tuples {
+		if !tuple.ExchangeRate.IsPositive() {
+			abstained = append(abstained, tuple.Denom)
+		}
+	}
+
+	if len(abstained) > 0 {
+		f.logger.Error("missing prices, abstaining from denoms", "period", period, "denoms", abstained)
+	}
+
+	salt, err := generateSalt()
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRates := formatExchangeRates(tuples)
+
+	if err := f.salts.Save(PendingVote{
+		Period:        period,
+		Salt:          salt,
+		ExchangeRates: exchangeRates,
+		Validator:     f.validator.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	hash := types.GetAggregateVoteHash(salt, exchangeRates, f.validator)
+
+	return types.NewMsgAggregateExchangeRatePrevote(hash, f.clientCtx.GetFromAddress(), f.validator), nil
+}
+
+// broadcast signs the messages with the feeder key and broadcasts them in a
+// single transaction.
+func (f *Feeder) broadcast(msgs ...sdk.Msg) error {
+	// refresh the account sequence on every transaction
+	txf, err := f.txf.WithAccountNumber(0).WithSequence(0).Prepare(f.clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(f.clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	txb, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Sign(txf, f.clientCtx.GetFromName(), txb, true); err != nil {
+		return err
+	}
+
+	txBytes, err := f.clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := f.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	if res.Code != 0 {
+		return fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	return nil
+}
+
+// planVotes decides whether the prevote of the previous period is revealed and
+// whether a new prevote is submitted in the given period, based on the prevote
+// stored on chain and the pending vote persisted by the feeder.
+func planVotes(
+	period, votePeriod uint64,
+	validator sdk.ValAddress,
+	onChain *types.AggregateExchangeRatePrevote,
+	pending *PendingVote,
+) (reveal, prevote bool) {
+	if onChain == nil {
+		return false, true
+	}
+
+	// a prevote blocks any other one until it is revealed or dropped by the
+	// tally, so only a prevote of the previous period that we know the salt of
+	// lets us vote in this period
+	if onChain.SubmitBlock/votePeriod+1 != period || pending == nil {
+		return false, false
+	}
+
+	if pending.Validator != validator.String() || pending.Period+1 != period {
+		return false, false
+	}
+
+	hash := types.GetAggregateVoteHash(pending.Salt, pending.ExchangeRates, validator)
+	if hash.String() != onChain.Hash {
+		return false, false
+	}
+
+	return true, true
+}
+
+// generateSalt returns a random hex encoded salt of the length required by
+// MsgAggregateExchangeRateVote.
+func generateSalt() (string, error) {
+	bz := make([]byte, 32)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bz), nil
+}
+
+// formatExchangeRates formats the tuples as the exchange rates of a vote.
+func formatExchangeRates(tuples types.ExchangeRateTuples) string {
+	exchangeRates := make([]string, len(tuples))
+	for i, tuple := range tuples {
+		exchangeRates[i] = fmt.Sprintf("%s:%s", tuple.Denom, tuple.ExchangeRate)
+	}
+
+	return strings.Join(exchangeRates, ",")
+}
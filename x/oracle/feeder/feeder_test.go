@@ -0,0 +1,176 @@
+package feeder
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func TestSaltStore(t *testing.T) {
+	store := NewSaltStore(filepath.Join(t.TempDir(), "feeder", "salt.json"))
+
+	pending, err := store.Load()
+	require.NoError(t, err)
+	require.Nil(t, pending)
+
+	vote := PendingVote{
+		Period:        10,
+		Salt:          "salt",
+		ExchangeRates: "ATOM:12.5",
+		Validator:     "validator",
+	}
+	require.NoError(t, store.Save(vote))
+
+	pending, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, vote, *pending)
+}
+
+func TestFormatExchangeRates(t *testing.T) {
+	exchangeRates := formatExchangeRates(expectedRates)
+
+	tuples, err := types.ParseExchangeRateTuples(exchangeRates)
+	require.NoError(t, err)
+	require.Equal(t, expectedRates, tuples)
+}
+
+func TestPlanVotes(t *testing.T) {
+	const votePeriod = 5
+
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+	otherValAddr := sdk.ValAddress([]byte("other_validator_____"))
+
+	salt, err := generateSalt()
+	require.NoError(t, err)
+	require.Len(t, salt, 64)
+
+	pending := &PendingVote{
+		Period:        4,
+		Salt:          salt,
+		ExchangeRates: "ATOM:12.5",
+		Validator:     valAddr.String(),
+	}
+
+	prevote := types.NewAggregateExchangeRatePrevote(
+		types.GetAggregateVoteHash(salt, pending.ExchangeRates, valAddr), valAddr, 22,
+	)
+
+	otherPending := *pending
+	otherPending.Validator = otherValAddr.String()
+
+	testCases := []struct {
+		name            string
+		period          uint64
+		onChain         *types.AggregateExchangeRatePrevote
+		pending         *PendingVote
+		expectedReveal  bool
+		expectedPrevote bool
+	}{
+		{"no prevote on chain", 5, nil, pending, false, true},
+		{"reveal previous prevote", 5, &prevote, pending, true, true},
+		{"prevote already submitted in period", 4, &prevote, pending, false, false},
+		{"unknown salt", 5, &prevote, nil, false, false},
+		{"salt of another validator", 5, &prevote, &otherPending, false, false},
+		{"stale prevote", 6, &prevote, pending, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reveal, prevote := planVotes(tc.period, votePeriod, valAddr, tc.onChain, tc.pending)
+			require.Equal(t, tc.expectedReveal, reveal)
+			require.Equal(t, tc.expectedPrevote, prevote)
+		})
+	}
+}
+
+// statusClient serves the status of a node at a fixed height.
+type statusClient struct {
+	rpcclient.Client
+	height int64
+}
+
+func (c statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: c.height}}, nil
+}
+
+// queryClient serves the oracle params, without any prevote on chain.
+type queryClient struct {
+	types.QueryClient
+	params types.Params
+}
+
+func (c queryClient) Params(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: c.params}, nil
+}
+
+func (c queryClient) AggregatePrevote(
+	context.Context, *types.QueryAggregatePrevoteRequest, ...grpc.CallOption,
+) (*types.QueryAggregatePrevoteResponse, error) {
+	return nil, types.ErrNoAggregatePrevote
+}
+
+// failingAccountRetriever fails the preparation of every transaction.
+type failingAccountRetriever struct {
+	client.AccountRetriever
+}
+
+func (failingAccountRetriever) EnsureExists(client.Context, sdk.AccAddress) error {
+	return errors.New("account not found")
+}
+
+func TestTickRetriesFailedBroadcast(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+	feederAddr := sdk.AccAddress([]byte("feeder______________"))
+
+	params := types.DefaultParams()
+	params.VotePeriod = 5
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6},
+		{BaseDenom: types.PersistenceDenom, SymbolDenom: types.PersistenceSymbol, Exponent: 6},
+	}
+
+	provider, err := NewStaticProvider("ATOM:12.5")
+	require.NoError(t, err)
+
+	salts := NewSaltStore(filepath.Join(t.TempDir(), "salt.json"))
+	f := NewFeeder(
+		client.Context{}.WithClient(statusClient{height: 24}).WithFromAddress(feederAddr),
+		tx.Factory{}.WithAccountRetriever(failingAccountRetriever{}),
+		valAddr,
+		provider,
+		salts,
+		0,
+		log.NewNopLogger(),
+	)
+	f.queryClient = queryClient{params: params}
+
+	// the prevote abstains from the denom the provider has no price of
+	require.ErrorContains(t, f.tick(context.Background()), "account not found")
+
+	pending, err := salts.Load()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), pending.Period)
+
+	tuples, err := types.ParseExchangeRateTuples(pending.ExchangeRates)
+	require.NoError(t, err)
+	require.Len(t, tuples, 2)
+	require.True(t, tuples[1].ExchangeRate.IsZero(), pending.ExchangeRates)
+	require.True(t, strings.HasPrefix(pending.ExchangeRates, "ATOM:12.5"))
+
+	// the period is not recorded as handled, so the next tick retries it
+	require.Zero(t, f.lastPeriod)
+	require.ErrorContains(t, f.tick(context.Background()), "account not found")
+}
@@ -0,0 +1,182 @@
+package feeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// PriceProvider is the source of the exchange rates a feeder votes with.
+// Implementations return a rate for each of the requested symbol denoms; the
+// rates of unknown denoms are zero, abstaining from them, rather than failing
+// the call.
+type PriceProvider interface {
+	// Prices returns the exchange rates of the given symbol denoms.
+	Prices(ctx context.Context, denoms []string) (types.ExchangeRateTuples, error)
+}
+
+var (
+	_ PriceProvider = StaticProvider{}
+	_ PriceProvider = FileProvider{}
+	_ PriceProvider = HTTPProvider{}
+)
+
+// StaticProvider serves a fixed set of exchange rates.
+type StaticProvider struct {
+	rates map[string]sdk.Dec
+}
+
+// NewStaticProvider returns a StaticProvider serving the given rates, formatted
+// like the exchange rates of a vote, e.g. "ATOM:12.5,XPRT:0.8".
+func NewStaticProvider(ratesStr string) (StaticProvider, error) {
+	tuples, err := types.ParseExchangeRateTuples(ratesStr)
+	if err != nil {
+		return StaticProvider{}, err
+	}
+
+	rates := make(map[string]sdk.Dec, len(tuples))
+	for _, tuple := range tuples {
+		if !tuple.ExchangeRate.IsPositive() {
+			return StaticProvider{}, fmt.Errorf("rate of %s must be positive: %s", tuple.Denom, tuple.ExchangeRate)
+		}
+
+		rates[tuple.Denom] = tuple.ExchangeRate
+	}
+
+	return StaticProvider{rates: rates}, nil
+}
+
+// Prices implements PriceProvider.
+func (p StaticProvider) Prices(_ context.Context, denoms []string) (types.ExchangeRateTuples, error) {
+	return filterRates(p.rates, denoms), nil
+}
+
+// FileProvider serves the exchange rates of a JSON file mapping symbol denoms
+// to decimal strings, e.g. {"ATOM": "12.5"}. The file is read on every call so
+// that it can be updated while the feeder is running.
+type FileProvider struct {
+	path string
+}
+
+// NewFileProvider returns a FileProvider reading the file at the given path.
+func NewFileProvider(path string) FileProvider {
+	return FileProvider{path: path}
+}
+
+// Prices implements PriceProvider.
+func (p FileProvider) Prices(_ context.Context, denoms []string) (types.ExchangeRateTuples, error) {
+	bz, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := parseRates(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price file %s: %w", p.path, err)
+	}
+
+	return filterRates(rates, denoms), nil
+}
+
+// HTTPProvider serves the exchange rates returned by a local HTTP endpoint in
+// the same JSON format as the FileProvider.
+type HTTPProvider struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPProvider returns a HTTPProvider querying the given URL.
+func NewHTTPProvider(url string, timeout time.Duration) HTTPProvider {
+	return HTTPProvider{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Prices implements PriceProvider.
+func (p HTTPProvider) Prices(ctx context.Context, denoms []string) (types.ExchangeRateTuples, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price endpoint %s returned status %d", p.url, resp.StatusCode)
+	}
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := parseRates(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response from price endpoint %s: %w", p.url, err)
+	}
+
+	return filterRates(rates, denoms), nil
+}
+
+// parseRates decodes a JSON object mapping symbol denoms to decimal strings.
+func parseRates(bz []byte) (map[string]sdk.Dec, error) {
+	var raw map[string]string
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]sdk.Dec, len(raw))
+
+	for denom, rateStr := range raw {
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate of %s: %w", denom, err)
+		}
+
+		if !rate.IsPositive() {
+			return nil, fmt.Errorf("rate of %s must be positive: %s", denom, rate)
+		}
+
+		rates[strings.ToUpper(denom)] = rate
+	}
+
+	return rates, nil
+}
+
+// filterRates returns the rates of the requested denoms, sorted by denom. The
+// rates of the denoms missing from the given rates are zero.
+func filterRates(rates map[string]sdk.Dec, denoms []string) types.ExchangeRateTuples {
+	tuples := types.ExchangeRateTuples{}
+
+	for _, denom := range denoms {
+		denom = strings.ToUpper(denom)
+
+		rate, ok := rates[denom]
+		if !ok {
+			rate = sdk.ZeroDec()
+		}
+
+		tuples = append(tuples, types.NewExchangeRateTuple(denom, rate))
+	}
+
+	sort.Slice(tuples, func(i, j int) bool {
+		return tuples[i].Denom < tuples[j].Denom
+	})
+
+	return tuples
+}
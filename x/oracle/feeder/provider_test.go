@@ -0,0 +1,83 @@
+package feeder
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+var expectedRates = types.ExchangeRateTuples{
+	types.NewExchangeRateTuple("ATOM", sdk.MustNewDecFromStr("12.5")),
+	types.NewExchangeRateTuple("XPRT", sdk.MustNewDecFromStr("0.8")),
+}
+
+func TestStaticProvider(t *testing.T) {
+	_, err := NewStaticProvider("ATOM:-1")
+	require.Error(t, err)
+
+	provider, err := NewStaticProvider("XPRT:0.8,ATOM:12.5,OSMO:1")
+	require.NoError(t, err)
+
+	rates, err := provider.Prices(context.Background(), []string{"xprt", "ATOM"})
+	require.NoError(t, err)
+	require.Equal(t, expectedRates, rates)
+
+	// the rates of the unknown denoms are zero, abstaining from them
+	rates, err = provider.Prices(context.Background(), []string{"xprt", "ATOM", "STARS"})
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateTuples{
+		expectedRates[0],
+		types.NewExchangeRateTuple("STARS", sdk.ZeroDec()),
+		expectedRates[1],
+	}, rates)
+
+	_, err = NewStaticProvider("ATOM:0")
+	require.ErrorContains(t, err, "must be positive")
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	provider := NewFileProvider(path)
+
+	_, err := provider.Prices(context.Background(), []string{"ATOM"})
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"atom":"12.5","XPRT":"0.8"}`), 0o600))
+
+	rates, err := provider.Prices(context.Background(), []string{"ATOM", "XPRT"})
+	require.NoError(t, err)
+	require.Equal(t, expectedRates, rates)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"ATOM":"0"}`), 0o600))
+
+	_, err = provider.Prices(context.Background(), []string{"ATOM"})
+	require.ErrorContains(t, err, "must be positive")
+}
+
+func TestHTTPProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prices" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"ATOM":"12.5","XPRT":"0.8"}`))
+	}))
+	defer server.Close()
+
+	rates, err := NewHTTPProvider(server.URL+"/prices", time.Second).Prices(context.Background(), []string{"ATOM", "XPRT"})
+	require.NoError(t, err)
+	require.Equal(t, expectedRates, rates)
+
+	_, err = NewHTTPProvider(server.URL, time.Second).Prices(context.Background(), []string{"ATOM"})
+	require.ErrorContains(t, err, "status 404")
+}
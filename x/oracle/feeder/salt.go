@@ -0,0 +1,71 @@
+package feeder
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// PendingVote is a prevote submitted by the feeder, kept until it is revealed
+// in the following vote period.
+type PendingVote struct {
+	// Period is the vote period in which the prevote was submitted.
+	Period uint64 `json:"period"`
+	// Salt is the hex encoded salt of the prevote hash.
+	Salt string `json:"salt"`
+	// ExchangeRates are the exchange rates committed to by the prevote.
+	ExchangeRates string `json:"exchange_rates"`
+	// Validator is the operator address the prevote was submitted for.
+	Validator string `json:"validator"`
+}
+
+// SaltStore persists the pending vote of the feeder to a file, so that a
+// prevote can still be revealed after the feeder restarts.
+type SaltStore struct {
+	path string
+}
+
+// NewSaltStore returns a SaltStore backed by the file at the given path.
+func NewSaltStore(path string) *SaltStore {
+	return &SaltStore{path: path}
+}
+
+// Load returns the persisted pending vote, or nil if there is none.
+func (s *SaltStore) Load() (*PendingVote, error) {
+	bz, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var pending PendingVote
+	if err := json.Unmarshal(bz, &pending); err != nil {
+		return nil, err
+	}
+
+	return &pending, nil
+}
+
+// Save persists the pending vote, replacing the previous one. The file is
+// written atomically so that a crash never leaves a partial salt behind.
+func (s *SaltStore) Save(pending PendingVote) error {
+	bz, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
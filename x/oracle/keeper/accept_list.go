@@ -0,0 +1,364 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// AddAcceptListDenom adds a denom to the accept list. Validators are expected to vote on
+// it from the next vote period on: it is tallied in the current vote period, but left
+// out of the miss counting.
+func (k Keeper) AddAcceptListDenom(ctx sdk.Context, denom types.Denom) error {
+	if err := denom.Validate(); err != nil {
+		return err
+	}
+
+	acceptList := k.GetAcceptList(ctx)
+
+	for _, d := range acceptList {
+		if strings.EqualFold(d.SymbolDenom, denom.SymbolDenom) || d.BaseDenom == denom.BaseDenom {
+			return errors.Wrap(types.ErrExistingDenom, denom.SymbolDenom)
+		}
+	}
+
+	acceptList = append(acceptList, denom)
+	if err := acceptList.ValidateQuoteDenoms(); err != nil {
+		return errors.Wrap(types.ErrInvalidQuoteDenom, err.Error())
+	}
+
+	k.SetAcceptList(ctx, acceptList)
+	k.SetAddedDenom(ctx, types.NewAddedDenom(denom.SymbolDenom, uint64(ctx.BlockHeight())/k.GetVotePeriod(ctx)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeAddDenom,
+			sdk.NewAttribute(types.EventAttrKeyDenom, denom.SymbolDenom),
+			sdk.NewAttribute(types.EventAttrKeyBaseDenom, denom.BaseDenom),
+			sdk.NewAttribute(types.EventAttrKeyExponent, strconv.FormatUint(uint64(denom.Exponent), 10)),
+		),
+	)
+
+	return nil
+}
+
+// UpdateAcceptListDenom replaces the denom of the accept list with the same SymbolDenom.
+func (k Keeper) UpdateAcceptListDenom(ctx sdk.Context, denom types.Denom) error {
+	if err := denom.Validate(); err != nil {
+		return err
+	}
+
+	acceptList := k.GetAcceptList(ctx)
+	index := -1
+
+	for i, d := range acceptList {
+		if strings.EqualFold(d.SymbolDenom, denom.SymbolDenom) {
+			index = i
+			continue
+		}
+
+		if d.BaseDenom == denom.BaseDenom {
+			return errors.Wrap(types.ErrExistingDenom, denom.BaseDenom)
+		}
+	}
+
+	if index < 0 {
+		return errors.Wrap(types.ErrUnknownDenom, denom.SymbolDenom)
+	}
+
+	// keep the stored casing of the symbol, which keys the votes and rates
+	denom.SymbolDenom = acceptList[index].SymbolDenom
+	acceptList[index] = denom
+	if err := acceptList.ValidateQuoteDenoms(); err != nil {
+		return errors.Wrap(types.ErrInvalidQuoteDenom, err.Error())
+	}
+
+	k.SetAcceptList(ctx, acceptList)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUpdateDenom,
+			sdk.NewAttribute(types.EventAttrKeyDenom, denom.SymbolDenom),
+			sdk.NewAttribute(types.EventAttrKeyBaseDenom, denom.BaseDenom),
+			sdk.NewAttribute(types.EventAttrKeyExponent, strconv.FormatUint(uint64(denom.Exponent), 10)),
+		),
+	)
+
+	return nil
+}
+
+// RemoveAcceptListDenom removes a denom from the accept list, together with its rates, its
+// tally results and the votes cast on it in the current vote period. Prevotes can not be
+// inspected, but their rates are filtered against the accept list on reveal. The vote
+// periods of the current slash window missed on the denom only are taken back from the
+// miss counters and the vote statistics of the validators.
+func (k Keeper) RemoveAcceptListDenom(ctx sdk.Context, symbolDenom string) error {
+	acceptList := k.GetAcceptList(ctx)
+
+	denom, found := acceptList.Find(symbolDenom)
+	if !found {
+		return errors.Wrap(types.ErrUnknownDenom, symbolDenom)
+	}
+
+	newAcceptList := make(types.DenomList, 0, len(acceptList)-1)
+	for _, d := range acceptList {
+		if !strings.EqualFold(d.SymbolDenom, symbolDenom) {
+			newAcceptList = append(newAcceptList, d)
+		}
+	}
+
+	// the denom can not be removed while other denoms are quoted in it
+	if err := newAcceptList.ValidateQuoteDenoms(); err != nil {
+		return errors.Wrap(types.ErrInvalidQuoteDenom, err.Error())
+	}
+
+	k.SetAcceptList(ctx, newAcceptList)
+	k.removeDenomFromVotes(ctx, symbolDenom)
+	k.DeleteExchangeRate(ctx, symbolDenom)
+	k.DeleteExchangeRateMetadata(ctx, symbolDenom)
+	k.DeleteHaltedDenom(ctx, symbolDenom)
+	k.DeleteHistoricExchangeRates(ctx, symbolDenom)
+	k.DeleteTallyResults(ctx, symbolDenom)
+	k.DeleteAddedDenom(ctx, symbolDenom)
+	k.refundDenomMisses(ctx, symbolDenom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRemoveDenom,
+			sdk.NewAttribute(types.EventAttrKeyDenom, denom.SymbolDenom),
+			sdk.NewAttribute(types.EventAttrKeyBaseDenom, denom.BaseDenom),
+		),
+	)
+
+	return nil
+}
+
+// removeDenomFromVotes strips the rates of a denom from the aggregate votes of
+// the current vote period, deleting the votes left empty.
+func (k Keeper) removeDenomFromVotes(ctx sdk.Context, symbolDenom string) {
+	var (
+		voters []sdk.ValAddress
+		votes  []types.AggregateExchangeRateVote
+	)
+
+	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, vote types.AggregateExchangeRateVote) bool {
+		tuples := make(types.ExchangeRateTuples, 0, len(vote.ExchangeRateTuples))
+
+		for _, tuple := range vote.ExchangeRateTuples {
+			if !strings.EqualFold(tuple.Denom, symbolDenom) {
+				tuples = append(tuples, tuple)
+			}
+		}
+
+		if len(tuples) != len(vote.ExchangeRateTuples) {
+			vote.ExchangeRateTuples = tuples
+			voters = append(voters, voterAddr)
+			votes = append(votes, vote)
+		}
+
+		return false
+	})
+
+	for i, voterAddr := range voters {
+		if len(votes[i].ExchangeRateTuples) == 0 {
+			k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
+		} else {
+			k.SetAggregateExchangeRateVote(ctx, voterAddr, votes[i])
+		}
+	}
+}
+
+// refundDenomMisses takes back the vote periods of the current slash window
+// missed on the given denom only from the miss counters and the vote statistics
+// of the validators, and deletes the denom miss counters.
+func (k Keeper) refundDenomMisses(ctx sdk.Context, symbolDenom string) {
+	var denomMissCounters []types.DenomMissCounter
+
+	k.IterateDenomMissCounters(ctx, symbolDenom, func(denomMissCounter types.DenomMissCounter) bool {
+		denomMissCounters = append(denomMissCounters, denomMissCounter)
+		return false
+	})
+
+	params := k.GetParams(ctx)
+	window := k.getSlashWindowIndex(ctx, params)
+
+	for _, denomMissCounter := range denomMissCounters {
+		operator, err := sdk.ValAddressFromBech32(denomMissCounter.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if missCounter := k.GetMissCounter(ctx, operator); missCounter > denomMissCounter.MissCounter {
+			k.SetMissCounter(ctx, operator, missCounter-denomMissCounter.MissCounter)
+		} else {
+			k.DeleteMissCounter(ctx, operator)
+		}
+
+		if performance, found := k.GetValidatorPerformance(ctx, operator, window); found {
+			refund := denomMissCounter.MissCounter
+			if refund > performance.Missed {
+				refund = performance.Missed
+			}
+
+			performance.Missed -= refund
+			performance.Won += refund
+			k.SetValidatorPerformance(ctx, performance)
+		}
+
+		k.DeleteDenomMissCounter(ctx, operator, symbolDenom)
+	}
+}
+
+// GetDenomMissCounter retrieves the # of vote periods of this oracle slash
+// window a validator missed on the given denom only.
+func (k Keeper) GetDenomMissCounter(ctx sdk.Context, operator sdk.ValAddress, denom string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetDenomMissCounterKey(strings.ToUpper(denom), operator))
+	if bz == nil {
+		// by default the counter is zero
+		return 0
+	}
+
+	var denomMissCounter types.DenomMissCounter
+	k.cdc.MustUnmarshal(bz, &denomMissCounter)
+
+	return denomMissCounter.MissCounter
+}
+
+// SetDenomMissCounter updates the # of vote periods of this oracle slash
+// window a validator missed on a denom only.
+func (k Keeper) SetDenomMissCounter(ctx sdk.Context, denomMissCounter types.DenomMissCounter) {
+	operator, err := sdk.ValAddressFromBech32(denomMissCounter.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	denomMissCounter.Denom = strings.ToUpper(denomMissCounter.Denom)
+
+	bz := k.cdc.MustMarshal(&denomMissCounter)
+	store.Set(types.GetDenomMissCounterKey(denomMissCounter.Denom, operator), bz)
+}
+
+// DeleteDenomMissCounter removes the denom miss counter of the validator.
+func (k Keeper) DeleteDenomMissCounter(ctx sdk.Context, operator sdk.ValAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomMissCounterKey(strings.ToUpper(denom), operator))
+}
+
+// IterateDenomMissCounters iterates over the miss counters of a denom.
+func (k Keeper) IterateDenomMissCounters(
+	ctx sdk.Context,
+	denom string,
+	handler func(types.DenomMissCounter) bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDenomMissCounterPrefix(strings.ToUpper(denom)))
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var denomMissCounter types.DenomMissCounter
+
+		k.cdc.MustUnmarshal(iter.Value(), &denomMissCounter)
+
+		if handler(denomMissCounter) {
+			break
+		}
+	}
+}
+
+// IterateAllDenomMissCounters iterates over the miss counters of all denoms,
+// grouped by denom.
+func (k Keeper) IterateAllDenomMissCounters(ctx sdk.Context, handler func(types.DenomMissCounter) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixDenomMissCounter)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var denomMissCounter types.DenomMissCounter
+
+		k.cdc.MustUnmarshal(iter.Value(), &denomMissCounter)
+
+		if handler(denomMissCounter) {
+			break
+		}
+	}
+}
+
+// ClearDenomMissCounters removes the miss counters of all denoms.
+func (k Keeper) ClearDenomMissCounters(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixDenomMissCounter)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// GetAddedDenom returns the record of a denom added to the accept list.
+func (k Keeper) GetAddedDenom(ctx sdk.Context, denom string) (types.AddedDenom, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAddedDenomKey(strings.ToUpper(denom)))
+	if bz == nil {
+		return types.AddedDenom{}, false
+	}
+
+	var addedDenom types.AddedDenom
+	k.cdc.MustUnmarshal(bz, &addedDenom)
+
+	return addedDenom, true
+}
+
+// SetAddedDenom stores the record of a denom added to the accept list.
+func (k Keeper) SetAddedDenom(ctx sdk.Context, addedDenom types.AddedDenom) {
+	store := ctx.KVStore(k.storeKey)
+	addedDenom.Denom = strings.ToUpper(addedDenom.Denom)
+
+	bz := k.cdc.MustMarshal(&addedDenom)
+	store.Set(types.GetAddedDenomKey(addedDenom.Denom), bz)
+}
+
+// DeleteAddedDenom deletes the record of a denom added to the accept list.
+func (k Keeper) DeleteAddedDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAddedDenomKey(strings.ToUpper(denom)))
+}
+
+// IterateAddedDenoms iterates over the records of the denoms added to the
+// accept list.
+func (k Keeper) IterateAddedDenoms(ctx sdk.Context, handler func(types.AddedDenom) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixAddedDenom)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var addedDenom types.AddedDenom
+
+		k.cdc.MustUnmarshal(iter.Value(), &addedDenom)
+
+		if handler(addedDenom) {
+			break
+		}
+	}
+}
+
+// ClearAddedDenoms removes the records of the denoms added to the accept list.
+func (k Keeper) ClearAddedDenoms(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixAddedDenom)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
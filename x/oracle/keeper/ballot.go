@@ -21,9 +21,13 @@ func (k Keeper) OrganizeBallotByDenom(
 		// organize ballot only for the active validators
 		claim, ok := validatorClaimMap[vote.Voter]
 		if ok {
-			power := claim.Power
-
 			for _, tuple := range vote.ExchangeRateTuples {
+				// abstaining votes have no power, they do not move the rate
+				power := claim.Power
+				if !tuple.ExchangeRate.IsPositive() {
+					power = 0
+				}
+
 				votes[tuple.Denom] = append(
 					votes[tuple.Denom],
 					types.NewVoteForTally(tuple.ExchangeRate, tuple.Denom, voterAddr, power),
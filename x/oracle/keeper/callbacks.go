@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icqtypes "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// Callback is an interchain query callback of the oracle module.
+type Callback func(Keeper, sdk.Context, []byte, icqtypes.Query) error
+
+// Callbacks wraps the interchain query callbacks of the oracle module.
+type Callbacks struct {
+	k         Keeper
+	callbacks map[string]Callback
+}
+
+var _ icqtypes.QueryCallbacks = Callbacks{}
+
+// CallbackHandler returns the handler of the interchain query callbacks of the
+// oracle module.
+func (k Keeper) CallbackHandler() Callbacks {
+	return Callbacks{k, make(map[string]Callback)}
+}
+
+// Call implements QueryCallbacks
+func (c Callbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
+	callback, found := c.callbacks[id]
+	if !found {
+		return fmt.Errorf("unknown callback %s", id)
+	}
+
+	return callback(c.k, ctx, args, query)
+}
+
+// Has implements QueryCallbacks
+func (c Callbacks) Has(id string) bool {
+	_, found := c.callbacks[id]
+	return found
+}
+
+// AddCallback implements QueryCallbacks
+func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallbacks {
+	c.callbacks[id] = fn.(Callback)
+	return c
+}
+
+// RegisterCallbacks implements QueryCallbacks
+func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
+	return c.AddCallback(types.RemotePriceCallbackID, Callback(RemotePriceCallback))
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetHaltedDenom returns the halt record of a denom, if it is halted by the
+// circuit breaker.
+func (k Keeper) GetHaltedDenom(ctx sdk.Context, denom string) (types.HaltedDenom, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetHaltedDenomKey(strings.ToUpper(denom)))
+	if bz == nil {
+		return types.HaltedDenom{}, false
+	}
+
+	var haltedDenom types.HaltedDenom
+	k.cdc.MustUnmarshal(bz, &haltedDenom)
+
+	return haltedDenom, true
+}
+
+// IsHaltedDenom returns true if the denom is halted by the circuit breaker.
+func (k Keeper) IsHaltedDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetHaltedDenomKey(strings.ToUpper(denom)))
+}
+
+// SetHaltedDenom stores the halt record of a denom.
+func (k Keeper) SetHaltedDenom(ctx sdk.Context, haltedDenom types.HaltedDenom) {
+	store := ctx.KVStore(k.storeKey)
+	haltedDenom.Denom = strings.ToUpper(haltedDenom.Denom)
+
+	bz := k.cdc.MustMarshal(&haltedDenom)
+	store.Set(types.GetHaltedDenomKey(haltedDenom.Denom), bz)
+}
+
+// DeleteHaltedDenom deletes the halt record of a denom.
+func (k Keeper) DeleteHaltedDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHaltedDenomKey(strings.ToUpper(denom)))
+}
+
+// IterateHaltedDenoms iterates over the denoms halted by the circuit breaker.
+func (k Keeper) IterateHaltedDenoms(ctx sdk.Context, handler func(types.HaltedDenom) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixHaltedDenom)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var haltedDenom types.HaltedDenom
+
+		k.cdc.MustUnmarshal(iter.Value(), &haltedDenom)
+
+		if handler(haltedDenom) {
+			break
+		}
+	}
+}
+
+// ResetCircuitBreaker marks a halted denom as reset, so that its next tallied
+// exchange rate is accepted without deviation check and lifts the halt.
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, denom string) error {
+	haltedDenom, found := k.GetHaltedDenom(ctx, denom)
+	if !found {
+		return errors.Wrap(types.ErrDenomNotHalted, denom)
+	}
+
+	haltedDenom.ResetRequested = true
+	k.SetHaltedDenom(ctx, haltedDenom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCircuitBreakerReset,
+			sdk.NewAttribute(types.EventAttrKeyDenom, haltedDenom.Denom),
+		),
+	)
+
+	return nil
+}
+
+// applyCircuitBreaker checks a tallied exchange rate against the circuit
+// breaker of its denom and returns whether it may be stored. A rate deviating
+// from the last one by more than the denom's MaxDeviation halts the denom,
+// pinning its last good rate until governance resets it. The pinned rate
+// still expires with MaxStaleness. The deviation is only measured against a
+// fresh rate, a rate following an expired one is accepted as the first rate is.
+func (k Keeper) applyCircuitBreaker(ctx sdk.Context, params types.Params, denom string, exchangeRate sdk.Dec) bool {
+	haltedDenom, halted := k.GetHaltedDenom(ctx, denom)
+	if halted {
+		if !haltedDenom.ResetRequested {
+			return false
+		}
+
+		k.DeleteHaltedDenom(ctx, denom)
+
+		return true
+	}
+
+	maxDeviation := params.DenomMaxDeviation(denom)
+	if maxDeviation == nil {
+		return true
+	}
+
+	lastRate, err := k.GetExchangeRate(ctx, denom)
+	if err != nil || !types.ExceedsDeviation(lastRate, exchangeRate, *maxDeviation) {
+		return true
+	}
+
+	k.SetHaltedDenom(ctx, types.NewHaltedDenom(denom, uint64(ctx.BlockHeight()), lastRate, exchangeRate))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCircuitBreakerHalt,
+			sdk.NewAttribute(types.EventAttrKeyDenom, strings.ToUpper(denom)),
+			sdk.NewAttribute(types.EventAttrKeyExchangeRate, lastRate.String()),
+			sdk.NewAttribute(types.EventAttrKeyRejectedRate, exchangeRate.String()),
+			sdk.NewAttribute(types.EventAttrKeyMaxDeviation, maxDeviation.String()),
+			sdk.NewAttribute(types.EventAttrKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return false
+}
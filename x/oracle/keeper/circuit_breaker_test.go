@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/keeper"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/testutil"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestCircuitBreaker() {
+	// custom app and context for this test
+	app, ctx := s.initAppAndContext()
+
+	_, valAddresses, err := testutil.StakingAddValidators(
+		app.BankKeeper,
+		app.StakingKeeper,
+		ctx,
+		3,
+	)
+	s.Require().NoError(err)
+
+	maxDeviation := sdk.NewDecWithPrec(10, 2)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 1
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6, MaxDeviation: &maxDeviation},
+	}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	tally := func(rate sdk.Dec) {
+		for _, valAddr := range valAddresses {
+			app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(
+				types.ExchangeRateTuples{types.NewExchangeRateTuple(types.AtomSymbol, rate)},
+				valAddr,
+			))
+		}
+
+		s.Require().NoError(app.OracleKeeper.BuildClaimsMapAndTally(ctx, params))
+	}
+
+	requireRate := func(expected sdk.Dec) {
+		rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, rate)
+	}
+
+	// the first rate has nothing to deviate from
+	tally(sdk.NewDec(10))
+	requireRate(sdk.NewDec(10))
+
+	// a move within the bound is accepted
+	tally(sdk.NewDec(11))
+	requireRate(sdk.NewDec(11))
+
+	// a move beyond the bound halts the denom and keeps the last good rate
+	tally(sdk.NewDec(20))
+	requireRate(sdk.NewDec(11))
+
+	halted, found := app.OracleKeeper.GetHaltedDenom(ctx, types.AtomSymbol)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDec(11), halted.ExchangeRate)
+	s.Require().Equal(sdk.NewDec(20), halted.RejectedExchangeRate)
+	s.Require().False(halted.ResetRequested)
+
+	// a halted denom keeps its rate even for a sane vote
+	tally(sdk.NewDec(11))
+	requireRate(sdk.NewDec(11))
+
+	resp, err := keeper.NewQuerier(app.OracleKeeper).HaltedDenoms(sdk.WrapSDKContext(ctx), &types.QueryHaltedDenomsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.HaltedDenoms, 1)
+
+	// governance resets the circuit breaker, the next tally lifts the halt
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+	authority := sdk.MustAccAddressFromBech32(app.OracleKeeper.GetAuthority())
+
+	_, err = msgServer.ResetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgResetCircuitBreaker(
+		sdk.AccAddress(valAddresses[0]), types.AtomSymbol,
+	))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.ResetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgResetCircuitBreaker(authority, "atom"))
+	s.Require().NoError(err)
+
+	tally(sdk.NewDec(20))
+	requireRate(sdk.NewDec(20))
+
+	_, found = app.OracleKeeper.GetHaltedDenom(ctx, types.AtomSymbol)
+	s.Require().False(found)
+
+	_, err = msgServer.ResetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgResetCircuitBreaker(authority, types.AtomSymbol))
+	s.Require().ErrorIs(err, types.ErrDenomNotHalted)
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakerStaleness() {
+	// custom app and context for this test
+	app, ctx := s.initAppAndContext()
+
+	_, valAddresses, err := testutil.StakingAddValidators(
+		app.BankKeeper,
+		app.StakingKeeper,
+		ctx,
+		3,
+	)
+	s.Require().NoError(err)
+
+	maxDeviation := sdk.NewDecWithPrec(10, 2)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 1
+	params.MaxStaleness = 10
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6, MaxDeviation: &maxDeviation},
+	}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	tally := func(ctx sdk.Context, rate sdk.Dec) {
+		for _, valAddr := range valAddresses {
+			app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(
+				types.ExchangeRateTuples{types.NewExchangeRateTuple(types.AtomSymbol, rate)},
+				valAddr,
+			))
+		}
+
+		s.Require().NoError(app.OracleKeeper.BuildClaimsMapAndTally(ctx, params))
+	}
+
+	// a move following an expired rate is not measured against it
+	tally(ctx, sdk.NewDec(10))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 11)
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().ErrorIs(err, types.ErrExpiredRate)
+
+	tally(ctx, sdk.NewDec(20))
+	s.Require().False(app.OracleKeeper.IsHaltedDenom(ctx, types.AtomSymbol))
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(20), rate)
+
+	// a halt pins the last good rate until its staleness
+	tally(ctx, sdk.NewDec(40))
+	s.Require().True(app.OracleKeeper.IsHaltedDenom(ctx, types.AtomSymbol))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(20), rate)
+
+	// past it, the pinned rate is only returned on request
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().ErrorIs(err, types.ErrHaltedRate)
+
+	rate, err = app.OracleKeeper.GetLastExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(20), rate)
+
+	// the rate expires again once governance lifts the halt
+	s.Require().NoError(app.OracleKeeper.ResetCircuitBreaker(ctx, types.AtomSymbol))
+	tally(ctx, sdk.NewDec(40))
+	s.Require().False(app.OracleKeeper.IsHaltedDenom(ctx, types.AtomSymbol))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 11)
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().ErrorIs(err, types.ErrExpiredRate)
+}
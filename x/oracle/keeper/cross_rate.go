@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetUSDExchangeRate returns the exchange rate of a denom of the accept list
+// in USD, converting it through its quote denoms if it is not quoted in USD.
+func (k Keeper) GetUSDExchangeRate(ctx sdk.Context, symbolDenom string) (sdk.Dec, error) {
+	acceptList := k.GetAcceptList(ctx)
+	usdRate := sdk.OneDec()
+
+	// the accept list is validated to be acyclic, so the chain of quote denoms
+	// has at most one link per denom
+	for i := 0; i <= len(acceptList); i++ {
+		rate, err := k.GetExchangeRate(ctx, symbolDenom)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+
+		usdRate = usdRate.Mul(rate)
+
+		denom, found := acceptList.Find(symbolDenom)
+		if !found || denom.QuoteDenom == "" {
+			return usdRate, nil
+		}
+
+		symbolDenom = denom.QuoteDenom
+	}
+
+	return sdk.ZeroDec(), errors.Wrapf(types.ErrInvalidQuoteDenom, "cyclic quote denom %s", symbolDenom)
+}
+
+// GetCrossExchangeRate returns the amount of the quote asset per unit of the
+// base asset, derived from their USD exchange rates. Each asset is either USD,
+// a symbol denom priced per display unit or a base denom (e.g. ibc/...) priced
+// per base unit, scaled by the Exponent of the denom.
+func (k Keeper) GetCrossExchangeRate(ctx sdk.Context, base, quote string) (sdk.Dec, error) {
+	baseRate, err := k.getScaledUSDExchangeRate(ctx, base)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	quoteRate, err := k.getScaledUSDExchangeRate(ctx, quote)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if !quoteRate.IsPositive() {
+		return sdk.ZeroDec(), errors.Wrapf(types.ErrInvalidExchangeRate, "%s has no positive exchange rate", quote)
+	}
+
+	return baseRate.Quo(quoteRate), nil
+}
+
+// getScaledUSDExchangeRate returns the USD price of one unit of an asset,
+// which is a display unit for symbols and a base unit for base denoms.
+func (k Keeper) getScaledUSDExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	if strings.EqualFold(denom, types.USDSymbol) {
+		return sdk.OneDec(), nil
+	}
+
+	acceptList := k.GetAcceptList(ctx)
+
+	if d, found := acceptList.Find(denom); found {
+		return k.GetUSDExchangeRate(ctx, d.SymbolDenom)
+	}
+
+	d, found := acceptList.FindByBaseDenom(denom)
+	if !found {
+		return sdk.ZeroDec(), errors.Wrap(types.ErrUnknownDenom, denom)
+	}
+
+	rate, err := k.GetUSDExchangeRate(ctx, d.SymbolDenom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return rate.Quo(sdk.NewDec(10).Power(uint64(d.Exponent))), nil
+}
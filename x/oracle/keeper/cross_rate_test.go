@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestGetCrossExchangeRate() {
+	app, ctx := s.app, s.ctx
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6},
+		{BaseDenom: types.PersistenceDenom, SymbolDenom: types.PersistenceSymbol, Exponent: 6},
+		// stATOM is quoted in ATOM
+		{BaseDenom: "ibc/statom", SymbolDenom: "STATOM", Exponent: 18, QuoteDenom: types.AtomSymbol},
+	}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	app.OracleKeeper.SetExchangeRate(ctx, types.AtomSymbol, sdk.NewDec(10))
+	app.OracleKeeper.SetExchangeRate(ctx, types.PersistenceSymbol, sdk.NewDecWithPrec(5, 1))
+	app.OracleKeeper.SetExchangeRate(ctx, "STATOM", sdk.NewDecWithPrec(12, 1))
+
+	tests := []struct {
+		name     string
+		base     string
+		quote    string
+		expected sdk.Dec
+	}{
+		{"symbols", types.AtomSymbol, types.PersistenceSymbol, sdk.NewDec(20)},
+		{"inverse", types.PersistenceSymbol, "atom", sdk.NewDecWithPrec(5, 2)},
+		{"usd quote", types.AtomSymbol, types.USDSymbol, sdk.NewDec(10)},
+		{"usd base", "usd", types.PersistenceSymbol, sdk.NewDec(2)},
+		{"non-usd quote denom", "STATOM", types.USDSymbol, sdk.NewDec(12)},
+		{"non-usd quote denom in its quote", "STATOM", types.AtomSymbol, sdk.NewDecWithPrec(12, 1)},
+		{"base denoms", types.AtomDenom, types.PersistenceDenom, sdk.NewDec(20)},
+		{"base denom in usd", types.PersistenceDenom, types.USDSymbol, sdk.NewDecWithPrec(5, 7)},
+		{"base denoms with different exponents", "ibc/statom", types.AtomDenom, sdk.NewDecWithPrec(12, 13)},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			rate, err := app.OracleKeeper.GetCrossExchangeRate(ctx, tc.base, tc.quote)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, rate)
+		})
+	}
+
+	_, err := app.OracleKeeper.GetCrossExchangeRate(ctx, "OSMO", types.USDSymbol)
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	resp, err := s.queryClient.CrossExchangeRate(ctx.Context(), &types.QueryCrossExchangeRateRequest{
+		Base:  types.AtomSymbol,
+		Quote: types.PersistenceSymbol,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(20), resp.ExchangeRate)
+
+	// the quote denom of another denom can not be removed
+	err = app.OracleKeeper.RemoveAcceptListDenom(ctx, types.AtomSymbol)
+	s.Require().ErrorIs(err, types.ErrInvalidQuoteDenom)
+}
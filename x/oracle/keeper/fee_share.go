@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// CollectFeeShare moves the fee share of the collected fees of the reward
+// denoms from the fee collector into the reward pool. It has to run before
+// the mint module adds the block provisions to the collected fees, so that the
+// share is not taken from the staking inflation, and before the distribution
+// module allocates them.
+func (k Keeper) CollectFeeShare(ctx sdk.Context) error {
+	feeShare := k.GetFeeShare(ctx)
+	if !feeShare.IsPositive() {
+		return nil
+	}
+
+	feeCollector := k.accountKeeper.GetModuleAddress(k.feeCollectorName)
+	if feeCollector == nil {
+		return fmt.Errorf("%s module account has not been set", k.feeCollectorName)
+	}
+
+	var shares sdk.Coins
+
+	for _, denom := range k.GetRewardDenoms(ctx) {
+		fees := k.bankKeeper.GetBalance(ctx, feeCollector, denom)
+
+		share := sdk.NewDecFromInt(fees.Amount).Mul(feeShare).TruncateInt()
+		if share.IsPositive() {
+			shares = shares.Add(sdk.NewCoin(denom, share))
+		}
+	}
+
+	if shares.Empty() {
+		return nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, shares)
+	if err != nil {
+		return fmt.Errorf("failed to move the fee share to the reward pool: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeShare,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shares.String()),
+			sdk.NewAttribute(types.EventAttrKeyFeeShare, feeShare.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestCollectFeeShare() {
+	app, ctx := s.app, s.ctx
+
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin(types.PersistenceDenom, 1000),
+		sdk.NewInt64Coin(types.AtomDenom, 1000),
+	)
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	oracleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	collected := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+	pool := app.BankKeeper.GetAllBalances(ctx, oracleAddr)
+
+	// the fee share is disabled by default
+	s.Require().NoError(app.OracleKeeper.CollectFeeShare(ctx))
+	s.Require().Equal(collected, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.FeeShare = sdk.NewDecWithPrec(25, 2)
+	app.OracleKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(app.OracleKeeper.CollectFeeShare(ctx))
+
+	// only the reward denoms are shared
+	share := sdk.NewInt64Coin(types.PersistenceDenom, collected.AmountOf(types.PersistenceDenom).QuoRaw(4).Int64())
+	s.Require().Equal(collected.Sub(share), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	s.Require().Equal(pool.Add(share), app.BankKeeper.GetAllBalances(ctx, oracleAddr))
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeFeeShare {
+			continue
+		}
+
+		found = true
+		s.Require().Equal(sdk.AttributeKeyAmount, string(event.Attributes[0].Key))
+		s.Require().Equal(share.String(), string(event.Attributes[0].Value))
+	}
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestCollectFeeShareWithInflation() {
+	app, ctx := s.app, s.ctx
+
+	mintParams := app.MintKeeper.GetParams(ctx)
+	mintParams.InflationMin = sdk.NewDecWithPrec(10, 2)
+	app.MintKeeper.SetParams(ctx, mintParams)
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.FeeShare = sdk.NewDecWithPrec(25, 2)
+	params.RewardDenoms = []string{mintParams.MintDenom}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(mintParams.MintDenom, 1000))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+	oracleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	pool := app.BankKeeper.GetBalance(ctx, oracleAddr, mintParams.MintDenom)
+	supply := app.BankKeeper.GetSupply(ctx, mintParams.MintDenom)
+
+	app.BeginBlocker(ctx, abci.RequestBeginBlock{})
+
+	// the block provisions are minted into the fee collector, but the share
+	// is only taken from the collected fees
+	s.Require().True(app.BankKeeper.GetSupply(ctx, mintParams.MintDenom).IsGTE(supply.AddAmount(sdk.OneInt())))
+	s.Require().Equal(pool.AddAmount(sdk.NewInt(250)), app.BankKeeper.GetBalance(ctx, oracleAddr, mintParams.MintDenom))
+}
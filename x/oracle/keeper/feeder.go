@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetFeeder returns a registered feeder of a validator.
+func (k Keeper) GetFeeder(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress) (types.Feeder, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFeederKey(operator, feeder))
+	if bz == nil {
+		return types.Feeder{}, false
+	}
+
+	var registered types.Feeder
+	k.cdc.MustUnmarshal(bz, &registered)
+
+	return registered, true
+}
+
+// SetFeeder stores a registered feeder of a validator.
+func (k Keeper) SetFeeder(ctx sdk.Context, feeder types.Feeder) {
+	operator, err := sdk.ValAddressFromBech32(feeder.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(feeder.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&feeder)
+	store.Set(types.GetFeederKey(operator, feederAddr), bz)
+}
+
+// DeleteFeeder deletes a registered feeder of a validator.
+func (k Keeper) DeleteFeeder(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeederKey(operator, feeder))
+}
+
+// IterateFeeders iterates over the registered feeders of a validator.
+func (k Keeper) IterateFeeders(ctx sdk.Context, operator sdk.ValAddress, handler func(types.Feeder) bool) {
+	k.iterateFeeders(ctx, types.GetFeederPrefix(operator), handler)
+}
+
+// IterateAllFeeders iterates over the registered feeders of all validators.
+func (k Keeper) IterateAllFeeders(ctx sdk.Context, handler func(types.Feeder) bool) {
+	k.iterateFeeders(ctx, types.KeyPrefixFeeder, handler)
+}
+
+func (k Keeper) iterateFeeders(ctx sdk.Context, prefix []byte, handler func(types.Feeder) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var feeder types.Feeder
+
+		k.cdc.MustUnmarshal(iter.Value(), &feeder)
+
+		if handler(feeder) {
+			break
+		}
+	}
+}
+
+// PruneExpiredFeeders deletes the registered feeders which expired at the
+// current height.
+func (k Keeper) PruneExpiredFeeders(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+
+	var expired []types.Feeder
+
+	k.IterateAllFeeders(ctx, func(feeder types.Feeder) bool {
+		if !feeder.IsActive(height) {
+			expired = append(expired, feeder)
+		}
+
+		return false
+	})
+
+	for _, feeder := range expired {
+		operator, err := sdk.ValAddressFromBech32(feeder.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		feederAddr, err := sdk.AccAddressFromBech32(feeder.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		k.DeleteFeeder(ctx, operator, feederAddr)
+	}
+}
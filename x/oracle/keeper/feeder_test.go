@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestValidateFeederRegistered() {
+	app, ctx := s.app, s.ctx
+	valAddr := s.valAddresses[0]
+	height := uint64(ctx.BlockHeight())
+
+	feederAddr := sdk.AccAddress([]byte("feeder______________"))
+	scopedAddr := sdk.AccAddress([]byte("scoped______________"))
+	expiredAddr := sdk.AccAddress([]byte("expired_____________"))
+
+	app.OracleKeeper.SetFeeder(ctx, types.NewFeeder(valAddr, feederAddr, 0, nil))
+	app.OracleKeeper.SetFeeder(ctx, types.NewFeeder(valAddr, scopedAddr, height+10, []string{types.AtomSymbol}))
+	app.OracleKeeper.SetFeeder(ctx, types.NewFeeder(valAddr, expiredAddr, height, nil))
+
+	// the default feeder delegation is still allowed
+	s.Require().NoError(app.OracleKeeper.ValidateFeeder(ctx, valAddr, s.accAddresses[0], types.PersistenceSymbol))
+
+	s.Require().NoError(app.OracleKeeper.ValidateFeeder(ctx, valAddr, feederAddr, types.AtomSymbol, types.PersistenceSymbol))
+
+	s.Require().NoError(app.OracleKeeper.ValidateFeeder(ctx, valAddr, scopedAddr))
+	s.Require().NoError(app.OracleKeeper.ValidateFeeder(ctx, valAddr, scopedAddr, "atom"))
+	s.Require().ErrorIs(
+		app.OracleKeeper.ValidateFeeder(ctx, valAddr, scopedAddr, types.AtomSymbol, types.PersistenceSymbol),
+		types.ErrNoVotingPermission,
+	)
+
+	s.Require().ErrorIs(app.OracleKeeper.ValidateFeeder(ctx, valAddr, expiredAddr), types.ErrNoVotingPermission)
+
+	// a feeder of another validator is not allowed
+	s.Require().ErrorIs(app.OracleKeeper.ValidateFeeder(ctx, s.valAddresses[1], feederAddr), types.ErrNoVotingPermission)
+
+	// the scoped feeder expires as well
+	s.Require().ErrorIs(
+		app.OracleKeeper.ValidateFeeder(ctx.WithBlockHeight(int64(height+10)), valAddr, scopedAddr),
+		types.ErrNoVotingPermission,
+	)
+
+	app.OracleKeeper.PruneExpiredFeeders(ctx)
+
+	_, found := app.OracleKeeper.GetFeeder(ctx, valAddr, expiredAddr)
+	s.Require().False(found)
+	_, found = app.OracleKeeper.GetFeeder(ctx, valAddr, scopedAddr)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_AddFeeder() {
+	app, ctx := s.app, s.ctx
+	valAddr := s.valAddresses[0]
+	height := uint64(ctx.BlockHeight())
+
+	feederAddr := sdk.AccAddress([]byte("feeder______________"))
+
+	_, err := s.msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(valAddr, feederAddr, height, nil))
+	s.Require().ErrorIs(err, types.ErrInvalidFeeder)
+
+	_, err = s.msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(valAddr, feederAddr, 0, []string{"OSMO"}))
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	unknownVal := sdk.ValAddress([]byte("unknown_validator___"))
+	_, err = s.msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(unknownVal, feederAddr, 0, nil))
+	s.Require().ErrorIs(err, sdkstaking.ErrNoValidatorFound)
+
+	_, err = s.msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(valAddr, feederAddr, height+100, []string{types.AtomSymbol}))
+	s.Require().NoError(err)
+
+	feeder, found := app.OracleKeeper.GetFeeder(ctx, valAddr, feederAddr)
+	s.Require().True(found)
+	s.Require().Equal(height+100, feeder.ExpiryHeight)
+	s.Require().Equal([]string{types.AtomSymbol}, feeder.Denoms)
+
+	// registering the feeder again updates it
+	_, err = s.msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(valAddr, feederAddr, 0, nil))
+	s.Require().NoError(err)
+
+	feeder, found = app.OracleKeeper.GetFeeder(ctx, valAddr, feederAddr)
+	s.Require().True(found)
+	s.Require().Zero(feeder.ExpiryHeight)
+	s.Require().Empty(feeder.Denoms)
+
+	// the number of feeders of a validator is limited
+	for i := 1; i < types.MaxFeedersPerValidator; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("feeder%014d", i)))
+		_, err = s.msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(valAddr, addr, 0, nil))
+		s.Require().NoError(err)
+	}
+
+	_, err = s.msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(valAddr, sdk.AccAddress([]byte("one_too_many________")), 0, nil))
+	s.Require().ErrorIs(err, types.ErrInvalidFeeder)
+
+	resp, err := s.queryClient.Feeders(ctx.Context(), &types.QueryFeedersRequest{ValidatorAddr: valAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.accAddresses[0].String(), resp.FeederAddr)
+	s.Require().Len(resp.Feeders, types.MaxFeedersPerValidator)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RevokeFeeder() {
+	app, ctx := s.app, s.ctx
+	valAddr := s.valAddresses[0]
+
+	feederAddr := sdk.AccAddress([]byte("feeder______________"))
+
+	_, err := s.msgServer.RevokeFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRevokeFeeder(valAddr, feederAddr))
+	s.Require().ErrorIs(err, types.ErrFeederNotFound)
+
+	_, err = s.msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(valAddr, feederAddr, 0, nil))
+	s.Require().NoError(err)
+	s.Require().NoError(app.OracleKeeper.ValidateFeeder(ctx, valAddr, feederAddr))
+
+	_, err = s.msgServer.RevokeFeeder(sdk.WrapSDKContext(ctx), types.NewMsgRevokeFeeder(valAddr, feederAddr))
+	s.Require().NoError(err)
+	s.Require().ErrorIs(app.OracleKeeper.ValidateFeeder(ctx, valAddr, feederAddr), types.ErrNoVotingPermission)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_AggregateExchangeRateVoteScopedFeeder() {
+	app, ctx := s.app, s.ctx
+	valAddr := s.valAddresses[0]
+	votePeriod := app.OracleKeeper.GetVotePeriod(ctx)
+
+	feederAddr := sdk.AccAddress([]byte("feeder______________"))
+	app.OracleKeeper.SetFeeder(ctx, types.NewFeeder(valAddr, feederAddr, 0, []string{types.AtomSymbol}))
+
+	salt, err := generateSalt(32)
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		rates string
+		err   error
+	}{
+		{"ATOM:123.2,XPRT:1.1", types.ErrNoVotingPermission},
+		{"ATOM:123.2", nil},
+	} {
+		hash := types.GetAggregateVoteHash(salt, tc.rates, valAddr)
+		app.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr, types.NewAggregateExchangeRatePrevote(
+			hash, valAddr, initialHeight-votePeriod,
+		))
+
+		_, err = s.msgServer.AggregateExchangeRateVote(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgAggregateExchangeRateVote(salt, tc.rates, feederAddr, valAddr),
+		)
+		if tc.err != nil {
+			s.Require().ErrorIs(err, tc.err)
+		} else {
+			s.Require().NoError(err)
+		}
+	}
+}
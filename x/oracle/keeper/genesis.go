@@ -55,6 +55,57 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, hr := range genState.HistoricExchangeRates {
+		k.SetHistoricExchangeRate(ctx, hr)
+	}
+
+	for _, md := range genState.ExchangeRateMetadata {
+		k.SetExchangeRateMetadata(ctx, md)
+	}
+
+	for _, hd := range genState.HaltedDenoms {
+		k.SetHaltedDenom(ctx, hd)
+	}
+
+	for _, vp := range genState.ValidatorPerformances {
+		k.SetValidatorPerformance(ctx, vp)
+	}
+
+	for _, oj := range genState.OracleJails {
+		k.SetOracleJail(ctx, oj)
+	}
+
+	nextScheduleID := uint64(1)
+	for _, rs := range genState.RewardSchedules {
+		k.SetRewardSchedule(ctx, rs)
+
+		if rs.Id >= nextScheduleID {
+			nextScheduleID = rs.Id + 1
+		}
+	}
+
+	k.SetNextRewardScheduleID(ctx, nextScheduleID)
+
+	for _, tr := range genState.TallyResults {
+		k.SetTallyResult(ctx, tr)
+	}
+
+	for _, f := range genState.Feeders {
+		k.SetFeeder(ctx, f)
+	}
+
+	for _, rp := range genState.RemotePrices {
+		k.SetRemotePrice(ctx, rp)
+	}
+
+	for _, dm := range genState.DenomMissCounters {
+		k.SetDenomMissCounter(ctx, dm)
+	}
+
+	for _, ad := range genState.AddedDenoms {
+		k.SetAddedDenom(ctx, ad)
+	}
+
 	k.SetParams(ctx, genState.Params)
 
 	// check if the module account exists
@@ -121,6 +172,83 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
+	var historicExchangeRates []types.HistoricExchangeRate
+
+	k.IterateAllHistoricExchangeRates(ctx, func(historicRate types.HistoricExchangeRate) bool {
+		historicExchangeRates = append(historicExchangeRates, historicRate)
+		return false
+	})
+
+	var exchangeRateMetadata []types.ExchangeRateMetadata
+
+	k.IterateExchangeRateMetadata(ctx, func(metadata types.ExchangeRateMetadata) bool {
+		exchangeRateMetadata = append(exchangeRateMetadata, metadata)
+		return false
+	})
+
+	var haltedDenoms []types.HaltedDenom
+
+	k.IterateHaltedDenoms(ctx, func(haltedDenom types.HaltedDenom) bool {
+		haltedDenoms = append(haltedDenoms, haltedDenom)
+		return false
+	})
+
+	var validatorPerformances []types.ValidatorPerformance
+
+	k.IterateAllValidatorPerformances(ctx, func(performance types.ValidatorPerformance) bool {
+		validatorPerformances = append(validatorPerformances, performance)
+		return false
+	})
+
+	var oracleJails []types.OracleJail
+
+	k.IterateOracleJails(ctx, func(oracleJail types.OracleJail) bool {
+		oracleJails = append(oracleJails, oracleJail)
+		return false
+	})
+
+	var rewardSchedules []types.RewardSchedule
+
+	k.IterateRewardSchedules(ctx, func(schedule types.RewardSchedule) bool {
+		rewardSchedules = append(rewardSchedules, schedule)
+		return false
+	})
+
+	var tallyResults []types.TallyResult
+
+	k.IterateAllTallyResults(ctx, func(tallyResult types.TallyResult) bool {
+		tallyResults = append(tallyResults, tallyResult)
+		return false
+	})
+
+	var feeders []types.Feeder
+
+	k.IterateAllFeeders(ctx, func(feeder types.Feeder) bool {
+		feeders = append(feeders, feeder)
+		return false
+	})
+
+	var remotePrices []types.RemotePrice
+
+	k.IterateRemotePrices(ctx, func(remotePrice types.RemotePrice) bool {
+		remotePrices = append(remotePrices, remotePrice)
+		return false
+	})
+
+	var denomMissCounters []types.DenomMissCounter
+
+	k.IterateAllDenomMissCounters(ctx, func(denomMissCounter types.DenomMissCounter) bool {
+		denomMissCounters = append(denomMissCounters, denomMissCounter)
+		return false
+	})
+
+	var addedDenoms []types.AddedDenom
+
+	k.IterateAddedDenoms(ctx, func(addedDenom types.AddedDenom) bool {
+		addedDenoms = append(addedDenoms, addedDenom)
+		return false
+	})
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
@@ -128,5 +256,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		missCounters,
 		aggregateExchangeRatePrevotes,
 		aggregateExchangeRateVotes,
+		historicExchangeRates,
+		exchangeRateMetadata,
+		haltedDenoms,
+		validatorPerformances,
+		oracleJails,
+		rewardSchedules,
+		tallyResults,
+		feeders,
+		remotePrices,
+		denomMissCounters,
+		addedDenoms,
 	)
 }
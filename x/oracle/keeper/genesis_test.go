@@ -61,6 +61,7 @@ func (s *KeeperTestSuite) TestOracleInitGenesis() {
 			SlashFraction:     sdk.MustNewDecFromStr("0.05"),
 			SlashWindow:       10000,
 			MinValidPerWindow: sdk.MustNewDecFromStr("0.6"),
+			FeeShare:          sdk.MustNewDecFromStr("0.1"),
 		},
 		ExchangeRates: types.ExchangeRateTuples{
 			types.ExchangeRateTuple{
@@ -122,6 +123,16 @@ func (s *KeeperTestSuite) TestOracleInitGenesis() {
 				Voter: valAddr2.String(),
 			},
 		},
+		HistoricExchangeRates: []types.HistoricExchangeRate{
+			types.NewHistoricExchangeRate(types.AtomSymbol, atomExchangeRate, initialHeight-1, ctx.BlockTime()),
+			types.NewHistoricExchangeRate(types.AtomSymbol, atomExchangeRate, initialHeight, ctx.BlockTime()),
+		},
+		DenomMissCounters: []types.DenomMissCounter{
+			types.NewDenomMissCounter(valAddr, types.AtomSymbol, 1),
+		},
+		AddedDenoms: []types.AddedDenom{
+			types.NewAddedDenom(types.AtomSymbol, 1),
+		},
 	}
 
 	// initialize the keeper with new genesis state and confirm that the params are set correctly
@@ -134,4 +145,7 @@ func (s *KeeperTestSuite) TestOracleInitGenesis() {
 	s.Require().Equal(len(newGenesisState.GetFeederDelegations()), len(newlyExportedState.GetFeederDelegations()))
 	s.Require().EqualValues(len(newGenesisState.GetAggregateExchangeRatePrevotes()), len(newlyExportedState.GetAggregateExchangeRatePrevotes()))
 	s.Require().Equal(len(newGenesisState.GetAggregateExchangeRateVotes()), len(newlyExportedState.GetAggregateExchangeRateVotes()))
+	s.Require().Equal(len(newGenesisState.GetHistoricExchangeRates()), len(newlyExportedState.GetHistoricExchangeRates()))
+	s.Require().Equal(newGenesisState.GetDenomMissCounters(), newlyExportedState.GetDenomMissCounters())
+	s.Require().Equal(newGenesisState.GetAddedDenoms(), newlyExportedState.GetAddedDenoms())
 }
@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -82,6 +86,274 @@ func (q querier) ExchangeRate(
 	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate.String()}, nil
 }
 
+// HistoricExchangeRates queries the tallied exchange rates of a denom within a
+// block height range.
+func (q querier) HistoricExchangeRates(
+	goCtx context.Context,
+	req *types.QueryHistoricExchangeRatesRequest,
+) (*types.QueryHistoricExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	if req.EndHeight != 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Error(codes.InvalidArgument, "end height must not be lower than start height")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(
+		ctx.KVStore(q.storeKey),
+		types.GetHistoricExchangeRatePrefix(strings.ToUpper(req.Denom)),
+	)
+
+	// the keys are big endian heights, so the first page starts at the start
+	// height unless the request positions it otherwise
+	pageReq := req.Pagination
+	if req.StartHeight != 0 && (pageReq == nil || (len(pageReq.Key) == 0 && pageReq.Offset == 0)) {
+		pageReq = &query.PageRequest{Key: sdk.Uint64ToBigEndian(req.StartHeight)}
+		if req.Pagination != nil {
+			pageReq.Limit = req.Pagination.Limit
+			pageReq.Reverse = req.Pagination.Reverse
+		}
+	}
+
+	var historicRates []types.HistoricExchangeRate
+
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		height := sdk.BigEndianToUint64(key)
+		if height < req.StartHeight || (req.EndHeight != 0 && height > req.EndHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			var historicRate types.HistoricExchangeRate
+			if err := q.cdc.Unmarshal(value, &historicRate); err != nil {
+				return false, err
+			}
+
+			historicRates = append(historicRates, historicRate)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricExchangeRatesResponse{
+		HistoricExchangeRates: historicRates,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// TallyResults queries the statistics of the tallies of a denom within a vote
+// period range.
+func (q querier) TallyResults(
+	goCtx context.Context,
+	req *types.QueryTallyResultsRequest,
+) (*types.QueryTallyResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	if req.EndPeriod != 0 && req.EndPeriod < req.StartPeriod {
+		return nil, status.Error(codes.InvalidArgument, "end period must not be lower than start period")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var tallyResults []types.TallyResult
+
+	q.IterateTallyResults(ctx, req.Denom, req.StartPeriod, req.EndPeriod, func(tallyResult types.TallyResult) bool {
+		tallyResults = append(tallyResults, tallyResult)
+		return false
+	})
+
+	return &types.QueryTallyResultsResponse{TallyResults: tallyResults}, nil
+}
+
+// LatestTallyResults queries the statistics of the latest tally of every denom
+// of the accept list.
+func (q querier) LatestTallyResults(
+	goCtx context.Context,
+	req *types.QueryLatestTallyResultsRequest,
+) (*types.QueryLatestTallyResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tallyResults := []types.TallyResult{}
+
+	for _, denom := range q.GetAcceptList(ctx) {
+		if tallyResult, found := q.GetLatestTallyResult(ctx, denom.SymbolDenom); found {
+			tallyResults = append(tallyResults, tallyResult)
+		}
+	}
+
+	return &types.QueryLatestTallyResultsResponse{TallyResults: tallyResults}, nil
+}
+
+// RemotePrice queries the price of a denom last read from its remote DEX pool.
+func (q querier) RemotePrice(
+	goCtx context.Context,
+	req *types.QueryRemotePriceRequest,
+) (*types.QueryRemotePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	remotePrice, found := q.GetRemotePrice(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no remote price for denom %s", req.Denom)
+	}
+
+	return &types.QueryRemotePriceResponse{RemotePrice: remotePrice}, nil
+}
+
+// TWAP queries the time-weighted average exchange rate of a denom.
+func (q querier) TWAP(
+	goCtx context.Context,
+	req *types.QueryTWAPRequest,
+) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	if req.WindowSeconds == 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	twap, err := q.GetTWAP(ctx, req.Denom, time.Duration(req.WindowSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{Twap: twap}, nil
+}
+
+// ExchangeRateMetadata queries the exchange rate of a denom together with the
+// metadata of its last successful tally.
+func (q querier) ExchangeRateMetadata(
+	goCtx context.Context,
+	req *types.QueryExchangeRateMetadataRequest,
+) (*types.QueryExchangeRateMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	exchangeRate, err := q.GetLastExchangeRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, found := q.GetExchangeRateMetadata(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryExchangeRateMetadataResponse{
+		ExchangeRate: exchangeRate,
+		Metadata:     metadata,
+		Expired:      metadata.IsExpired(uint64(ctx.BlockHeight()), q.GetMaxStaleness(ctx)),
+	}, nil
+}
+
+// AllExchangeRateMetadata queries the metadata of the exchange rates of all
+// denoms.
+func (q querier) AllExchangeRateMetadata(
+	goCtx context.Context,
+	req *types.QueryAllExchangeRateMetadataRequest,
+) (*types.QueryAllExchangeRateMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var exchangeRateMetadata []types.ExchangeRateMetadata
+
+	q.IterateExchangeRateMetadata(ctx, func(metadata types.ExchangeRateMetadata) bool {
+		exchangeRateMetadata = append(exchangeRateMetadata, metadata)
+		return false
+	})
+
+	return &types.QueryAllExchangeRateMetadataResponse{ExchangeRateMetadata: exchangeRateMetadata}, nil
+}
+
+// CrossExchangeRate queries the exchange rate of a pair of denoms derived
+// from their USD exchange rates.
+func (q querier) CrossExchangeRate(
+	goCtx context.Context,
+	req *types.QueryCrossExchangeRateRequest,
+) (*types.QueryCrossExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Base == "" || req.Quote == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	exchangeRate, err := q.GetCrossExchangeRate(ctx, req.Base, req.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCrossExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+}
+
+// HaltedDenoms queries the denoms halted by the circuit breaker.
+func (q querier) HaltedDenoms(
+	goCtx context.Context,
+	req *types.QueryHaltedDenomsRequest,
+) (*types.QueryHaltedDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var haltedDenoms []types.HaltedDenom
+
+	q.IterateHaltedDenoms(ctx, func(haltedDenom types.HaltedDenom) bool {
+		haltedDenoms = append(haltedDenoms, haltedDenom)
+		return false
+	})
+
+	return &types.QueryHaltedDenomsResponse{HaltedDenoms: haltedDenoms}, nil
+}
+
 // ActiveExchangeRates queries all denoms for which exchange rates exist.
 func (q querier) ActiveExchangeRates(
 	goCtx context.Context,
@@ -130,6 +402,41 @@ func (q querier) FeederDelegation(
 	}, nil
 }
 
+// Feeders queries the feeder delegation and the registered feeders of a
+// validator.
+func (q querier) Feeders(
+	goCtx context.Context,
+	req *types.QueryFeedersRequest,
+) (*types.QueryFeedersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feederAddr, err := q.GetFeederDelegation(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	feeders := []types.Feeder{}
+
+	q.IterateFeeders(ctx, valAddr, func(feeder types.Feeder) bool {
+		feeders = append(feeders, feeder)
+		return false
+	})
+
+	return &types.QueryFeedersResponse{
+		FeederAddr: feederAddr.String(),
+		Feeders:    feeders,
+	}, nil
+}
+
 // MissCounter queries oracle miss counter of a validator.
 func (q querier) MissCounter(
 	goCtx context.Context,
@@ -151,6 +458,98 @@ func (q querier) MissCounter(
 	}, nil
 }
 
+// ValidatorPerformance queries the vote statistics of a validator over the
+// kept slash windows.
+func (q querier) ValidatorPerformance(
+	goCtx context.Context,
+	req *types.QueryValidatorPerformanceRequest,
+) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var windows []types.ValidatorPerformance
+
+	q.IterateValidatorPerformances(ctx, valAddr, func(performance types.ValidatorPerformance) bool {
+		windows = append(windows, performance)
+		return false
+	})
+
+	return &types.QueryValidatorPerformanceResponse{
+		Summary: types.NewValidatorPerformanceSummary(valAddr.String(), windows),
+		Windows: windows,
+	}, nil
+}
+
+// ValidatorPerformances queries the vote statistics of all validators, ranked
+// by uptime.
+func (q querier) ValidatorPerformances(
+	goCtx context.Context,
+	req *types.QueryValidatorPerformancesRequest,
+) (*types.QueryValidatorPerformancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryValidatorPerformancesResponse{
+		Summaries: q.GetValidatorPerformanceSummaries(ctx),
+	}, nil
+}
+
+// OracleJail queries the oracle jail record of a validator.
+func (q querier) OracleJail(
+	goCtx context.Context,
+	req *types.QueryOracleJailRequest,
+) (*types.QueryOracleJailResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	oracleJail, found := q.GetOracleJail(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no oracle jail record for %s", req.ValidatorAddr)
+	}
+
+	return &types.QueryOracleJailResponse{OracleJail: oracleJail}, nil
+}
+
+// OracleJails queries the oracle jail records of all validators.
+func (q querier) OracleJails(
+	goCtx context.Context,
+	req *types.QueryOracleJailsRequest,
+) (*types.QueryOracleJailsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var oracleJails []types.OracleJail
+
+	q.IterateOracleJails(ctx, func(oracleJail types.OracleJail) bool {
+		oracleJails = append(oracleJails, oracleJail)
+		return false
+	})
+
+	return &types.QueryOracleJailsResponse{OracleJails: oracleJails}, nil
+}
+
 // AggregatePrevote queries an aggregate prevote of a validator.
 func (q querier) AggregatePrevote(
 	goCtx context.Context,
@@ -266,3 +665,19 @@ func (q querier) QueryRewardPoolBalance(
 		RemainingFunds: balance,
 	}, nil
 }
+
+// RewardProjection queries the projected reward payout per vote period and the
+// remaining runway of the reward schedules.
+func (q querier) RewardProjection(
+	goCtx context.Context,
+	req *types.QueryRewardProjectionRequest,
+) (*types.QueryRewardProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := q.GetRewardProjection(ctx)
+
+	return &res, nil
+}
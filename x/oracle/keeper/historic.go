@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// SetHistoricExchangeRate stores a tallied exchange rate in the historic store,
+// keyed by its denom and block height.
+func (k Keeper) SetHistoricExchangeRate(ctx sdk.Context, historicRate types.HistoricExchangeRate) {
+	store := ctx.KVStore(k.storeKey)
+	historicRate.Denom = strings.ToUpper(historicRate.Denom)
+
+	bz := k.cdc.MustMarshal(&historicRate)
+	store.Set(types.GetHistoricExchangeRateKey(historicRate.Denom, historicRate.BlockHeight), bz)
+}
+
+// IterateHistoricExchangeRates iterates over the historic rates of a denom with
+// a block height within [startHeight, endHeight], in ascending height order.
+// A zero endHeight leaves the range unbounded.
+func (k Keeper) IterateHistoricExchangeRates(
+	ctx sdk.Context,
+	denom string,
+	startHeight, endHeight uint64,
+	handler func(types.HistoricExchangeRate) bool,
+) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetHistoricExchangeRatePrefix(strings.ToUpper(denom)),
+	)
+
+	var end []byte
+	if endHeight != 0 {
+		end = sdk.Uint64ToBigEndian(endHeight + 1)
+	}
+
+	iter := store.Iterator(sdk.Uint64ToBigEndian(startHeight), end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var historicRate types.HistoricExchangeRate
+
+		k.cdc.MustUnmarshal(iter.Value(), &historicRate)
+
+		if handler(historicRate) {
+			break
+		}
+	}
+}
+
+// IterateAllHistoricExchangeRates iterates over the historic rates of all
+// denoms in the store.
+func (k Keeper) IterateAllHistoricExchangeRates(ctx sdk.Context, handler func(types.HistoricExchangeRate) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixHistoricExchangeRate)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var historicRate types.HistoricExchangeRate
+
+		k.cdc.MustUnmarshal(iter.Value(), &historicRate)
+
+		if handler(historicRate) {
+			break
+		}
+	}
+}
+
+// DeleteHistoricExchangeRates deletes all the historic rates of a denom.
+func (k Keeper) DeleteHistoricExchangeRates(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetHistoricExchangeRatePrefix(strings.ToUpper(denom)))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// PruneHistoricExchangeRates deletes the historic rates of the denoms of the
+// accept list that are older than the retention, counted in blocks from the
+// current height. Rates of denoms removed from the accept list are deleted on
+// removal.
+func (k Keeper) PruneHistoricExchangeRates(ctx sdk.Context, retention uint64) {
+	height := uint64(ctx.BlockHeight())
+	if height <= retention {
+		return
+	}
+
+	cutoff := height - retention
+
+	for _, denom := range k.GetAcceptList(ctx) {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.GetHistoricExchangeRatePrefix(strings.ToUpper(denom.SymbolDenom)),
+		)
+
+		// the keys are big endian heights, so the stale rates come first
+		iter := store.Iterator(nil, sdk.Uint64ToBigEndian(cutoff))
+
+		var staleKeys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			staleKeys = append(staleKeys, iter.Key())
+		}
+
+		iter.Close()
+
+		for _, key := range staleKeys {
+			store.Delete(key)
+		}
+	}
+}
+
+// GetTWAP returns the time-weighted average of the historic rates of a denom
+// over the window ending at the current block time. Each rate is weighted by
+// the time it remained the latest tallied rate within the window.
+func (k Keeper) GetTWAP(ctx sdk.Context, denom string, window time.Duration) (sdk.Dec, error) {
+	if window <= 0 {
+		return sdk.ZeroDec(), errors.Wrapf(types.ErrNoHistoricRate, "invalid window %s", window)
+	}
+
+	var (
+		now         = ctx.BlockTime()
+		windowStart = now.Add(-window)
+		weightedSum = sdk.ZeroDec()
+		totalWeight = int64(0)
+		last        *types.HistoricExchangeRate
+	)
+
+	// weight adds the rate of the last snapshot for the time it was in effect
+	// within the window, up until the given time.
+	weight := func(until time.Time) {
+		from := last.BlockTime
+		if from.Before(windowStart) {
+			from = windowStart
+		}
+
+		if elapsed := until.Sub(from).Milliseconds(); elapsed > 0 {
+			weightedSum = weightedSum.Add(last.ExchangeRate.MulInt64(elapsed))
+			totalWeight += elapsed
+		}
+	}
+
+	// walk back from the latest rate to the last rate tallied before the
+	// window, instead of over the whole history of the denom
+	var windowRates []types.HistoricExchangeRate
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetHistoricExchangeRatePrefix(strings.ToUpper(denom)),
+	)
+
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var historicRate types.HistoricExchangeRate
+
+		k.cdc.MustUnmarshal(iter.Value(), &historicRate)
+
+		if historicRate.BlockTime.After(now) {
+			continue
+		}
+
+		windowRates = append(windowRates, historicRate)
+
+		if !historicRate.BlockTime.After(windowStart) {
+			break
+		}
+	}
+
+	for i := len(windowRates) - 1; i >= 0; i-- {
+		if last != nil {
+			weight(windowRates[i].BlockTime)
+		}
+
+		last = &windowRates[i]
+	}
+
+	if last == nil {
+		return sdk.ZeroDec(), errors.Wrap(types.ErrNoHistoricRate, denom)
+	}
+
+	weight(now)
+
+	// the only rate in the window was tallied in the current block
+	if totalWeight == 0 {
+		return last.ExchangeRate, nil
+	}
+
+	return weightedSum.QuoInt64(totalWeight), nil
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestIterateHistoricExchangeRates() {
+	app, ctx := s.app, s.ctx
+
+	for height := uint64(1); height <= 5; height++ {
+		app.OracleKeeper.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(
+			types.AtomSymbol, sdk.NewDec(int64(height)), height, ctx.BlockTime(),
+		))
+	}
+
+	app.OracleKeeper.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(
+		types.PersistenceSymbol, sdk.OneDec(), 3, ctx.BlockTime(),
+	))
+
+	var heights []uint64
+
+	app.OracleKeeper.IterateHistoricExchangeRates(ctx, "atom", 2, 4, func(historicRate types.HistoricExchangeRate) bool {
+		heights = append(heights, historicRate.BlockHeight)
+		return false
+	})
+	s.Require().Equal([]uint64{2, 3, 4}, heights)
+
+	heights = nil
+
+	app.OracleKeeper.IterateHistoricExchangeRates(ctx, types.AtomSymbol, 3, 0, func(historicRate types.HistoricExchangeRate) bool {
+		heights = append(heights, historicRate.BlockHeight)
+		return false
+	})
+	s.Require().Equal([]uint64{3, 4, 5}, heights)
+}
+
+func (s *KeeperTestSuite) TestPruneHistoricExchangeRates() {
+	app, ctx := s.app, s.ctx
+
+	for _, height := range []uint64{initialHeight - 20, initialHeight - 10, initialHeight - 5} {
+		app.OracleKeeper.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(
+			types.AtomSymbol, sdk.OneDec(), height, ctx.BlockTime(),
+		))
+	}
+
+	app.OracleKeeper.PruneHistoricExchangeRates(ctx, 10)
+
+	var heights []uint64
+
+	app.OracleKeeper.IterateAllHistoricExchangeRates(ctx, func(historicRate types.HistoricExchangeRate) bool {
+		heights = append(heights, historicRate.BlockHeight)
+		return false
+	})
+	s.Require().Equal([]uint64{initialHeight - 10, initialHeight - 5}, heights)
+}
+
+func (s *KeeperTestSuite) TestQueryHistoricExchangeRatesPagination() {
+	app, ctx := s.app, s.ctx
+
+	for height := uint64(1); height <= 5; height++ {
+		app.OracleKeeper.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(
+			types.AtomSymbol, sdk.NewDec(int64(height)), height, ctx.BlockTime(),
+		))
+	}
+
+	resp, err := s.queryClient.HistoricExchangeRates(ctx.Context(), &types.QueryHistoricExchangeRatesRequest{
+		Denom:       types.AtomSymbol,
+		StartHeight: 2,
+		EndHeight:   4,
+		Pagination:  &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.HistoricExchangeRates, 2)
+	s.Require().Equal(uint64(2), resp.HistoricExchangeRates[0].BlockHeight)
+	s.Require().NotNil(resp.Pagination.NextKey)
+
+	resp, err = s.queryClient.HistoricExchangeRates(ctx.Context(), &types.QueryHistoricExchangeRatesRequest{
+		Denom:       types.AtomSymbol,
+		StartHeight: 2,
+		EndHeight:   4,
+		Pagination:  &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.HistoricExchangeRates, 1)
+	s.Require().Equal(uint64(4), resp.HistoricExchangeRates[0].BlockHeight)
+}
+
+func (s *KeeperTestSuite) TestSetExchangeRateWithEventRecordsHistory() {
+	app, ctx := s.app, s.ctx
+
+	app.OracleKeeper.SetExchangeRateWithEvent(ctx, types.AtomSymbol, sdk.OneDec())
+
+	resp, err := s.queryClient.HistoricExchangeRates(ctx.Context(), &types.QueryHistoricExchangeRatesRequest{
+		Denom: types.AtomSymbol,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.HistoricExchangeRates, 1)
+	s.Require().Equal(uint64(initialHeight), resp.HistoricExchangeRates[0].BlockHeight)
+	s.Require().Equal(sdk.OneDec(), resp.HistoricExchangeRates[0].ExchangeRate)
+
+	// disabling the history stops recording new rates
+	params := app.OracleKeeper.GetParams(ctx)
+	params.HistoryRetention = 0
+	app.OracleKeeper.SetParams(ctx, params)
+
+	app.OracleKeeper.SetExchangeRateWithEvent(ctx.WithBlockHeight(initialHeight+1), types.AtomSymbol, sdk.OneDec())
+
+	resp, err = s.queryClient.HistoricExchangeRates(ctx.Context(), &types.QueryHistoricExchangeRatesRequest{
+		Denom: types.AtomSymbol,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.HistoricExchangeRates, 1)
+}
+
+func (s *KeeperTestSuite) TestGetTWAP() {
+	app, ctx := s.app, s.ctx
+	now := ctx.BlockTime()
+
+	_, err := app.OracleKeeper.GetTWAP(ctx, types.AtomSymbol, time.Hour)
+	s.Require().ErrorIs(err, types.ErrNoHistoricRate)
+
+	// rate of 1 for the first half of the window, 3 for the second half
+	app.OracleKeeper.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(
+		types.AtomSymbol, sdk.NewDec(5), initialHeight-30, now.Add(-2*time.Hour),
+	))
+	app.OracleKeeper.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(
+		types.AtomSymbol, sdk.OneDec(), initialHeight-20, now.Add(-time.Hour),
+	))
+	app.OracleKeeper.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(
+		types.AtomSymbol, sdk.NewDec(3), initialHeight-10, now.Add(-30*time.Minute),
+	))
+
+	twap, err := app.OracleKeeper.GetTWAP(ctx, types.AtomSymbol, time.Hour)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(2), twap)
+
+	// the window start falls within the first rate's lifetime
+	twap, err = app.OracleKeeper.GetTWAP(ctx, types.AtomSymbol, 90*time.Minute)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(3), twap)
+
+	resp, err := s.queryClient.TWAP(ctx.Context(), &types.QueryTWAPRequest{
+		Denom:         types.AtomSymbol,
+		WindowSeconds: 3600,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(2), resp.Twap)
+
+	_, err = s.queryClient.TWAP(ctx.Context(), &types.QueryTWAPRequest{Denom: types.AtomSymbol})
+	s.Require().Error(err)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AfterExchangeRateUpdated calls the hooks after a tallied exchange rate is stored.
+func (k Keeper) AfterExchangeRateUpdated(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	if k.hooks == nil {
+		return
+	}
+
+	// Error is not handled as oracle hooks use utils.ApplyFuncIfNoError()
+	_ = k.hooks.AfterExchangeRateUpdated(ctx, denom, exchangeRate)
+}
+
+// AfterBallotDropped calls the hooks after the ballot of a denom is dropped.
+func (k Keeper) AfterBallotDropped(ctx sdk.Context, denom string) {
+	if k.hooks == nil {
+		return
+	}
+
+	// Error is not handled as oracle hooks use utils.ApplyFuncIfNoError()
+	_ = k.hooks.AfterBallotDropped(ctx, denom)
+}
+
+// AfterValidatorMissed calls the hooks after a validator missed a vote period.
+func (k Keeper) AfterValidatorMissed(ctx sdk.Context, operator sdk.ValAddress, missCounter uint64) {
+	if k.hooks == nil {
+		return
+	}
+
+	// Error is not handled as oracle hooks use utils.ApplyFuncIfNoError()
+	_ = k.hooks.AfterValidatorMissed(ctx, operator, missCounter)
+}
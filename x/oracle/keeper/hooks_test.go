@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/keeper"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/testutil"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// recordingOracleHook records the calls of the oracle hooks, and panics on
+// each of them if shouldPanic is set.
+type recordingOracleHook struct {
+	shouldPanic bool
+
+	updatedRates  map[string]sdk.Dec
+	droppedDenoms []string
+	missCounters  map[string]uint64
+}
+
+var _ types.OracleHooks = &recordingOracleHook{}
+
+func newRecordingOracleHook(shouldPanic bool) *recordingOracleHook {
+	return &recordingOracleHook{
+		shouldPanic:  shouldPanic,
+		updatedRates: map[string]sdk.Dec{},
+		missCounters: map[string]uint64{},
+	}
+}
+
+func (h *recordingOracleHook) AfterExchangeRateUpdated(_ sdk.Context, denom string, exchangeRate sdk.Dec) error {
+	if h.shouldPanic {
+		panic("recordingOracleHook is panicking")
+	}
+
+	h.updatedRates[denom] = exchangeRate
+
+	return nil
+}
+
+func (h *recordingOracleHook) AfterBallotDropped(_ sdk.Context, denom string) error {
+	if h.shouldPanic {
+		panic("recordingOracleHook is panicking")
+	}
+
+	h.droppedDenoms = append(h.droppedDenoms, denom)
+
+	return nil
+}
+
+func (h *recordingOracleHook) AfterValidatorMissed(_ sdk.Context, operator sdk.ValAddress, missCounter uint64) error {
+	if h.shouldPanic {
+		panic("recordingOracleHook is panicking")
+	}
+
+	h.missCounters[operator.String()] = missCounter
+
+	return nil
+}
+
+func (s *KeeperTestSuite) TestOracleHooks() {
+	// custom app and context for this test
+	app, ctx := s.initAppAndContext()
+
+	_, valAddresses, err := testutil.StakingAddValidators(
+		app.BankKeeper,
+		app.StakingKeeper,
+		ctx,
+		4,
+	)
+	s.Require().NoError(err)
+
+	panicHook, hook := newRecordingOracleHook(true), newRecordingOracleHook(false)
+
+	oracleKeeper := keeper.NewKeeper(
+		app.AppCodec(),
+		app.GetKey(types.StoreKey),
+		app.GetSubspace(types.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.StakingKeeper,
+		app.SlashingKeeper,
+		authtypes.FeeCollectorName,
+		distrtypes.ModuleName,
+		app.OracleKeeper.GetAuthority(),
+	)
+	oracleKeeper.SetHooks(types.NewMultiOracleHooks(panicHook, hook))
+
+	params := types.DefaultParams()
+	params.VotePeriod = 1
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6},
+		{BaseDenom: types.PersistenceDenom, SymbolDenom: types.PersistenceSymbol, Exponent: 6},
+	}
+	oracleKeeper.SetParams(ctx, params)
+
+	// three validators vote on ATOM, a single one on XPRT
+	for valN := 0; valN < 3; valN++ {
+		tuples := types.ExchangeRateTuples{types.NewExchangeRateTuple(types.AtomSymbol, sdk.NewDec(10))}
+		if valN == 0 {
+			tuples = append(tuples, types.NewExchangeRateTuple(types.PersistenceSymbol, sdk.OneDec()))
+		}
+
+		oracleKeeper.SetAggregateExchangeRateVote(ctx, valAddresses[valN], types.NewAggregateExchangeRateVote(
+			tuples,
+			valAddresses[valN],
+		))
+	}
+
+	s.Require().NotPanics(func() {
+		s.Require().NoError(oracleKeeper.BuildClaimsMapAndTally(ctx, params))
+	})
+
+	s.Require().Equal(map[string]sdk.Dec{types.AtomSymbol: sdk.NewDec(10)}, hook.updatedRates)
+	s.Require().Equal([]string{types.PersistenceSymbol}, hook.droppedDenoms)
+
+	// with the XPRT ballot dropped, every bonded validator missed the period
+	s.Require().Len(hook.missCounters, len(app.StakingKeeper.GetBondedValidatorsByPower(ctx)))
+	s.Require().Equal(uint64(1), hook.missCounters[valAddresses[3].String()])
+
+	// the panicking hook never got to record anything
+	s.Require().Empty(panicHook.updatedRates)
+	s.Require().Empty(panicHook.droppedDenoms)
+	s.Require().Empty(panicHook.missCounters)
+}
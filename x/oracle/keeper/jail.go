@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetOracleJail returns the oracle jail record of a validator, if it was
+// jailed for missing oracle votes and has not unjailed since.
+func (k Keeper) GetOracleJail(ctx sdk.Context, operator sdk.ValAddress) (types.OracleJail, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetOracleJailKey(operator))
+	if bz == nil {
+		return types.OracleJail{}, false
+	}
+
+	var oracleJail types.OracleJail
+	k.cdc.MustUnmarshal(bz, &oracleJail)
+
+	return oracleJail, true
+}
+
+// SetOracleJail stores the oracle jail record of a validator.
+func (k Keeper) SetOracleJail(ctx sdk.Context, oracleJail types.OracleJail) {
+	operator, err := sdk.ValAddressFromBech32(oracleJail.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&oracleJail)
+	store.Set(types.GetOracleJailKey(operator), bz)
+}
+
+// DeleteOracleJail deletes the oracle jail record of a validator.
+func (k Keeper) DeleteOracleJail(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOracleJailKey(operator))
+}
+
+// IterateOracleJails iterates over the oracle jail records of all validators.
+func (k Keeper) IterateOracleJails(ctx sdk.Context, handler func(types.OracleJail) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixOracleJail)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var oracleJail types.OracleJail
+
+		k.cdc.MustUnmarshal(iter.Value(), &oracleJail)
+
+		if handler(oracleJail) {
+			break
+		}
+	}
+}
+
+// BeforeValidatorUnjailed implements the unjail guard of the slashing module.
+// A validator jailed for missing oracle votes can not unjail before the end of
+// its oracle jail, after which its record is cleared.
+func (k Keeper) BeforeValidatorUnjailed(ctx sdk.Context, operator sdk.ValAddress) error {
+	oracleJail, found := k.GetOracleJail(ctx, operator)
+	if !found {
+		return nil
+	}
+
+	if ctx.BlockTime().Before(oracleJail.JailedUntil) {
+		return errors.Wrapf(types.ErrOracleJailed, "%s jailed until %s", operator, oracleJail.JailedUntil)
+	}
+
+	k.DeleteOracleJail(ctx, operator)
+
+	return nil
+}
@@ -27,7 +27,17 @@ type Keeper struct {
 	distrKeeper   types.DistributionKeeper
 	StakingKeeper types.StakingKeeper
 
-	recipientModule string
+	slashingKeeper types.SlashingKeeper
+	icqKeeper      types.InterchainQueryKeeper
+
+	feeCollectorName string
+	recipientModule  string
+
+	// authority is the address allowed to manage the accept list, usually the
+	// gov module account.
+	authority string
+
+	hooks types.OracleHooks
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -39,7 +49,10 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistributionKeeper,
 	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+	feeCollectorName string,
 	recipientModule string,
+	authority string,
 ) Keeper {
 	// ensure oracle module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -52,15 +65,46 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:             cdc,
-		storeKey:        storeKey,
-		paramSpace:      paramSpace,
-		accountKeeper:   accountKeeper,
-		bankKeeper:      bankKeeper,
-		distrKeeper:     distrKeeper,
-		StakingKeeper:   stakingKeeper,
-		recipientModule: recipientModule,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		paramSpace:       paramSpace,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		distrKeeper:      distrKeeper,
+		StakingKeeper:    stakingKeeper,
+		slashingKeeper:   slashingKeeper,
+		feeCollectorName: feeCollectorName,
+		recipientModule:  recipientModule,
+		authority:        authority,
+	}
+}
+
+// SetHooks sets the oracle hooks.
+func (k *Keeper) SetHooks(oh types.OracleHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set oracle hooks twice")
+	}
+
+	k.hooks = oh
+
+	return k
+}
+
+// SetInterchainQueryKeeper sets the keeper of the interchain queries the
+// remote prices are read with. Remote prices are disabled if it is not set.
+func (k *Keeper) SetInterchainQueryKeeper(icqKeeper types.InterchainQueryKeeper) *Keeper {
+	if k.icqKeeper != nil {
+		panic("cannot set interchain query keeper twice")
 	}
+
+	k.icqKeeper = icqKeeper
+
+	return k
+}
+
+// GetAuthority returns the address allowed to manage the accept list.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // Logger returns a module-specific logger.
@@ -69,8 +113,36 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 // GetExchangeRate gets the consensus exchange rate of USD denominated in the
-// denom asset from the store.
+// denom asset from the store. It fails if the rate was last tallied more than
+// MaxStaleness blocks ago, with ErrHaltedRate if the denom is halted by the
+// circuit breaker. The callers accepting such a rate opt in to it with
+// GetLastExchangeRate.
 func (k Keeper) GetExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	exchangeRate, err := k.GetLastExchangeRate(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// rates set without a tally, e.g. at genesis, have no metadata and never
+	// expire
+	metadata, found := k.GetExchangeRateMetadata(ctx, denom)
+	if found && metadata.IsExpired(uint64(ctx.BlockHeight()), k.GetMaxStaleness(ctx)) {
+		err := types.ErrExpiredRate
+		if k.IsHaltedDenom(ctx, denom) {
+			err = types.ErrHaltedRate
+		}
+
+		return sdk.ZeroDec(), errors.Wrapf(
+			err, "%s last updated at height %d", strings.ToUpper(denom), metadata.LastUpdateHeight,
+		)
+	}
+
+	return exchangeRate, nil
+}
+
+// GetLastExchangeRate gets the last consensus exchange rate of USD denominated
+// in the denom asset from the store, regardless of its staleness.
+func (k Keeper) GetLastExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
 	store := ctx.KVStore(k.storeKey)
 	denom = strings.ToUpper(denom)
 
@@ -95,9 +167,20 @@ func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.
 }
 
 // SetExchangeRateWithEvent sets an consensus
-// exchange rate to the store with ABCI event
+// exchange rate to the store with ABCI event and records it in the historic
+// store if the history is enabled.
 func (k Keeper) SetExchangeRateWithEvent(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
 	k.SetExchangeRate(ctx, denom, exchangeRate)
+
+	if k.GetHistoryRetention(ctx) > 0 {
+		k.SetHistoricExchangeRate(ctx, types.NewHistoricExchangeRate(
+			denom,
+			exchangeRate,
+			uint64(ctx.BlockHeight()),
+			ctx.BlockTime(),
+		))
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeExchangeRateUpdate,
 			sdk.NewAttribute(types.EventAttrKeyDenom, denom),
@@ -106,6 +189,14 @@ func (k Keeper) SetExchangeRateWithEvent(ctx sdk.Context, denom string, exchange
 	)
 }
 
+// DeleteExchangeRate deletes the consensus exchange rate of a denom from the
+// store.
+func (k Keeper) DeleteExchangeRate(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	denom = strings.ToUpper(denom)
+	store.Delete(types.GetExchangeRateKey(denom))
+}
+
 // ClearExchangeRates clears all exchange rates from the store.
 func (k Keeper) ClearExchangeRates(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
@@ -368,16 +459,29 @@ func (k Keeper) IterateAggregateExchangeRateVotes(
 }
 
 // ValidateFeeder returns the given feeder is allowed to feed the message or not.
-func (k Keeper) ValidateFeeder(ctx sdk.Context, valAddr sdk.ValAddress, feederAddr sdk.AccAddress) error {
+// Besides the feeder delegation, any active registered feeder of the validator
+// is allowed, as long as its scope covers the given denoms.
+func (k Keeper) ValidateFeeder(ctx sdk.Context, valAddr sdk.ValAddress, feederAddr sdk.AccAddress, denoms ...string) error {
 	delegate, err := k.GetFeederDelegation(ctx, valAddr)
 	if err != nil {
 		return err
 	}
 
-	if !delegate.Equals(feederAddr) {
+	if delegate.Equals(feederAddr) {
+		return nil
+	}
+
+	feeder, found := k.GetFeeder(ctx, valAddr, feederAddr)
+	if !found || !feeder.IsActive(uint64(ctx.BlockHeight())) {
 		return errors.Wrap(types.ErrNoVotingPermission, feederAddr.String())
 	}
 
+	for _, denom := range denoms {
+		if !feeder.CoversDenom(denom) {
+			return errors.Wrapf(types.ErrNoVotingPermission, "%s can not vote on %s", feederAddr, denom)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetExchangeRateMetadata returns the metadata of the last successful tally of
+// a denom, if any.
+func (k Keeper) GetExchangeRateMetadata(ctx sdk.Context, denom string) (types.ExchangeRateMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetExchangeRateMetadataKey(strings.ToUpper(denom)))
+	if bz == nil {
+		return types.ExchangeRateMetadata{}, false
+	}
+
+	var metadata types.ExchangeRateMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+
+	return metadata, true
+}
+
+// SetExchangeRateMetadata stores the metadata of the last successful tally of
+// a denom.
+func (k Keeper) SetExchangeRateMetadata(ctx sdk.Context, metadata types.ExchangeRateMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	metadata.Denom = strings.ToUpper(metadata.Denom)
+
+	bz := k.cdc.MustMarshal(&metadata)
+	store.Set(types.GetExchangeRateMetadataKey(metadata.Denom), bz)
+}
+
+// DeleteExchangeRateMetadata deletes the metadata of a denom.
+func (k Keeper) DeleteExchangeRateMetadata(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetExchangeRateMetadataKey(strings.ToUpper(denom)))
+}
+
+// IterateExchangeRateMetadata iterates over the metadata of all denoms.
+func (k Keeper) IterateExchangeRateMetadata(ctx sdk.Context, handler func(types.ExchangeRateMetadata) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixExchangeRateMetadata)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var metadata types.ExchangeRateMetadata
+
+		k.cdc.MustUnmarshal(iter.Value(), &metadata)
+
+		if handler(metadata) {
+			break
+		}
+	}
+}
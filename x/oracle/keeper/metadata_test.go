@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestExchangeRateExpiry() {
+	app, ctx := s.app, s.ctx
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.MaxStaleness = 20
+	app.OracleKeeper.SetParams(ctx, params)
+
+	app.OracleKeeper.SetExchangeRate(ctx, types.AtomSymbol, sdk.OneDec())
+	app.OracleKeeper.SetExchangeRateMetadata(ctx, types.NewExchangeRateMetadata(
+		types.AtomSymbol, initialHeight, ctx.BlockTime(), 2, sdk.OneDec(),
+	))
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx.WithBlockHeight(initialHeight+20), types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	expiredCtx := ctx.WithBlockHeight(initialHeight + 21)
+
+	_, err = app.OracleKeeper.GetExchangeRate(expiredCtx, types.AtomSymbol)
+	s.Require().ErrorIs(err, types.ErrExpiredRate)
+
+	rate, err = app.OracleKeeper.GetLastExchangeRate(expiredCtx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	// disabling the expiry makes the rate valid again
+	params.MaxStaleness = 0
+	app.OracleKeeper.SetParams(ctx, params)
+
+	_, err = app.OracleKeeper.GetExchangeRate(expiredCtx, types.AtomSymbol)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestQueryExchangeRateMetadata() {
+	app, ctx := s.app, s.ctx
+
+	_, err := s.queryClient.ExchangeRateMetadata(ctx.Context(), &types.QueryExchangeRateMetadataRequest{
+		Denom: types.AtomSymbol,
+	})
+	s.Require().Error(err)
+
+	app.OracleKeeper.SetExchangeRate(ctx, types.AtomSymbol, sdk.OneDec())
+	app.OracleKeeper.SetExchangeRateMetadata(ctx, types.NewExchangeRateMetadata(
+		types.AtomSymbol, 1, ctx.BlockTime(), 2, sdk.OneDec(),
+	))
+
+	resp, err := s.queryClient.ExchangeRateMetadata(ctx.Context(), &types.QueryExchangeRateMetadataRequest{
+		Denom: "atom",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), resp.ExchangeRate)
+	s.Require().Equal(uint64(1), resp.Metadata.LastUpdateHeight)
+	s.Require().Equal(uint64(2), resp.Metadata.VoterCount)
+	s.Require().False(resp.Expired)
+
+	allResp, err := s.queryClient.AllExchangeRateMetadata(ctx.Context(), &types.QueryAllExchangeRateMetadataRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(allResp.ExchangeRateMetadata, 1)
+}
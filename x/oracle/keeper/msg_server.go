@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
@@ -41,8 +44,11 @@ func (ms msgServer) AggregateExchangeRatePrevote(
 		return nil, err
 	}
 
-	// Ensure prevote wasn't already submitted
-	if ms.HasAggregateExchangeRatePrevote(ctx, valAddr) {
+	// Ensure a prevote of a previous vote period isn't left unrevealed, a
+	// prevote of the current vote period can be updated
+	votePeriod := ms.GetVotePeriod(ctx)
+	if prevote, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr); err == nil &&
+		prevote.SubmitBlock/votePeriod != uint64(ctx.BlockHeight())/votePeriod {
 		return nil, types.ErrExistingPrevote
 	}
 
@@ -86,7 +92,17 @@ func (ms msgServer) AggregateExchangeRateVote(
 		return nil, err
 	}
 
-	if err := ms.ValidateFeeder(ctx, valAddr, feederAddr); err != nil {
+	exchangeRateTuples, err := types.ParseExchangeRateTuples(msg.ExchangeRates)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	denoms := make([]string, len(exchangeRateTuples))
+	for i, tuple := range exchangeRateTuples {
+		denoms[i] = tuple.Denom
+	}
+
+	if err := ms.ValidateFeeder(ctx, valAddr, feederAddr, denoms...); err != nil {
 		return nil, err
 	}
 
@@ -102,11 +118,6 @@ func (ms msgServer) AggregateExchangeRateVote(
 		return nil, types.ErrRevealPeriodMissMatch
 	}
 
-	exchangeRateTuples, err := types.ParseExchangeRateTuples(msg.ExchangeRates)
-	if err != nil {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
-	}
-
 	// Verify a exchange rate with aggregate prevote hash
 	hash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddr)
 	if aggregatePrevote.Hash != hash.String() {
@@ -122,6 +133,18 @@ func (ms msgServer) AggregateExchangeRateVote(
 		}
 	}
 
+	// Abstain from the denoms out of the scope of a registered feeder, the
+	// validator does not miss them
+	if delegate, err := ms.GetFeederDelegation(ctx, valAddr); err == nil && !delegate.Equals(feederAddr) {
+		if feeder, found := ms.GetFeeder(ctx, valAddr, feederAddr); found {
+			for _, denom := range params.AcceptList {
+				if !feeder.CoversDenom(denom.SymbolDenom) {
+					filteredTuples = append(filteredTuples, types.NewExchangeRateTuple(denom.SymbolDenom, sdk.ZeroDec()))
+				}
+			}
+		}
+	}
+
 	// Move aggregate prevote to aggregate vote with given exchange rates
 	ms.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(filteredTuples, valAddr))
 	ms.DeleteAggregateExchangeRatePrevote(ctx, valAddr)
@@ -180,6 +203,110 @@ func (ms msgServer) DelegateFeedConsent(
 	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
 
+func (ms msgServer) AddFeeder(
+	goCtx context.Context,
+	msg *types.MsgAddFeeder,
+) (*types.MsgAddFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	val := ms.StakingKeeper.Validator(ctx, operatorAddr)
+	if val == nil {
+		return nil, errors.Wrap(sdkstaking.ErrNoValidatorFound, msg.Operator)
+	}
+
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight <= uint64(ctx.BlockHeight()) {
+		return nil, errors.Wrapf(types.ErrInvalidFeeder, "expiry height %d is not in the future", msg.ExpiryHeight)
+	}
+
+	acceptList := ms.GetAcceptList(ctx)
+	for _, denom := range msg.Denoms {
+		if !acceptList.Contains(denom) {
+			return nil, errors.Wrap(types.ErrUnknownDenom, denom)
+		}
+	}
+
+	// updating a registered feeder does not count towards the limit
+	if _, found := ms.GetFeeder(ctx, operatorAddr, feederAddr); !found {
+		var count int
+
+		ms.IterateFeeders(ctx, operatorAddr, func(types.Feeder) bool {
+			count++
+			return false
+		})
+
+		if count >= types.MaxFeedersPerValidator {
+			return nil, errors.Wrapf(types.ErrInvalidFeeder, "validator already has %d feeders", count)
+		}
+	}
+
+	ms.SetFeeder(ctx, types.NewFeeder(operatorAddr, feederAddr, msg.ExpiryHeight, msg.Denoms))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddFeeder,
+			sdk.NewAttribute(types.EventAttrKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.EventAttrKeyFeeder, msg.Feeder),
+			sdk.NewAttribute(types.EventAttrKeyExpiryHeight, strconv.FormatUint(msg.ExpiryHeight, 10)),
+			sdk.NewAttribute(types.EventAttrKeyDenoms, strings.Join(msg.Denoms, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.EventAttrValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgAddFeederResponse{}, nil
+}
+
+func (ms msgServer) RevokeFeeder(
+	goCtx context.Context,
+	msg *types.MsgRevokeFeeder,
+) (*types.MsgRevokeFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := ms.GetFeeder(ctx, operatorAddr, feederAddr); !found {
+		return nil, errors.Wrap(types.ErrFeederNotFound, msg.Feeder)
+	}
+
+	ms.DeleteFeeder(ctx, operatorAddr, feederAddr)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeder,
+			sdk.NewAttribute(types.EventAttrKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.EventAttrKeyFeeder, msg.Feeder),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.EventAttrValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgRevokeFeederResponse{}, nil
+}
+
 // AddFundsToRewardPool add funds to the reward pool
 func (ms msgServer) AddFundsToRewardPool(
 	goCtx context.Context,
@@ -195,5 +322,114 @@ func (ms msgServer) AddFundsToRewardPool(
 		return nil, err
 	}
 
-	return &types.MsgAddFundsToRewardPoolResponse{}, nil
+	if msg.Schedule == nil {
+		return &types.MsgAddFundsToRewardPoolResponse{}, nil
+	}
+
+	scheduleID, err := ms.Keeper.CreateRewardSchedule(ctx, fromAddr, *msg.Schedule, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddFundsToRewardPoolResponse{ScheduleId: scheduleID}, nil
+}
+
+func (ms msgServer) AddDenom(
+	goCtx context.Context,
+	msg *types.MsgAddDenom,
+) (*types.MsgAddDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.AddAcceptListDenom(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddDenomResponse{}, nil
+}
+
+func (ms msgServer) UpdateDenom(
+	goCtx context.Context,
+	msg *types.MsgUpdateDenom,
+) (*types.MsgUpdateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.UpdateAcceptListDenom(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDenomResponse{}, nil
+}
+
+func (ms msgServer) RemoveDenom(
+	goCtx context.Context,
+	msg *types.MsgRemoveDenom,
+) (*types.MsgRemoveDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.RemoveAcceptListDenom(ctx, msg.SymbolDenom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveDenomResponse{}, nil
+}
+
+func (ms msgServer) ResetCircuitBreaker(
+	goCtx context.Context,
+	msg *types.MsgResetCircuitBreaker,
+) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.ResetCircuitBreaker(ctx, msg.SymbolDenom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+func (ms msgServer) CreateRewardSchedule(
+	goCtx context.Context,
+	msg *types.MsgCreateRewardSchedule,
+) (*types.MsgCreateRewardScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleID, err := ms.Keeper.CreateRewardSchedule(ctx, authority, msg.Schedule, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateRewardScheduleResponse{ScheduleId: scheduleID}, nil
+}
+
+// validateAuthority ensures the message was sent by the keeper authority.
+func (ms msgServer) validateAuthority(authority string) error {
+	if ms.authority != authority {
+		return errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, authority)
+	}
+
+	return nil
 }
@@ -5,9 +5,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
 )
@@ -109,8 +111,9 @@ func (s *KeeperTestSuite) TestMsgServer_AggregateExchangeRateVote() {
 	_, err = s.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), prevoteMsg)
 	s.Require().NoError(err)
 
+	// The prevote can be updated within the vote period
 	_, err = s.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), prevoteMsg)
-	s.Require().EqualError(err, types.ErrExistingPrevote.Error())
+	s.Require().NoError(err)
 
 	// Reveal period mismatch
 	_, err = s.msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx), voteMsg)
@@ -124,6 +127,10 @@ func (s *KeeperTestSuite) TestMsgServer_AggregateExchangeRateVote() {
 			hash, valAddr, initialHeight-votePeriod,
 		))
 
+	// The prevote of the previous vote period must be revealed first
+	_, err = s.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), prevoteMsg)
+	s.Require().EqualError(err, types.ErrExistingPrevote.Error())
+
 	_, err = s.msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx), voteMsg)
 	s.Require().NoError(err)
 
@@ -162,6 +169,26 @@ func (s *KeeperTestSuite) TestMsgServer_AggregateExchangeRateVote() {
 
 	_, err = s.msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx), voteMsgInvalidSalt)
 	s.Require().ErrorContains(err, types.ErrVerificationFailed.Error())
+
+	// A zero exchange rate abstains from the denom
+	ratesStrAbstain := acceptList[0].SymbolDenom + ":0"
+	app.OracleKeeper.SetAggregateExchangeRatePrevote(
+		ctx,
+		valAddr,
+		types.NewAggregateExchangeRatePrevote(
+			types.GetAggregateVoteHash(salt, ratesStrAbstain, valAddr), valAddr, initialHeight-votePeriod,
+		))
+
+	_, err = s.msgServer.AggregateExchangeRateVote(
+		sdk.WrapSDKContext(ctx),
+		types.NewMsgAggregateExchangeRateVote(salt, ratesStrAbstain, addr, valAddr),
+	)
+	s.Require().NoError(err)
+
+	vote, err = app.OracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().Len(vote.ExchangeRateTuples, 1)
+	s.Require().True(vote.ExchangeRateTuples[0].ExchangeRate.IsZero())
 }
 
 func (s *KeeperTestSuite) TestMsgServer_DelegateFeedConsent() {
@@ -175,3 +202,95 @@ func (s *KeeperTestSuite) TestMsgServer_DelegateFeedConsent() {
 	_, err := s.msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateFeedConsent(valAddr, feederAddr))
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestMsgServer_AddDenom() {
+	app, ctx := s.app, s.ctx
+	authority := sdk.MustAccAddressFromBech32(app.OracleKeeper.GetAuthority())
+
+	osmo := types.Denom{BaseDenom: "uosmo", SymbolDenom: "OSMO", Exponent: 6}
+
+	_, err := s.msgServer.AddDenom(sdk.WrapSDKContext(ctx), types.NewMsgAddDenom(s.accAddresses[0], osmo))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.AddDenom(sdk.WrapSDKContext(ctx), types.NewMsgAddDenom(authority, osmo))
+	s.Require().NoError(err)
+	s.Require().True(app.OracleKeeper.GetAcceptList(ctx).Contains("OSMO"))
+
+	_, err = s.msgServer.AddDenom(sdk.WrapSDKContext(ctx), types.NewMsgAddDenom(authority, types.Denom{
+		BaseDenom: "ibc/osmo", SymbolDenom: "osmo", Exponent: 6,
+	}))
+	s.Require().ErrorIs(err, types.ErrExistingDenom)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_UpdateDenom() {
+	app, ctx := s.app, s.ctx
+	authority := sdk.MustAccAddressFromBech32(app.OracleKeeper.GetAuthority())
+
+	atom := types.Denom{BaseDenom: "ibc/atom", SymbolDenom: "atom", Exponent: 8}
+
+	_, err := s.msgServer.UpdateDenom(sdk.WrapSDKContext(ctx), types.NewMsgUpdateDenom(authority, types.Denom{
+		BaseDenom: "uosmo", SymbolDenom: "OSMO", Exponent: 6,
+	}))
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	_, err = s.msgServer.UpdateDenom(sdk.WrapSDKContext(ctx), types.NewMsgUpdateDenom(authority, atom))
+	s.Require().NoError(err)
+
+	denom, found := app.OracleKeeper.GetAcceptList(ctx).Find(types.AtomSymbol)
+	s.Require().True(found)
+	s.Require().Equal(types.Denom{BaseDenom: "ibc/atom", SymbolDenom: types.AtomSymbol, Exponent: 8}, denom)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RemoveDenom() {
+	app, ctx := s.app, s.ctx
+	authority := sdk.MustAccAddressFromBech32(app.OracleKeeper.GetAuthority())
+	valAddr, valAddr2 := s.valAddresses[0], s.valAddresses[1]
+
+	app.OracleKeeper.SetExchangeRateWithEvent(ctx, types.AtomSymbol, sdk.OneDec())
+	app.OracleKeeper.SetExchangeRateWithEvent(ctx, types.PersistenceSymbol, sdk.OneDec())
+	app.OracleKeeper.SetTallyResult(ctx, newTestTallyResult(types.AtomSymbol, 1))
+	app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(
+		types.ExchangeRateTuples{
+			types.NewExchangeRateTuple(types.AtomSymbol, sdk.OneDec()),
+			types.NewExchangeRateTuple(types.PersistenceSymbol, sdk.OneDec()),
+		},
+		valAddr,
+	))
+	app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr2, types.NewAggregateExchangeRateVote(
+		types.ExchangeRateTuples{
+			types.NewExchangeRateTuple(types.AtomSymbol, sdk.OneDec()),
+		},
+		valAddr2,
+	))
+
+	_, err := s.msgServer.RemoveDenom(sdk.WrapSDKContext(ctx), types.NewMsgRemoveDenom(s.accAddresses[0], types.AtomSymbol))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.RemoveDenom(sdk.WrapSDKContext(ctx), types.NewMsgRemoveDenom(authority, "osmo"))
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	_, err = s.msgServer.RemoveDenom(sdk.WrapSDKContext(ctx), types.NewMsgRemoveDenom(authority, "atom"))
+	s.Require().NoError(err)
+	s.Require().False(app.OracleKeeper.GetAcceptList(ctx).Contains(types.AtomSymbol))
+
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.PersistenceSymbol)
+	s.Require().NoError(err)
+
+	_, err = app.OracleKeeper.GetTWAP(ctx, types.AtomSymbol, time.Hour)
+	s.Require().ErrorIs(err, types.ErrNoHistoricRate)
+
+	_, found := app.OracleKeeper.GetLatestTallyResult(ctx, types.AtomSymbol)
+	s.Require().False(found)
+
+	vote, err := app.OracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ExchangeRateTuples{
+		types.NewExchangeRateTuple(types.PersistenceSymbol, sdk.OneDec()),
+	}, vote.ExchangeRateTuples)
+
+	_, err = app.OracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr2)
+	s.Require().ErrorIs(err, types.ErrNoAggregateVote)
+}
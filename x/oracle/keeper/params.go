@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -57,6 +60,54 @@ func (k Keeper) GetMinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// GetHistoryRetention returns the number of blocks tallied rates are kept in
+// the historic store.
+func (k Keeper) GetHistoryRetention(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyHistoryRetention, &res)
+	return
+}
+
+// GetMaxStaleness returns the number of blocks after which a rate expires.
+func (k Keeper) GetMaxStaleness(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxStaleness, &res)
+	return
+}
+
+// GetPerformanceWindows returns the number of slash windows the vote
+// statistics of validators are kept for.
+func (k Keeper) GetPerformanceWindows(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyPerformanceWindows, &res)
+	return
+}
+
+// GetJailDuration returns the time a validator jailed for missing oracle votes
+// can not unjail for.
+func (k Keeper) GetJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyJailDuration, &res)
+	return
+}
+
+// GetRewardDenoms returns the denoms of the reward pool paid out to ballot
+// winners.
+func (k Keeper) GetRewardDenoms(ctx sdk.Context) (res []string) {
+	k.paramSpace.Get(ctx, types.KeyRewardDenoms, &res)
+	return
+}
+
+// GetFeeShare returns the fraction of the collected fees moved into the reward
+// pool every block.
+func (k Keeper) GetFeeShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyFeeShare, &res)
+	return
+}
+
+// GetTallyResultRetention returns the number of vote periods the statistics
+// of the tallies are kept for.
+func (k Keeper) GetTallyResultRetention(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyTallyResultRetention, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -68,6 +119,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// ValidateParams validates the total set of oracle parameters, including the
+// dependencies between parameters that can not be checked one key at a time.
+func (k Keeper) ValidateParams(ctx sdk.Context) error {
+	if err := k.GetParams(ctx).Validate(); err != nil {
+		return errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
 // GetAcceptList returns the denom list that can be activated
 func (k Keeper) GetAcceptList(ctx sdk.Context) (res types.DenomList) {
 	k.paramSpace.Get(ctx, types.KeyAcceptList, &res)
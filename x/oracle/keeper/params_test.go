@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle"
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
 )
 
@@ -19,3 +26,26 @@ func (s *KeeperTestSuite) TestVoteThreshold() {
 	voteThresholdDec := app.OracleKeeper.GetVoteThreshold(ctx)
 	s.Require().Equal(newVoteTreshold, voteThresholdDec)
 }
+
+func (s *KeeperTestSuite) TestParamChangeProposalHandler() {
+	app, ctx := s.app, s.ctx
+	handler := oracle.NewParamChangeProposalHandler(app.OracleKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+
+	votePeriod := app.OracleKeeper.GetVotePeriod(ctx)
+	maxStaleness := app.OracleKeeper.GetMaxStaleness(ctx)
+
+	changeMaxStaleness := func(maxStaleness uint64) govv1beta1.Content {
+		return proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(types.ModuleName, string(types.KeyMaxStaleness), fmt.Sprintf("\"%d\"", maxStaleness)),
+		})
+	}
+
+	// the max staleness is valid on its own, but shorter than the vote period
+	cacheCtx, _ := ctx.CacheContext()
+	err := handler(cacheCtx, changeMaxStaleness(votePeriod-1))
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+	s.Require().Equal(maxStaleness, app.OracleKeeper.GetMaxStaleness(ctx))
+
+	s.Require().NoError(handler(ctx, changeMaxStaleness(votePeriod*2)))
+	s.Require().Equal(votePeriod*2, app.OracleKeeper.GetMaxStaleness(ctx))
+}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetValidatorPerformance returns the vote statistics of a validator in a
+// slash window.
+func (k Keeper) GetValidatorPerformance(
+	ctx sdk.Context,
+	operator sdk.ValAddress,
+	window uint64,
+) (types.ValidatorPerformance, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorPerformanceKey(operator, window))
+	if bz == nil {
+		return types.ValidatorPerformance{}, false
+	}
+
+	var performance types.ValidatorPerformance
+	k.cdc.MustUnmarshal(bz, &performance)
+
+	return performance, true
+}
+
+// SetValidatorPerformance stores the vote statistics of a validator in a
+// slash window.
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, performance types.ValidatorPerformance) {
+	operator, err := sdk.ValAddressFromBech32(performance.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetValidatorPerformanceKey(operator, performance.Window), bz)
+}
+
+// IterateValidatorPerformances iterates over the vote statistics of a
+// validator, in ascending slash window order.
+func (k Keeper) IterateValidatorPerformances(
+	ctx sdk.Context,
+	operator sdk.ValAddress,
+	handler func(types.ValidatorPerformance) bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetValidatorPerformancePrefix(operator))
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+
+		if handler(performance) {
+			break
+		}
+	}
+}
+
+// IterateAllValidatorPerformances iterates over the vote statistics of all
+// validators, grouped by validator.
+func (k Keeper) IterateAllValidatorPerformances(ctx sdk.Context, handler func(types.ValidatorPerformance) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixValidatorPerformance)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+
+		if handler(performance) {
+			break
+		}
+	}
+}
+
+// PruneValidatorPerformances deletes the vote statistics of the slash windows
+// older than the last PerformanceWindows ones, or all of them if the
+// statistics are disabled.
+func (k Keeper) PruneValidatorPerformances(ctx sdk.Context, params types.Params) {
+	currentWindow := k.getSlashWindowIndex(ctx, params)
+
+	var cutoff uint64
+	switch {
+	case params.PerformanceWindows == 0:
+		cutoff = currentWindow + 1
+	case currentWindow+1 > params.PerformanceWindows:
+		cutoff = currentWindow + 1 - params.PerformanceWindows
+	default:
+		return
+	}
+
+	var staleKeys [][]byte
+
+	k.IterateAllValidatorPerformances(ctx, func(performance types.ValidatorPerformance) bool {
+		if performance.Window < cutoff {
+			operator, err := sdk.ValAddressFromBech32(performance.Validator)
+			if err != nil {
+				panic(err)
+			}
+
+			staleKeys = append(staleKeys, types.GetValidatorPerformanceKey(operator, performance.Window))
+		}
+
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+}
+
+// GetValidatorPerformanceSummaries returns the vote statistics of all
+// validators summed over the kept slash windows, ranked by uptime and then by
+// the number of won vote periods.
+func (k Keeper) GetValidatorPerformanceSummaries(ctx sdk.Context) []types.ValidatorPerformanceSummary {
+	windowsByValidator := map[string][]types.ValidatorPerformance{}
+
+	var validators []string
+
+	k.IterateAllValidatorPerformances(ctx, func(performance types.ValidatorPerformance) bool {
+		if _, ok := windowsByValidator[performance.Validator]; !ok {
+			validators = append(validators, performance.Validator)
+		}
+
+		windowsByValidator[performance.Validator] = append(windowsByValidator[performance.Validator], performance)
+
+		return false
+	})
+
+	summaries := make([]types.ValidatorPerformanceSummary, 0, len(validators))
+	for _, validator := range validators {
+		summaries = append(summaries, types.NewValidatorPerformanceSummary(validator, windowsByValidator[validator]))
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if !summaries[i].Uptime.Equal(summaries[j].Uptime) {
+			return summaries[i].Uptime.GT(summaries[j].Uptime)
+		}
+
+		return summaries[i].Won > summaries[j].Won
+	})
+
+	return summaries
+}
+
+// recordValidatorPerformance adds the outcome of a vote period to the vote
+// statistics of a validator in the current slash window.
+func (k Keeper) recordValidatorPerformance(
+	ctx sdk.Context,
+	params types.Params,
+	operator sdk.ValAddress,
+	voted, abstained, won bool,
+) {
+	if params.PerformanceWindows == 0 {
+		return
+	}
+
+	window := k.getSlashWindowIndex(ctx, params)
+
+	performance, found := k.GetValidatorPerformance(ctx, operator, window)
+	if !found {
+		performance = types.NewValidatorPerformance(operator, window)
+	}
+
+	if voted {
+		performance.Voted++
+	}
+
+	if abstained {
+		performance.Abstained++
+	}
+
+	if won {
+		performance.Won++
+	} else {
+		performance.Missed++
+	}
+
+	k.SetValidatorPerformance(ctx, performance)
+}
+
+// warnIfApproachingMinValidPerWindow emits an event when the misses of a
+// validator in the current slash window approach the number allowed by
+// MinValidPerWindow.
+func (k Keeper) warnIfApproachingMinValidPerWindow(
+	ctx sdk.Context,
+	params types.Params,
+	operator sdk.ValAddress,
+	missCounter uint64,
+) {
+	votePeriodsPerWindow := sdk.NewDec(int64(params.SlashWindow)).QuoInt64(int64(params.VotePeriod)).TruncateInt64()
+	maxMisses := types.MaxMissesPerWindow(votePeriodsPerWindow, params.MinValidPerWindow)
+
+	if !types.ApproachesMinValidPerWindow(missCounter, maxMisses) {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeMissWarning,
+			sdk.NewAttribute(types.EventAttrKeyOperator, operator.String()),
+			sdk.NewAttribute(types.EventAttrKeyMissCounter, strconv.FormatUint(missCounter, 10)),
+			sdk.NewAttribute(types.EventAttrKeyMaxMisses, strconv.FormatInt(maxMisses, 10)),
+		),
+	)
+}
+
+// getSlashWindowIndex returns the index of the current slash window.
+func (k Keeper) getSlashWindowIndex(ctx sdk.Context, params types.Params) uint64 {
+	return uint64(ctx.BlockHeight()) / params.SlashWindow
+}
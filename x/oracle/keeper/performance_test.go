@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/testutil"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorPerformance() {
+	// custom app and context for this test
+	app, ctx := s.initAppAndContext()
+
+	_, valAddresses, err := testutil.StakingAddValidators(
+		app.BankKeeper,
+		app.StakingKeeper,
+		ctx,
+		4,
+	)
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 1
+	params.SlashWindow = 10
+	params.MinValidPerWindow = sdk.NewDecWithPrec(50, 2)
+	params.PerformanceWindows = 2
+	params.VoteThreshold = sdk.NewDecWithPrec(34, 2) // the abstaining votes have no power
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6},
+	}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	// valAddresses[0] and valAddresses[3] vote in the band, valAddresses[1]
+	// abstains and valAddresses[2] does not vote
+	tally := func(ctx sdk.Context) {
+		for _, valAddr := range []sdk.ValAddress{valAddresses[0], valAddresses[3]} {
+			app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(
+				types.ExchangeRateTuples{types.NewExchangeRateTuple(types.AtomSymbol, sdk.NewDec(10))},
+				valAddr,
+			))
+		}
+		app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddresses[1], types.NewAggregateExchangeRateVote(
+			types.ExchangeRateTuples{types.NewExchangeRateTuple(types.AtomSymbol, sdk.ZeroDec())},
+			valAddresses[1],
+		))
+
+		s.Require().NoError(app.OracleKeeper.BuildClaimsMapAndTally(ctx, params))
+	}
+
+	for i := int64(0); i < 4; i++ {
+		tally(ctx.WithBlockHeight(initialHeight + i))
+	}
+
+	performance, found := app.OracleKeeper.GetValidatorPerformance(ctx, valAddresses[0], initialHeight/10)
+	s.Require().True(found)
+	s.Require().Equal(uint64(4), performance.Voted)
+	s.Require().Equal(uint64(4), performance.Won)
+	s.Require().Zero(performance.Missed)
+	s.Require().Zero(performance.Abstained)
+
+	// abstaining is not a miss
+	performance, found = app.OracleKeeper.GetValidatorPerformance(ctx, valAddresses[1], initialHeight/10)
+	s.Require().True(found)
+	s.Require().Equal(uint64(4), performance.Voted)
+	s.Require().Equal(uint64(4), performance.Won)
+	s.Require().Equal(uint64(4), performance.Abstained)
+
+	performance, found = app.OracleKeeper.GetValidatorPerformance(ctx, valAddresses[2], initialHeight/10)
+	s.Require().True(found)
+	s.Require().Zero(performance.Voted)
+	s.Require().Equal(uint64(4), performance.Missed)
+
+	// 4 out of the 5 allowed misses reach the warning ratio
+	var warned bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMissWarning &&
+			string(event.Attributes[0].Value) == valAddresses[2].String() &&
+			string(event.Attributes[1].Value) == "4" {
+			warned = true
+		}
+	}
+	s.Require().True(warned)
+
+	// the statistics of the next windows add up, until the first window is pruned
+	nextCtx := ctx.WithBlockHeight(initialHeight + 10)
+	tally(nextCtx)
+
+	findSummary := func(summaries []types.ValidatorPerformanceSummary) types.ValidatorPerformanceSummary {
+		for _, summary := range summaries {
+			if summary.Validator == valAddresses[2].String() {
+				return summary
+			}
+		}
+
+		s.FailNow("no summary of the validator")
+
+		return types.ValidatorPerformanceSummary{}
+	}
+
+	summaries := app.OracleKeeper.GetValidatorPerformanceSummaries(nextCtx)
+	s.Require().Equal(sdk.OneDec(), summaries[0].Uptime)
+	s.Require().True(summaries[len(summaries)-1].Uptime.IsZero())
+
+	summary := findSummary(summaries)
+	s.Require().Equal(uint64(2), summary.Windows)
+	s.Require().Equal(uint64(5), summary.Missed)
+	s.Require().True(summary.Uptime.IsZero())
+
+	lastCtx := ctx.WithBlockHeight(initialHeight + 20)
+	tally(lastCtx)
+	app.OracleKeeper.PruneValidatorPerformances(lastCtx, params)
+
+	summary = findSummary(app.OracleKeeper.GetValidatorPerformanceSummaries(lastCtx))
+	s.Require().Equal(uint64(2), summary.Windows)
+	s.Require().Equal(uint64(2), summary.Missed)
+
+	_, found = app.OracleKeeper.GetValidatorPerformance(ctx, valAddresses[2], initialHeight/10)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestQueryValidatorPerformance() {
+	app, ctx := s.app, s.ctx
+	valAddr := s.valAddresses[0]
+
+	performance := types.NewValidatorPerformance(valAddr, 1)
+	performance.Voted, performance.Won, performance.Missed = 3, 3, 1
+	app.OracleKeeper.SetValidatorPerformance(ctx, performance)
+
+	resp, err := s.queryClient.ValidatorPerformance(ctx.Context(), &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: valAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Windows, 1)
+	s.Require().Equal(sdk.NewDecWithPrec(75, 2), resp.Summary.Uptime)
+
+	allResp, err := s.queryClient.ValidatorPerformances(ctx.Context(), &types.QueryValidatorPerformancesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(allResp.Summaries, 1)
+	s.Require().Equal(valAddr.String(), allResp.Summaries[0].Validator)
+}
@@ -0,0 +1,331 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icqkeeper "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/keeper"
+	icqtypes "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetRemotePrice returns the price of a denom last read from its remote DEX
+// pool.
+func (k Keeper) GetRemotePrice(ctx sdk.Context, denom string) (types.RemotePrice, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetRemotePriceKey(strings.ToUpper(denom)))
+	if bz == nil {
+		return types.RemotePrice{}, false
+	}
+
+	var remotePrice types.RemotePrice
+	k.cdc.MustUnmarshal(bz, &remotePrice)
+
+	return remotePrice, true
+}
+
+// SetRemotePrice stores the price of a denom read from its remote DEX pool.
+func (k Keeper) SetRemotePrice(ctx sdk.Context, remotePrice types.RemotePrice) {
+	store := ctx.KVStore(k.storeKey)
+	remotePrice.Denom = strings.ToUpper(remotePrice.Denom)
+
+	bz := k.cdc.MustMarshal(&remotePrice)
+	store.Set(types.GetRemotePriceKey(remotePrice.Denom), bz)
+}
+
+// DeleteRemotePrice deletes the remote price of a denom.
+func (k Keeper) DeleteRemotePrice(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRemotePriceKey(strings.ToUpper(denom)))
+}
+
+// IterateRemotePrices iterates over the remote prices of all denoms.
+func (k Keeper) IterateRemotePrices(ctx sdk.Context, handler func(types.RemotePrice) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixRemotePrice)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var remotePrice types.RemotePrice
+
+		k.cdc.MustUnmarshal(iter.Value(), &remotePrice)
+
+		if handler(remotePrice) {
+			break
+		}
+	}
+}
+
+// getFreshRemotePrice returns the remote price of a denom if it was received
+// within the maximum age of its source, converted to the asset the exchange
+// rate of the denom is quoted in.
+func (k Keeper) getFreshRemotePrice(ctx sdk.Context, denom types.Denom) (types.RemotePrice, bool) {
+	source := denom.RemotePriceSource
+
+	remotePrice, found := k.GetRemotePrice(ctx, denom.SymbolDenom)
+	if !found || !remotePrice.IsFresh(uint64(ctx.BlockHeight()), source.MaxAge) {
+		return types.RemotePrice{}, false
+	}
+
+	quote := denom.QuoteDenom
+	if quote == "" {
+		quote = types.USDSymbol
+	}
+
+	if strings.EqualFold(source.QuoteSymbolDenom, quote) {
+		return remotePrice, true
+	}
+
+	// without a rate of the pool quote the remote price cannot be checked
+	rate, err := k.GetCrossExchangeRate(ctx, source.QuoteSymbolDenom, quote)
+	if err != nil || !rate.IsPositive() {
+		return types.RemotePrice{}, false
+	}
+
+	remotePrice.Price = remotePrice.Price.Mul(rate)
+
+	return remotePrice, true
+}
+
+// remotePriceQueryIDs returns the ids of the interchain queries reading the
+// base and quote reserves of the pool of a remote price source.
+func remotePriceQueryIDs(source types.RemotePriceSource) (string, string) {
+	return remotePriceQueryID(source, source.BaseDenom), remotePriceQueryID(source, source.QuoteDenom)
+}
+
+// remotePriceQueryID returns the id of the interchain query reading the
+// reserve of the pool of a remote price source in a remote denom.
+func remotePriceQueryID(source types.RemotePriceSource, denom string) string {
+	return icqkeeper.GenerateQueryHash(
+		source.ConnectionId, source.ChainId, types.RemotePriceQueryType, source.ReserveRequest(denom), types.ModuleName,
+	)
+}
+
+// SyncRemotePriceQueries registers periodic interchain queries of the reserves
+// of the pool of every remote price source of the accept list, and removes the
+// queries and remote prices no denom uses anymore.
+func (k Keeper) SyncRemotePriceQueries(ctx sdk.Context, acceptList types.DenomList) {
+	if k.icqKeeper == nil {
+		return
+	}
+
+	used := make(map[string]bool)
+
+	for _, denom := range acceptList {
+		if denom.RemotePriceSource != nil {
+			baseID, quoteID := remotePriceQueryIDs(*denom.RemotePriceSource)
+			used[baseID] = true
+			used[quoteID] = true
+		}
+	}
+
+	registered := make(map[string]bool)
+
+	var stale []string
+
+	k.icqKeeper.IterateQueries(ctx, func(_ int64, query icqtypes.Query) bool {
+		if query.CallbackId != types.RemotePriceCallbackID {
+			return false
+		}
+
+		if used[query.Id] {
+			registered[query.Id] = true
+		} else {
+			stale = append(stale, query.Id)
+		}
+
+		return false
+	})
+
+	for _, id := range stale {
+		k.icqKeeper.DeleteQuery(ctx, id)
+	}
+
+	// register the queries in the order of the accept list
+	for _, denom := range acceptList {
+		source := denom.RemotePriceSource
+		if source == nil {
+			continue
+		}
+
+		for _, reserveDenom := range []string{source.BaseDenom, source.QuoteDenom} {
+			id := remotePriceQueryID(*source, reserveDenom)
+			if registered[id] {
+				continue
+			}
+
+			k.icqKeeper.MakeRequest(
+				ctx,
+				source.ConnectionId,
+				source.ChainId,
+				types.RemotePriceQueryType,
+				source.ReserveRequest(reserveDenom),
+				sdk.NewIntFromUint64(source.QueryPeriod),
+				types.ModuleName,
+				types.RemotePriceCallbackID,
+				source.QueryTtl,
+			)
+			registered[id] = true
+		}
+	}
+
+	var unused []string
+
+	k.IterateRemotePrices(ctx, func(remotePrice types.RemotePrice) bool {
+		if denom, found := acceptList.Find(remotePrice.Denom); !found || denom.RemotePriceSource == nil {
+			unused = append(unused, remotePrice.Denom)
+		}
+
+		return false
+	})
+
+	for _, denom := range unused {
+		k.DeleteRemotePrice(ctx, denom)
+	}
+}
+
+// getReserve returns the reserve last read by an interchain query of a pool,
+// and the remote height it was read at.
+func (k Keeper) getReserve(ctx sdk.Context, id string) (sdk.Int, uint64, error) {
+	dataPoint, err := k.icqKeeper.GetDatapointForID(ctx, id)
+	if err != nil || !dataPoint.RemoteHeight.IsUint64() {
+		return sdk.Int{}, 0, errors.Wrap(types.ErrInvalidRemotePrice, "pool reserve not read")
+	}
+
+	reserve, err := types.UnmarshalReserve(dataPoint.Value)
+	if err != nil {
+		return sdk.Int{}, 0, errors.Wrap(types.ErrInvalidRemotePrice, err.Error())
+	}
+
+	return reserve, dataPoint.RemoteHeight.Uint64(), nil
+}
+
+// RemotePriceCallback stores the prices of the denoms implied by the reserves
+// of their remote DEX pool, once the proven responses to the queries of both
+// reserves were received for the same remote height.
+func RemotePriceCallback(k Keeper, ctx sdk.Context, _ []byte, query icqtypes.Query) error {
+	// only the reserves proven against the remote chain are trusted
+	if !icqtypes.IsProvenQueryType(query.QueryType) {
+		return errors.Wrapf(types.ErrInvalidRemotePrice, "responses to %s queries carry no proof", query.QueryType)
+	}
+
+	for _, denom := range k.GetAcceptList(ctx) {
+		source := denom.RemotePriceSource
+		if source == nil {
+			continue
+		}
+
+		baseID, quoteID := remotePriceQueryIDs(*source)
+		if baseID != query.Id && quoteID != query.Id {
+			continue
+		}
+
+		// the datapoints of the responses hold the reserves and the heights
+		// they were read at
+		base, baseHeight, err := k.getReserve(ctx, baseID)
+		if err != nil {
+			continue
+		}
+
+		quote, remoteHeight, err := k.getReserve(ctx, quoteID)
+		if err != nil || baseHeight != remoteHeight {
+			continue
+		}
+
+		// ignore responses older than the stored price
+		if last, found := k.GetRemotePrice(ctx, denom.SymbolDenom); found && last.RemoteHeight > remoteHeight {
+			continue
+		}
+
+		price, err := source.PriceFromReserves(base, quote)
+		if err != nil {
+			return errors.Wrap(types.ErrInvalidRemotePrice, err.Error())
+		}
+
+		remotePrice := types.NewRemotePrice(denom.SymbolDenom, price, remoteHeight, uint64(ctx.BlockHeight()))
+		k.SetRemotePrice(ctx, remotePrice)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeRemotePrice,
+				sdk.NewAttribute(types.EventAttrKeyDenom, remotePrice.Denom),
+				sdk.NewAttribute(types.EventAttrKeyPrice, price.String()),
+				sdk.NewAttribute(types.EventAttrKeyRemoteHeight, strconv.FormatUint(remoteHeight, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// withinRemotePriceBound returns false if the denom bounds its exchange rate
+// by a fresh remote price and the tallied exchange rate deviates from it by
+// more than the maximum deviation of the source.
+func (k Keeper) withinRemotePriceBound(ctx sdk.Context, params types.Params, denom string, exchangeRate sdk.Dec) bool {
+	d, found := params.AcceptList.Find(denom)
+	source := d.RemotePriceSource
+	if !found || source == nil || source.Policy != types.RemotePricePolicySanityBound || source.MaxDeviation == nil {
+		return true
+	}
+
+	// without a fresh remote price the tallied exchange rate is not bounded
+	remotePrice, found := k.getFreshRemotePrice(ctx, d)
+	if !found || !types.ExceedsDeviation(remotePrice.Price, exchangeRate, *source.MaxDeviation) {
+		return true
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRemotePriceReject,
+			sdk.NewAttribute(types.EventAttrKeyDenom, strings.ToUpper(denom)),
+			sdk.NewAttribute(types.EventAttrKeyPrice, remotePrice.Price.String()),
+			sdk.NewAttribute(types.EventAttrKeyRejectedRate, exchangeRate.String()),
+			sdk.NewAttribute(types.EventAttrKeyMaxDeviation, source.MaxDeviation.String()),
+			sdk.NewAttribute(types.EventAttrKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return false
+}
+
+// applyRemotePriceFallbacks sets the fresh remote price as exchange rate of
+// the denoms with the fallback policy whose ballot did not reach quorum.
+func (k Keeper) applyRemotePriceFallbacks(ctx sdk.Context, params types.Params, quorum map[string]bool) {
+	for _, denom := range params.AcceptList {
+		source := denom.RemotePriceSource
+		if source == nil || source.Policy != types.RemotePricePolicyFallback || quorum[strings.ToUpper(denom.SymbolDenom)] {
+			continue
+		}
+
+		remotePrice, found := k.getFreshRemotePrice(ctx, denom)
+		if !found {
+			continue
+		}
+
+		if !k.applyCircuitBreaker(ctx, params, denom.SymbolDenom, remotePrice.Price) {
+			ctx.Logger().Info("Denom is halted by the circuit breaker, keeping last rate", "denom", denom.SymbolDenom)
+			continue
+		}
+
+		k.SetExchangeRateWithEvent(ctx, denom.SymbolDenom, remotePrice.Price)
+		k.SetExchangeRateMetadata(ctx, types.NewExchangeRateMetadata(
+			denom.SymbolDenom,
+			uint64(ctx.BlockHeight()),
+			ctx.BlockTime(),
+			0,
+			sdk.ZeroDec(),
+		))
+		k.AfterExchangeRateUpdated(ctx, denom.SymbolDenom, remotePrice.Price)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeRemotePriceFallback,
+				sdk.NewAttribute(types.EventAttrKeyDenom, remotePrice.Denom),
+				sdk.NewAttribute(types.EventAttrKeyPrice, remotePrice.Price.String()),
+				sdk.NewAttribute(types.EventAttrKeyRemoteHeight, strconv.FormatUint(remotePrice.RemoteHeight, 10)),
+			),
+		)
+	}
+}
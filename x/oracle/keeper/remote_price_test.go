@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	icqkeeper "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/keeper"
+	icqtypes "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/keeper"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/testutil"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) remotePriceSource(policy string) *types.RemotePriceSource {
+	poolAddress, err := bech32.ConvertAndEncode("osmo", s.accAddresses[0])
+	s.Require().NoError(err)
+
+	maxDeviation := sdk.NewDecWithPrec(1, 1)
+
+	return &types.RemotePriceSource{
+		ConnectionId:     "connection-0",
+		ChainId:          "osmosis-1",
+		PoolAddress:      poolAddress,
+		BaseDenom:        "ibc/atom",
+		QuoteDenom:       "uusdc",
+		BaseExponent:     6,
+		QuoteExponent:    6,
+		Policy:           policy,
+		QueryPeriod:      10,
+		MaxAge:           100,
+		MaxDeviation:     &maxDeviation,
+		QuoteSymbolDenom: types.USDSymbol,
+		QueryTtl:         20,
+	}
+}
+
+func remotePriceQuery(source *types.RemotePriceSource, denom string) icqtypes.Query {
+	return icqtypes.Query{
+		Id: icqkeeper.GenerateQueryHash(
+			source.ConnectionId, source.ChainId, types.RemotePriceQueryType, source.ReserveRequest(denom), types.ModuleName,
+		),
+		QueryType:  types.RemotePriceQueryType,
+		CallbackId: types.RemotePriceCallbackID,
+	}
+}
+
+func (s *KeeperTestSuite) TestRemotePriceCallback() {
+	app, ctx := s.app, s.ctx
+	height := uint64(ctx.BlockHeight())
+	source := s.remotePriceSource(types.RemotePricePolicyFallback)
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.AcceptList = types.DenomList{{
+		BaseDenom:         types.AtomDenom,
+		SymbolDenom:       types.AtomSymbol,
+		Exponent:          6,
+		RemotePriceSource: source,
+	}}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	baseQuery := remotePriceQuery(source, source.BaseDenom)
+	quoteQuery := remotePriceQuery(source, source.QuoteDenom)
+
+	// setReserve stores the proven response to the query of a reserve
+	setReserve := func(query icqtypes.Query, amount int64, remoteHeight int64) {
+		bz, err := sdk.NewInt(amount).Marshal()
+		s.Require().NoError(err)
+
+		err = app.InterchainQueryKeeper.SetDatapointForID(ctx, query.Id, bz, sdk.NewInt(remoteHeight))
+		s.Require().NoError(err)
+	}
+
+	// the price is derived once both reserves are read
+	setReserve(baseQuery, 1_000_000, 500)
+
+	err := keeper.RemotePriceCallback(app.OracleKeeper, ctx, nil, baseQuery)
+	s.Require().NoError(err)
+
+	_, found := app.OracleKeeper.GetRemotePrice(ctx, types.AtomSymbol)
+	s.Require().False(found)
+
+	setReserve(quoteQuery, 12_500_000, 500)
+
+	err = keeper.RemotePriceCallback(app.OracleKeeper, ctx, nil, quoteQuery)
+	s.Require().NoError(err)
+
+	remotePrice, found := app.OracleKeeper.GetRemotePrice(ctx, types.AtomSymbol)
+	s.Require().True(found)
+	s.Require().Equal(types.NewRemotePrice(types.AtomSymbol, sdk.MustNewDecFromStr("12.5"), 500, height), remotePrice)
+
+	// reserves read at different remote heights are not paired
+	setReserve(baseQuery, 1_000_000, 550)
+	setReserve(quoteQuery, 10_000_000, 560)
+
+	err = keeper.RemotePriceCallback(app.OracleKeeper, ctx, nil, quoteQuery)
+	s.Require().NoError(err)
+
+	remotePrice, _ = app.OracleKeeper.GetRemotePrice(ctx, types.AtomSymbol)
+	s.Require().Equal(sdk.MustNewDecFromStr("12.5"), remotePrice.Price)
+
+	// responses read at an older remote height are ignored
+	setReserve(baseQuery, 1_000_000, 400)
+	setReserve(quoteQuery, 10_000_000, 400)
+
+	err = keeper.RemotePriceCallback(app.OracleKeeper, ctx, nil, quoteQuery)
+	s.Require().NoError(err)
+
+	remotePrice, _ = app.OracleKeeper.GetRemotePrice(ctx, types.AtomSymbol)
+	s.Require().Equal(sdk.MustNewDecFromStr("12.5"), remotePrice.Price)
+
+	// the reserves of both denoms are required
+	setReserve(baseQuery, 0, 600)
+	setReserve(quoteQuery, 10_000_000, 600)
+
+	err = keeper.RemotePriceCallback(app.OracleKeeper, ctx, nil, quoteQuery)
+	s.Require().ErrorIs(err, types.ErrInvalidRemotePrice)
+
+	// the responses to unproven queries are rejected
+	unproven := quoteQuery
+	unproven.QueryType = "cosmos.bank.v1beta1.Query/AllBalances"
+
+	err = keeper.RemotePriceCallback(app.OracleKeeper, ctx, nil, unproven)
+	s.Require().ErrorIs(err, types.ErrInvalidRemotePrice)
+}
+
+func (s *KeeperTestSuite) TestSyncRemotePriceQueries() {
+	app, ctx := s.app, s.ctx
+	source := s.remotePriceSource(types.RemotePricePolicyFallback)
+	queries := []icqtypes.Query{remotePriceQuery(source, source.BaseDenom), remotePriceQuery(source, source.QuoteDenom)}
+
+	acceptList := types.DenomList{{
+		BaseDenom:         types.AtomDenom,
+		SymbolDenom:       types.AtomSymbol,
+		Exponent:          6,
+		RemotePriceSource: source,
+	}}
+
+	app.OracleKeeper.SyncRemotePriceQueries(ctx, acceptList)
+
+	// both reserves of the pool are queried
+	for _, query := range queries {
+		registered, found := app.InterchainQueryKeeper.GetQuery(ctx, query.Id)
+		s.Require().True(found)
+		s.Require().Equal(types.RemotePriceQueryType, registered.QueryType)
+		s.Require().Equal(types.RemotePriceCallbackID, registered.CallbackId)
+		s.Require().Equal(sdk.NewInt(10), registered.Period)
+		s.Require().Equal(uint64(20), registered.Ttl)
+	}
+
+	app.OracleKeeper.SetRemotePrice(ctx, types.NewRemotePrice(types.AtomSymbol, sdk.OneDec(), 1, 1))
+
+	// the query and the price are removed with the source
+	acceptList[0].RemotePriceSource = nil
+	app.OracleKeeper.SyncRemotePriceQueries(ctx, acceptList)
+
+	for _, query := range queries {
+		_, found := app.InterchainQueryKeeper.GetQuery(ctx, query.Id)
+		s.Require().False(found)
+	}
+
+	_, found := app.OracleKeeper.GetRemotePrice(ctx, types.AtomSymbol)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyRemotePriceFallback() {
+	app, ctx := s.app, s.ctx
+	height := uint64(ctx.BlockHeight())
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.AcceptList = types.DenomList{{
+		BaseDenom:         types.AtomDenom,
+		SymbolDenom:       types.AtomSymbol,
+		Exponent:          6,
+		RemotePriceSource: s.remotePriceSource(types.RemotePricePolicyFallback),
+	}}
+	app.OracleKeeper.SetParams(ctx, params)
+	app.OracleKeeper.SetExchangeRate(ctx, types.AtomSymbol, sdk.OneDec())
+
+	// a stale remote price is not used
+	app.OracleKeeper.SetRemotePrice(ctx, types.NewRemotePrice(types.AtomSymbol, sdk.NewDec(12), 500, height-101))
+
+	err := app.OracleKeeper.BuildClaimsMapAndTally(ctx, params)
+	s.Require().NoError(err)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	// without any vote the ballot fails to reach quorum
+	app.OracleKeeper.SetRemotePrice(ctx, types.NewRemotePrice(types.AtomSymbol, sdk.NewDec(12), 600, height-100))
+
+	err = app.OracleKeeper.BuildClaimsMapAndTally(ctx, params)
+	s.Require().NoError(err)
+
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(12), rate)
+
+	metadata, found := app.OracleKeeper.GetExchangeRateMetadata(ctx, types.AtomSymbol)
+	s.Require().True(found)
+	s.Require().Equal(height, metadata.LastUpdateHeight)
+	s.Require().Zero(metadata.VoterCount)
+}
+
+func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyRemotePriceSanityBound() {
+	// custom app and context for this test
+	app, ctx := s.initAppAndContext()
+	height := uint64(ctx.BlockHeight())
+
+	_, valAddresses, err := testutil.StakingAddValidators(app.BankKeeper, app.StakingKeeper, ctx, 4)
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 1
+	params.AcceptList = types.DenomList{{
+		BaseDenom:         types.AtomDenom,
+		SymbolDenom:       types.AtomSymbol,
+		Exponent:          6,
+		RemotePriceSource: s.remotePriceSource(types.RemotePricePolicySanityBound),
+	}}
+	app.OracleKeeper.SetParams(ctx, params)
+	app.OracleKeeper.SetExchangeRate(ctx, types.AtomSymbol, sdk.OneDec())
+
+	vote := func() {
+		for _, valAddr := range valAddresses {
+			app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(
+				types.ExchangeRateTuples{{Denom: types.AtomSymbol, ExchangeRate: sdk.NewDec(10)}}, valAddr))
+		}
+	}
+
+	// the tallied rate deviates by more than 10% from the remote price
+	app.OracleKeeper.SetRemotePrice(ctx, types.NewRemotePrice(types.AtomSymbol, sdk.NewDec(12), 500, height))
+	vote()
+
+	err = app.OracleKeeper.BuildClaimsMapAndTally(ctx, params)
+	s.Require().NoError(err)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	// the statistics of the tally are still recorded
+	_, found := app.OracleKeeper.GetLatestTallyResult(ctx, types.AtomSymbol)
+	s.Require().True(found)
+
+	// within the bound the tallied rate is set
+	app.OracleKeeper.SetRemotePrice(ctx, types.NewRemotePrice(types.AtomSymbol, sdk.MustNewDecFromStr("10.5"), 600, height))
+	vote()
+
+	err = app.OracleKeeper.BuildClaimsMapAndTally(ctx, params)
+	s.Require().NoError(err)
+
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(10), rate)
+}
+
+func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyRemotePriceConversion() {
+	app, ctx := s.app, s.ctx
+	height := uint64(ctx.BlockHeight())
+
+	// the pool quotes ATOM in OSMO
+	source := s.remotePriceSource(types.RemotePricePolicyFallback)
+	source.QuoteDenom = "uosmo"
+	source.QuoteSymbolDenom = "OSMO"
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.AcceptList = types.DenomList{
+		{
+			BaseDenom:         types.AtomDenom,
+			SymbolDenom:       types.AtomSymbol,
+			Exponent:          6,
+			RemotePriceSource: source,
+		},
+		{
+			BaseDenom:   "uosmo",
+			SymbolDenom: "OSMO",
+			Exponent:    6,
+		},
+	}
+	app.OracleKeeper.SetParams(ctx, params)
+	app.OracleKeeper.SetExchangeRate(ctx, types.AtomSymbol, sdk.OneDec())
+	app.OracleKeeper.SetRemotePrice(ctx, types.NewRemotePrice(types.AtomSymbol, sdk.NewDec(24), 500, height))
+
+	// without a rate of OSMO the remote price is not used
+	err := app.OracleKeeper.BuildClaimsMapAndTally(ctx, params)
+	s.Require().NoError(err)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	// the remote price is converted from OSMO to USD
+	app.OracleKeeper.SetExchangeRate(ctx, "OSMO", sdk.MustNewDecFromStr("0.5"))
+
+	err = app.OracleKeeper.BuildClaimsMapAndTally(ctx, params)
+	s.Require().NoError(err)
+
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(12), rate)
+}
@@ -8,28 +8,17 @@ import (
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
 )
 
-// prependXPRTIfUnique pushs `uxprt` denom to the front of the list, if it is not yet included.
-func prependXPRTIfUnique(voteTargets []string) []string {
-	if contains(types.PersistenceDenom, voteTargets) {
-		return voteTargets
-	}
-
-	rewardDenoms := make([]string, len(voteTargets)+1)
-	rewardDenoms[0] = types.PersistenceDenom
-	copy(rewardDenoms[1:], voteTargets)
-
-	return rewardDenoms
-}
-
 // RewardBallotWinners is executed at the end of every voting period, where we
 // give out a portion of seigniorage reward(reward-weight) to the oracle voters
-// that voted correctly.
+// that voted correctly. The period reward is the share of the unscheduled
+// balance of the reward denoms for the vote period, plus the amount per period
+// of every active reward schedule.
 // https://classic-docs.terra.money/docs/develop/module-specifications/spec-oracle.html#k-rewardballotwinners
 func (k Keeper) RewardBallotWinners(
 	ctx sdk.Context,
 	votePeriod int64,
 	rewardDistributionWindow int64,
-	voteTargets []string,
+	rewardDenoms []string,
 	ballotWinners []types.Claim,
 ) {
 	// sum weight of the claims
@@ -43,23 +32,29 @@ func (k Keeper) RewardBallotWinners(
 		return
 	}
 
-	distributionRatio := sdk.NewDec(votePeriod).QuoInt64(rewardDistributionWindow)
+	periodRewards := k.unscheduledPeriodRewards(ctx, votePeriod, rewardDistributionWindow, rewardDenoms)
 
-	var periodRewards sdk.DecCoins
+	// the payout of the schedules is deducted in full, the dust left by the
+	// truncation below is released to the unscheduled balance
+	height := uint64(ctx.BlockHeight())
 
-	rewardDenoms := prependXPRTIfUnique(voteTargets)
-	for _, denom := range rewardDenoms {
-		rewardPool := k.GetRewardPool(ctx, denom)
+	var schedules []types.RewardSchedule
 
-		// return if there's no rewards to give out
-		if rewardPool.IsZero() {
-			continue
+	k.IterateRewardSchedules(ctx, func(schedule types.RewardSchedule) bool {
+		if !schedule.IsActive(height) {
+			return false
 		}
 
-		periodRewards = periodRewards.Add(sdk.NewDecCoinFromDec(
-			denom,
-			sdk.NewDecFromInt(rewardPool.Amount).Mul(distributionRatio),
-		))
+		payout := schedule.PeriodPayout()
+		periodRewards = periodRewards.Add(sdk.NewDecCoinsFromCoins(payout...)...)
+		schedule.RemainingFunds = schedule.RemainingFunds.Sub(payout...)
+		schedules = append(schedules, schedule)
+
+		return false
+	})
+
+	for _, schedule := range schedules {
+		k.SetRewardSchedule(ctx, schedule)
 	}
 
 	// distribute rewards
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// GetRewardSchedule returns a reward schedule by its id.
+func (k Keeper) GetRewardSchedule(ctx sdk.Context, id uint64) (types.RewardSchedule, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetRewardScheduleKey(id))
+	if bz == nil {
+		return types.RewardSchedule{}, false
+	}
+
+	var schedule types.RewardSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+
+	return schedule, true
+}
+
+// SetRewardSchedule stores a reward schedule.
+func (k Keeper) SetRewardSchedule(ctx sdk.Context, schedule types.RewardSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&schedule)
+	store.Set(types.GetRewardScheduleKey(schedule.Id), bz)
+}
+
+// DeleteRewardSchedule deletes a reward schedule.
+func (k Keeper) DeleteRewardSchedule(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRewardScheduleKey(id))
+}
+
+// IterateRewardSchedules iterates over the reward schedules in the order of
+// their ids.
+func (k Keeper) IterateRewardSchedules(ctx sdk.Context, handler func(types.RewardSchedule) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixRewardSchedule)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.RewardSchedule
+
+		k.cdc.MustUnmarshal(iter.Value(), &schedule)
+
+		if handler(schedule) {
+			break
+		}
+	}
+}
+
+// GetNextRewardScheduleID returns the id of the next reward schedule.
+func (k Keeper) GetNextRewardScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyNextRewardScheduleID)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextRewardScheduleID stores the id of the next reward schedule.
+func (k Keeper) SetNextRewardScheduleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextRewardScheduleID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetScheduledRewards returns the funds of the reward pool reserved by the
+// reward schedules.
+func (k Keeper) GetScheduledRewards(ctx sdk.Context) sdk.Coins {
+	scheduled := sdk.NewCoins()
+
+	k.IterateRewardSchedules(ctx, func(schedule types.RewardSchedule) bool {
+		scheduled = scheduled.Add(schedule.RemainingFunds...)
+		return false
+	})
+
+	return scheduled
+}
+
+// GetUnscheduledRewardPool returns the funds of the reward pool not reserved
+// by a reward schedule.
+func (k Keeper) GetUnscheduledRewardPool(ctx sdk.Context) sdk.Coins {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balance := k.GetRewardPoolBalance(ctx, moduleAddr)
+	scheduled := k.GetScheduledRewards(ctx)
+
+	unscheduled := sdk.NewCoins()
+	for _, coin := range balance {
+		amount := coin.Amount.Sub(scheduled.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			unscheduled = unscheduled.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return unscheduled
+}
+
+// CreateRewardSchedule reserves funds of the unscheduled balance of the reward
+// pool for a new reward schedule and returns its id. It transfers no funds,
+// the pool must already hold them.
+func (k Keeper) CreateRewardSchedule(
+	ctx sdk.Context,
+	funder sdk.AccAddress,
+	plan types.RewardSchedulePlan,
+	funds sdk.Coins,
+) (uint64, error) {
+	if err := plan.ValidateBasic(funds); err != nil {
+		return 0, err
+	}
+
+	height := uint64(ctx.BlockHeight())
+	if plan.StartHeight == 0 {
+		plan.StartHeight = height
+	}
+
+	if plan.EndHeight < height {
+		return 0, errors.Wrapf(types.ErrInvalidRewardSchedule, "end height %d is in the past", plan.EndHeight)
+	}
+
+	rewardDenoms := k.GetRewardDenoms(ctx)
+	for _, coin := range funds {
+		if !contains(coin.Denom, rewardDenoms) {
+			return 0, errors.Wrapf(types.ErrInvalidRewardSchedule, "%s is not a reward denom", coin.Denom)
+		}
+	}
+
+	if unscheduled := k.GetUnscheduledRewardPool(ctx); !unscheduled.IsAllGTE(funds) {
+		return 0, errors.Wrapf(types.ErrInsufficientRewardPool, "%s is smaller than %s", unscheduled, funds)
+	}
+
+	id := k.GetNextRewardScheduleID(ctx)
+	k.SetNextRewardScheduleID(ctx, id+1)
+
+	schedule := types.NewRewardSchedule(id, funder, plan, funds)
+	k.SetRewardSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardSchedule,
+			sdk.NewAttribute(types.EventAttrKeyScheduleID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.EventAttrKeyFunder, schedule.Funder),
+			sdk.NewAttribute(types.EventAttrKeyStartHeight, strconv.FormatUint(schedule.StartHeight, 10)),
+			sdk.NewAttribute(types.EventAttrKeyEndHeight, strconv.FormatUint(schedule.EndHeight, 10)),
+			sdk.NewAttribute(types.EventAttrKeyAmount, schedule.AmountPerPeriod.String()),
+			sdk.NewAttribute(types.EventAttrKeyFunds, funds.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// ReleaseEndedRewardSchedules deletes the reward schedules past their end
+// height or out of funds. Their remaining funds are released to the
+// unscheduled balance of the reward pool.
+func (k Keeper) ReleaseEndedRewardSchedules(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+
+	var ended []types.RewardSchedule
+
+	k.IterateRewardSchedules(ctx, func(schedule types.RewardSchedule) bool {
+		if schedule.EndHeight < height || schedule.RemainingFunds.IsZero() {
+			ended = append(ended, schedule)
+		}
+
+		return false
+	})
+
+	for _, schedule := range ended {
+		k.DeleteRewardSchedule(ctx, schedule.Id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardScheduleEnd,
+				sdk.NewAttribute(types.EventAttrKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+				sdk.NewAttribute(types.EventAttrKeyFunds, schedule.RemainingFunds.String()),
+			),
+		)
+	}
+}
+
+// unscheduledPeriodRewards returns the share of the unscheduled balance of the
+// reward denoms paid out in a vote period.
+func (k Keeper) unscheduledPeriodRewards(
+	ctx sdk.Context,
+	votePeriod int64,
+	rewardDistributionWindow int64,
+	rewardDenoms []string,
+) sdk.DecCoins {
+	distributionRatio := sdk.NewDec(votePeriod).QuoInt64(rewardDistributionWindow)
+	unscheduled := k.GetUnscheduledRewardPool(ctx)
+
+	var periodRewards sdk.DecCoins
+
+	for _, denom := range rewardDenoms {
+		amount := unscheduled.AmountOf(denom)
+
+		// skip if there's no rewards to give out
+		if amount.IsZero() {
+			continue
+		}
+
+		periodRewards = periodRewards.Add(sdk.NewDecCoinFromDec(
+			denom,
+			sdk.NewDecFromInt(amount).Mul(distributionRatio),
+		))
+	}
+
+	return periodRewards
+}
+
+// GetRewardProjection returns the reward payout of the next vote period, the
+// unscheduled balance of the reward pool and the remaining runway of every
+// reward schedule.
+func (k Keeper) GetRewardProjection(ctx sdk.Context) types.QueryRewardProjectionResponse {
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+	payoutHeight := types.NextPayoutHeight(height, params.VotePeriod)
+
+	periodRewards := k.unscheduledPeriodRewards(
+		ctx,
+		int64(params.VotePeriod),
+		int64(params.RewardDistributionWindow),
+		params.RewardDenoms,
+	)
+
+	schedules := []types.RewardScheduleProjection{}
+
+	k.IterateRewardSchedules(ctx, func(schedule types.RewardSchedule) bool {
+		if schedule.IsActive(payoutHeight) {
+			periodRewards = periodRewards.Add(sdk.NewDecCoinsFromCoins(schedule.PeriodPayout()...)...)
+		}
+
+		periods, lastPayoutHeight := schedule.RemainingPeriods(height, params.VotePeriod)
+		schedules = append(schedules, types.RewardScheduleProjection{
+			Schedule:         schedule,
+			RemainingPeriods: periods,
+			RunwayEndHeight:  lastPayoutHeight,
+		})
+
+		return false
+	})
+
+	perPeriodPayout, _ := periodRewards.TruncateDecimal()
+
+	return types.QueryRewardProjectionResponse{
+		PerPeriodPayout:  perPeriodPayout,
+		UnscheduledFunds: k.GetUnscheduledRewardPool(ctx),
+		Schedules:        schedules,
+	}
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServer_AddFundsToRewardSchedule() {
+	s.balanceSetup()
+	app, ctx := s.app, s.ctx
+	addr := s.accAddresses[0]
+	height := uint64(ctx.BlockHeight())
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 2500))
+	plan := types.NewRewardSchedulePlan(0, height+100, sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1000)))
+
+	res, err := s.msgServer.AddFundsToRewardPool(sdk.WrapSDKContext(ctx), types.NewMsgAddFundsToRewardSchedule(addr, funds, plan))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.ScheduleId)
+
+	schedule, found := app.OracleKeeper.GetRewardSchedule(ctx, res.ScheduleId)
+	s.Require().True(found)
+	s.Require().Equal(addr.String(), schedule.Funder)
+	s.Require().Equal(height, schedule.StartHeight)
+	s.Require().Equal(funds, schedule.RemainingFunds)
+	s.Require().True(app.OracleKeeper.GetUnscheduledRewardPool(ctx).IsZero())
+
+	// funds of a denom which is no reward denom can not be scheduled
+	funds = sdk.NewCoins(sdk.NewInt64Coin("uatom", 2500))
+	plan.AmountPerPeriod = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	_, err = app.OracleKeeper.CreateRewardSchedule(ctx, addr, plan, funds)
+	s.Require().ErrorIs(err, types.ErrInvalidRewardSchedule)
+
+	// the schedule can not end in the past
+	plan = types.NewRewardSchedulePlan(0, height-1, sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1000)))
+	_, err = s.msgServer.AddFundsToRewardPool(sdk.WrapSDKContext(ctx), types.NewMsgAddFundsToRewardSchedule(
+		addr, sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1000)), plan))
+	s.Require().ErrorIs(err, types.ErrInvalidRewardSchedule)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_CreateRewardSchedule() {
+	s.balanceSetup()
+	app, ctx := s.app, s.ctx
+	authority := sdk.MustAccAddressFromBech32(app.OracleKeeper.GetAuthority())
+	height := uint64(ctx.BlockHeight())
+
+	err := app.OracleKeeper.FundRewardPool(ctx, s.accAddresses[0], sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 3000)))
+	s.Require().NoError(err)
+
+	plan := types.NewRewardSchedulePlan(height+10, height+100, sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1000)))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 2000))
+
+	_, err = s.msgServer.CreateRewardSchedule(sdk.WrapSDKContext(ctx), types.NewMsgCreateRewardSchedule(s.accAddresses[0], funds, plan))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	res, err := s.msgServer.CreateRewardSchedule(sdk.WrapSDKContext(ctx), types.NewMsgCreateRewardSchedule(authority, funds, plan))
+	s.Require().NoError(err)
+
+	schedule, found := app.OracleKeeper.GetRewardSchedule(ctx, res.ScheduleId)
+	s.Require().True(found)
+	s.Require().Equal(authority.String(), schedule.Funder)
+	s.Require().Equal(height+10, schedule.StartHeight)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1000)), app.OracleKeeper.GetUnscheduledRewardPool(ctx))
+
+	// the reserved funds can not be scheduled twice
+	_, err = s.msgServer.CreateRewardSchedule(sdk.WrapSDKContext(ctx), types.NewMsgCreateRewardSchedule(authority, funds, plan))
+	s.Require().ErrorIs(err, types.ErrInsufficientRewardPool)
+}
+
+func (s *KeeperTestSuite) TestRewardBallotWinnersSchedule() {
+	s.balanceSetup()
+	app, ctx := s.app, s.ctx
+	valAddr, valAddr2 := s.valAddresses[0], s.valAddresses[1]
+	height := uint64(ctx.BlockHeight())
+	params := app.OracleKeeper.GetParams(ctx)
+
+	claims := []types.Claim{
+		types.NewClaim(10, 10, 0, valAddr),
+		types.NewClaim(20, 20, 0, valAddr2),
+	}
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 2500))
+	plan := types.NewRewardSchedulePlan(height, height+100, sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1500)))
+
+	err := app.OracleKeeper.FundRewardPool(ctx, s.accAddresses[0], funds)
+	s.Require().NoError(err)
+	id, err := app.OracleKeeper.CreateRewardSchedule(ctx, s.accAddresses[0], plan, funds)
+	s.Require().NoError(err)
+
+	rewardBallotWinners := func() {
+		app.OracleKeeper.RewardBallotWinners(
+			ctx,
+			int64(params.VotePeriod),
+			int64(params.RewardDistributionWindow),
+			params.RewardDenoms,
+			claims,
+		)
+	}
+
+	weight := sdk.NewDec(10).QuoInt64(30)
+
+	// the schedule pays out its amount per period
+	rewardBallotWinners()
+
+	outstandingRewards := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+	s.Require().Equal(sdk.NewDec(1500).Mul(weight).TruncateDec(), outstandingRewards.AmountOf(types.PersistenceDenom))
+
+	schedule, found := app.OracleKeeper.GetRewardSchedule(ctx, id)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1000)), schedule.RemainingFunds)
+
+	// the truncation dust is released to the unscheduled balance
+	dust := app.OracleKeeper.GetUnscheduledRewardPool(ctx).AmountOf(types.PersistenceDenom)
+	s.Require().True(dust.LTE(sdk.NewInt(int64(len(claims)))))
+
+	// and its remaining funds afterwards
+	rewardBallotWinners()
+
+	outstandingRewards = app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+	s.Require().Equal(sdk.NewDec(1500).Mul(weight).TruncateDec().Add(sdk.NewDec(1000).Mul(weight).TruncateDec()),
+		outstandingRewards.AmountOf(types.PersistenceDenom))
+
+	schedule, found = app.OracleKeeper.GetRewardSchedule(ctx, id)
+	s.Require().True(found)
+	s.Require().True(schedule.RemainingFunds.IsZero())
+
+	app.OracleKeeper.ReleaseEndedRewardSchedules(ctx)
+	_, found = app.OracleKeeper.GetRewardSchedule(ctx, id)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestQuerier_RewardProjection() {
+	s.balanceSetup()
+	app, ctx := s.app, s.ctx
+	height := uint64(ctx.BlockHeight())
+	votePeriod := app.OracleKeeper.GetVotePeriod(ctx)
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 2500))
+	plan := types.NewRewardSchedulePlan(0, height+100*votePeriod, sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1000)))
+
+	_, err := s.msgServer.AddFundsToRewardPool(sdk.WrapSDKContext(ctx), types.NewMsgAddFundsToRewardSchedule(s.accAddresses[0], funds, plan))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.RewardProjection(sdk.WrapSDKContext(ctx), &types.QueryRewardProjectionRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.PersistenceDenom, 1000)), res.PerPeriodPayout)
+	s.Require().True(res.UnscheduledFunds.IsZero())
+	s.Require().Len(res.Schedules, 1)
+
+	// two full payouts and a partial one
+	nextPayoutHeight := types.NextPayoutHeight(height, votePeriod)
+	s.Require().Equal(uint64(3), res.Schedules[0].RemainingPeriods)
+	s.Require().Equal(nextPayoutHeight+2*votePeriod, res.Schedules[0].RunwayEndHeight)
+}
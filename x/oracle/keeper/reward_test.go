@@ -26,17 +26,11 @@ func (s *KeeperTestSuite) TestRewardBallotWinners() {
 
 	params := s.app.OracleKeeper.GetParams(s.ctx)
 
-	var voteTargets = make([]string, len(params.AcceptList))
-
-	for i, v := range params.AcceptList {
-		voteTargets[i] = v.SymbolDenom
-	}
-
 	votePeriodsPerWindow := sdk.NewDec((int64)(s.app.OracleKeeper.GetRewardDistributionWindow(s.ctx))).
 		QuoInt64((int64)(s.app.OracleKeeper.GetVotePeriod(s.ctx))).
 		TruncateInt64()
 
-	s.app.OracleKeeper.RewardBallotWinners(s.ctx, (int64)(s.app.OracleKeeper.GetVotePeriod(s.ctx)), (int64)(s.app.OracleKeeper.GetRewardDistributionWindow(s.ctx)), voteTargets, claims)
+	s.app.OracleKeeper.RewardBallotWinners(s.ctx, (int64)(s.app.OracleKeeper.GetVotePeriod(s.ctx)), (int64)(s.app.OracleKeeper.GetRewardDistributionWindow(s.ctx)), params.RewardDenoms, claims)
 
 	outstandingRewardsDec := s.app.DistrKeeper.GetValidatorOutstandingRewardsCoins(s.ctx, valAddr)
 	outstandingRewards, _ := outstandingRewardsDec.TruncateDecimal()
@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"strconv"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
 )
 
 // SlashAndResetMissCounters iterates over all the current missed counters and
@@ -9,7 +14,7 @@ import (
 // (votePeriodsPerWindow - missCounter)/votePeriodsPerWindow.
 //
 // If the valid vote rate is below the minValidPerWindow, the validator will be
-// slashed and jailed.
+// slashed and jailed for the JailDuration, and an oracle jail record is stored.
 // https://classic-docs.terra.money/docs/develop/module-specifications/spec-oracle.html#slashandresetmisscounters
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
@@ -24,6 +29,7 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	var (
 		minValidPerWindow = k.GetMinValidPerWindow(ctx)
 		slashFraction     = k.GetSlashFraction(ctx)
+		jailDuration      = k.GetJailDuration(ctx)
 		powerReduction    = k.StakingKeeper.PowerReduction(ctx)
 	)
 
@@ -41,19 +47,55 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 					panic(err)
 				}
 
-				k.StakingKeeper.Slash(
+				power := validator.GetConsensusPower(powerReduction)
+
+				burnedTokens := k.StakingKeeper.Slash(
 					ctx,
 					consAddr,
 					distributionHeight,
-					validator.GetConsensusPower(powerReduction),
+					power,
 					slashFraction,
 				)
 
 				k.StakingKeeper.Jail(ctx, consAddr)
+
+				// Keep the validator jailed for the jail duration, in the
+				// signing info checked by the slashing module on unjail when
+				// the validator has one, and in the oracle jail record. A
+				// longer jail already set in the signing info is kept.
+				jailedUntil := ctx.BlockTime().Add(jailDuration)
+				if signingInfo, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr); found {
+					if signingInfo.JailedUntil.After(jailedUntil) {
+						jailedUntil = signingInfo.JailedUntil
+					}
+
+					k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil)
+				}
+
+				k.SetOracleJail(ctx, types.NewOracleJail(
+					operator,
+					uint64(height),
+					jailedUntil,
+					missCounter,
+					validVoteRate,
+					burnedTokens,
+				))
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(types.EventTypeOracleJail,
+						sdk.NewAttribute(types.EventAttrKeyOperator, operator.String()),
+						sdk.NewAttribute(types.EventAttrKeyPower, strconv.FormatInt(power, 10)),
+						sdk.NewAttribute(types.EventAttrKeyMissCounter, strconv.FormatUint(missCounter, 10)),
+						sdk.NewAttribute(types.EventAttrKeyBurnedCoins, burnedTokens.String()),
+						sdk.NewAttribute(types.EventAttrKeyJailedUntil, jailedUntil.Format(time.RFC3339)),
+					),
+				)
 			}
 		}
 
 		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
+
+	k.ClearDenomMissCounters(ctx)
 }
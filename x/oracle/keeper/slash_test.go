@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	distrtypes "github.com/incubus-network/fanfury-sdk/v2/x/lsnative/distribution/types"
 	"github.com/incubus-network/fanfury-sdk/v2/x/lsnative/staking"
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/testutil"
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
@@ -43,6 +46,7 @@ func (s *KeeperTestSuite) TestSlashAndResetMissCounters() {
 
 	// Case 2, slash
 	s.app.OracleKeeper.SetMissCounter(s.ctx, valAddr, missCounterSlash)
+	s.app.OracleKeeper.SetDenomMissCounter(s.ctx, types.NewDenomMissCounter(valAddr, types.AtomSymbol, 1))
 	s.app.OracleKeeper.SlashAndResetMissCounters(s.ctx)
 
 	validator, _ = s.app.StakingKeeper.GetLiquidValidator(s.ctx, valAddr)
@@ -51,6 +55,7 @@ func (s *KeeperTestSuite) TestSlashAndResetMissCounters() {
 
 	missCounter := app.OracleKeeper.GetMissCounter(ctx, valAddr)
 	s.Require().Zero(missCounter)
+	s.Require().Zero(app.OracleKeeper.GetDenomMissCounter(ctx, valAddr, types.AtomSymbol))
 
 	// Case 3, slash unbonded validator
 	validator, _ = s.app.StakingKeeper.GetLiquidValidator(s.ctx, valAddr)
@@ -77,3 +82,97 @@ func (s *KeeperTestSuite) TestSlashAndResetMissCounters() {
 	validator, _ = s.app.StakingKeeper.GetLiquidValidator(s.ctx, valAddr)
 	s.Require().Equal(amt, validator.Tokens)
 }
+
+func (s *KeeperTestSuite) TestSlashAndResetMissCountersOracleJail() {
+	app, ctx := s.app, s.ctx
+	valAddr := s.valAddresses[0]
+
+	params := types.DefaultParams()
+	params.VotePeriod = 10
+	params.SlashFraction = sdk.NewDecWithPrec(5, 1)
+	params.SlashWindow = 100
+	params.MinValidPerWindow = sdk.MustNewDecFromStr("0.5")
+	params.JailDuration = time.Hour
+	app.OracleKeeper.SetParams(ctx, params)
+
+	validator := app.StakingKeeper.Validator(ctx, valAddr)
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+	s.Require().True(app.SlashingKeeper.HasValidatorSigningInfo(ctx, consAddr))
+
+	app.OracleKeeper.SetMissCounter(ctx, valAddr, 6)
+	app.OracleKeeper.SlashAndResetMissCounters(ctx)
+	s.Require().True(app.StakingKeeper.Validator(ctx, valAddr).IsJailed())
+
+	// the jail duration is set in the signing info
+	signingInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), signingInfo.JailedUntil.Unix())
+
+	oracleJail, found := app.OracleKeeper.GetOracleJail(ctx, valAddr)
+	s.Require().True(found)
+	s.Require().Equal(uint64(6), oracleJail.MissCounter)
+	s.Require().Equal(sdk.NewDecWithPrec(4, 1), oracleJail.ValidVoteRate)
+	s.Require().True(oracleJail.SlashedTokens.IsPositive())
+
+	resp, err := s.queryClient.OracleJail(ctx.Context(), &types.QueryOracleJailRequest{ValidatorAddr: valAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(oracleJail.JailHeight, resp.OracleJail.JailHeight)
+
+	// the slash is recorded by distribution for the rewards of the delegators
+	var slashEvents int
+	app.DistrKeeper.IterateValidatorSlashEventsBetween(
+		ctx, valAddr, 0, uint64(ctx.BlockHeight())+1,
+		func(_ uint64, _ distrtypes.ValidatorSlashEvent) bool {
+			slashEvents++
+			return false
+		},
+	)
+	s.Require().Equal(1, slashEvents)
+
+	// the oracle jail is checked on unjail even if the signing info allows it
+	signingInfo.JailedUntil = ctx.BlockTime()
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, signingInfo)
+
+	err = app.SlashingKeeper.Unjail(ctx, valAddr)
+	s.Require().ErrorIs(err, types.ErrOracleJailed)
+
+	// after the jail duration the validator unjails and the record is cleared
+	unjailCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(app.SlashingKeeper.Unjail(unjailCtx, valAddr))
+
+	_, found = app.OracleKeeper.GetOracleJail(unjailCtx, valAddr)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestSlashAndResetMissCountersKeepsLongerJail() {
+	app, ctx := s.app, s.ctx
+	valAddr := s.valAddresses[0]
+
+	params := types.DefaultParams()
+	params.VotePeriod = 10
+	params.SlashWindow = 100
+	params.MinValidPerWindow = sdk.MustNewDecFromStr("0.5")
+	params.JailDuration = time.Hour
+	app.OracleKeeper.SetParams(ctx, params)
+
+	validator := app.StakingKeeper.Validator(ctx, valAddr)
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+
+	// the signing info already holds a longer jail
+	longerJail := ctx.BlockTime().Add(48 * time.Hour)
+	app.SlashingKeeper.JailUntil(ctx, consAddr, longerJail)
+
+	app.OracleKeeper.SetMissCounter(ctx, valAddr, 6)
+	app.OracleKeeper.SlashAndResetMissCounters(ctx)
+	s.Require().True(app.StakingKeeper.Validator(ctx, valAddr).IsJailed())
+
+	signingInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().True(found)
+	s.Require().Equal(longerJail.Unix(), signingInfo.JailedUntil.Unix())
+
+	oracleJail, found := app.OracleKeeper.GetOracleJail(ctx, valAddr)
+	s.Require().True(found)
+	s.Require().Equal(longerJail.Unix(), oracleJail.JailedUntil.Unix())
+}
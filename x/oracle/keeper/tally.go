@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,58 +26,177 @@ func (k Keeper) BuildClaimsMapAndTally(ctx sdk.Context, params types.Params) err
 		validatorClaimMap[addr.String()] = types.NewClaim(valConsensusPower, 0, 0, addr)
 	}
 
-	var (
-		// voteTargets defines the symbol (ticker) denoms that we require votes on
-		voteTargets      = make([]string, 0, len(params.AcceptList))
-		voteTargetDenoms = make([]string, 0, len(params.AcceptList))
-	)
+	votePeriod := uint64(ctx.BlockHeight()) / params.VotePeriod
 
+	// voteTargets defines the symbol (ticker) denoms that we require votes on.
+	// The denoms added during the vote period are tallied, but not required.
+	voteTargets := make([]string, 0, len(params.AcceptList))
 	for _, v := range params.AcceptList {
-		voteTargets = append(voteTargets, v.SymbolDenom)
-		voteTargetDenoms = append(voteTargetDenoms, v.SymbolDenom)
+		if addedDenom, found := k.GetAddedDenom(ctx, v.SymbolDenom); found && addedDenom.VotePeriod == votePeriod {
+			continue
+		}
+
+		voteTargets = append(voteTargets, strings.ToUpper(v.SymbolDenom))
 	}
 
-	// Clear all exchange rates
-	k.ClearExchangeRates(ctx)
+	// Exchange rates are kept across vote periods; the metadata of their last
+	// successful tally tells whether they expired.
 
 	// Organize votes to ballot by denom
 	// NOTE: **Filter out inactive or jailed validators**
 	ballotDenomSlice := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
 
-	threshold := k.GetVoteThreshold(ctx).MulInt64(types.MaxVoteThresholdMultiplier).TruncateInt64()
+	// Keep track of the validators that voted and abstained for their statistics
+	var (
+		voters     = make(map[string]bool)
+		abstainers = make(map[string]bool)
+	)
+
+	for _, ballotDenom := range ballotDenomSlice {
+		for _, vote := range ballotDenom.Ballot {
+			voters[vote.Voter.String()] = true
+
+			if !vote.ExchangeRate.IsPositive() {
+				abstainers[vote.Voter.String()] = true
+			}
+		}
+	}
+
+	// Keep track of the denoms whose ballot reached quorum, and of the denoms
+	// won by each validator
+	var (
+		quorum    = make(map[string]bool)
+		wonDenoms = make(map[string]map[string]bool)
+	)
 
 	// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
+	// The threshold, reward band and minimum voters may be overridden per denom.
 	for _, ballotDenom := range ballotDenomSlice {
+		if minVoters := params.DenomMinVoters(ballotDenom.Denom); uint64(len(ballotDenom.Ballot)) < minVoters {
+			ctx.Logger().Info("Ballot has fewer voters than required, dropping ballot", "denom", ballotDenom)
+			k.AfterBallotDropped(ctx, ballotDenom.Denom)
+			continue
+		}
+
+		threshold := params.DenomVoteThreshold(ballotDenom.Denom).MulInt64(types.MaxVoteThresholdMultiplier).TruncateInt64()
+
 		// Calculate the portion of votes received as an integer, scaled up using the
 		// same multiplier as the `threshold` computed above
 		support := ballotDenom.Ballot.Power() * types.MaxVoteThresholdMultiplier / totalBondedValidatorPower
 		if support < threshold {
 			ctx.Logger().Info("Ballot voting power is under vote threshold, dropping ballot", "denom", ballotDenom)
+			k.AfterBallotDropped(ctx, ballotDenom.Denom)
 			continue
 		}
 
-		// Get weighted median of exchange rates
-		exchangeRate, err := Tally(ballotDenom.Ballot, params.RewardBand, validatorClaimMap)
+		quorum[strings.ToUpper(ballotDenom.Denom)] = true
+
+		winCounts := make(map[string]int64, len(ballotDenom.Ballot))
+		for _, vote := range ballotDenom.Ballot {
+			winCounts[vote.Voter.String()] = validatorClaimMap[vote.Voter.String()].WinCount
+		}
+
+		// Aggregate the exchange rates with the strategy of the denom
+		tallyResult, err := Tally(
+			ballotDenom.Ballot,
+			params.DenomAggregationStrategy(ballotDenom.Denom),
+			params.DenomRewardBand(ballotDenom.Denom),
+			validatorClaimMap,
+		)
 		if err != nil {
 			return err
 		}
 
+		for _, vote := range ballotDenom.Ballot {
+			voter := vote.Voter.String()
+			if validatorClaimMap[voter].WinCount == winCounts[voter] {
+				continue
+			}
+
+			if wonDenoms[voter] == nil {
+				wonDenoms[voter] = make(map[string]bool)
+			}
+
+			wonDenoms[voter][strings.ToUpper(ballotDenom.Denom)] = true
+		}
+
+		tallyResult.Denom = ballotDenom.Denom
+		tallyResult.VotePeriod = votePeriod
+		tallyResult.BlockHeight = uint64(ctx.BlockHeight())
+		tallyResult.SupportRatio = sdk.NewDec(tallyResult.ParticipatingPower).QuoInt64(totalBondedValidatorPower)
+
+		// Record the statistics of the tally, even if the rate is not applied
+		k.SetTallyResultWithEvent(ctx, tallyResult)
+
+		exchangeRate := tallyResult.ExchangeRate
+
+		// Keep the last good rate if the new rate deviates from the remote
+		// price bounding it.
+		if !k.withinRemotePriceBound(ctx, params, ballotDenom.Denom, exchangeRate) {
+			ctx.Logger().Info("Exchange rate deviates from the remote price, keeping last rate", "denom", ballotDenom.Denom)
+			continue
+		}
+
+		// Keep the last good rate if the denom is halted or the new rate trips
+		// the circuit breaker. The ballot winners are still rewarded.
+		if !k.applyCircuitBreaker(ctx, params, ballotDenom.Denom, exchangeRate) {
+			ctx.Logger().Info("Denom is halted by the circuit breaker, keeping last rate", "denom", ballotDenom.Denom)
+			continue
+		}
+
 		// Set the exchange rate, emit ABCI event
 		k.SetExchangeRateWithEvent(ctx, ballotDenom.Denom, exchangeRate)
+		k.SetExchangeRateMetadata(ctx, types.NewExchangeRateMetadata(
+			ballotDenom.Denom,
+			uint64(ctx.BlockHeight()),
+			ctx.BlockTime(),
+			uint64(len(ballotDenom.Ballot)),
+			sdk.NewDec(ballotDenom.Ballot.Power()).QuoInt64(totalBondedValidatorPower),
+		))
+		k.AfterExchangeRateUpdated(ctx, ballotDenom.Denom, exchangeRate)
 	}
 
-	// update miss counting & slashing
-	voteTargetsLen := len(voteTargets)
+	// Fall back to the remote price for the denoms without quorum
+	k.applyRemotePriceFallbacks(ctx, params, quorum)
 
+	// update miss counting & slashing
 	claimSlice := types.ClaimMapToSlice(validatorClaimMap)
 	for _, claim := range claimSlice {
+		var missedDenoms []string
+
+		for _, denom := range voteTargets {
+			if !wonDenoms[claim.Recipient.String()][denom] {
+				missedDenoms = append(missedDenoms, denom)
+			}
+		}
+
+		won := len(missedDenoms) == 0
+		k.recordValidatorPerformance(
+			ctx,
+			params,
+			claim.Recipient,
+			voters[claim.Recipient.String()],
+			abstainers[claim.Recipient.String()],
+			won,
+		)
+
 		// Skip valid voters
-		if int(claim.WinCount) == voteTargetsLen {
+		if won {
 			continue
 		}
 
 		// Increase miss counter
-		k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
+		missCounter := k.GetMissCounter(ctx, claim.Recipient) + 1
+		k.SetMissCounter(ctx, claim.Recipient, missCounter)
+		k.AfterValidatorMissed(ctx, claim.Recipient, missCounter)
+		k.warnIfApproachingMinValidPerWindow(ctx, params, claim.Recipient, missCounter)
+
+		// Attribute the miss to the denom if it is the only one missed, to take
+		// it back if the denom is removed from the accept list
+		if len(missedDenoms) == 1 {
+			denomMissCounter := k.GetDenomMissCounter(ctx, claim.Recipient, missedDenoms[0]) + 1
+			k.SetDenomMissCounter(ctx, types.NewDenomMissCounter(claim.Recipient, missedDenoms[0], denomMissCounter))
+		}
 	}
 
 	// Distribute rewards to ballot winners
@@ -83,45 +204,54 @@ func (k Keeper) BuildClaimsMapAndTally(ctx sdk.Context, params types.Params) err
 		ctx,
 		int64(params.VotePeriod),
 		int64(params.RewardDistributionWindow),
-		voteTargetDenoms,
+		params.RewardDenoms,
 		claimSlice,
 	)
 
-	// Clear the ballot
+	// Clear the ballot and require votes on the added denoms from now on
 	k.ClearVotes(ctx, params.VotePeriod)
+	k.ClearAddedDenoms(ctx)
 
 	return nil
 }
 
-// Tally calculates the median and returns it. It sets the set of voters to be
-// rewarded, i.e. voted within a reasonable spread from the weighted median to
-// the store. Note, the ballot is sorted by ExchangeRate.
+// Tally aggregates the exchange rates of the ballot with the given strategy
+// and returns the result together with the statistics of the ballot. It sets
+// the set of voters to be rewarded, i.e. voted within a reasonable spread from
+// the aggregated rate to the store. Note, the ballot is sorted by ExchangeRate.
 // https://classic-docs.terra.money/docs/develop/module-specifications/spec-oracle.html#tally
 func Tally(
 	ballot types.ExchangeRateBallot,
+	strategy types.AggregationStrategy,
 	rewardBand sdk.Dec,
 	validatorClaimMap map[string]types.Claim,
-) (sdk.Dec, error) {
-	weightedMedian, err := ballot.WeightedMedian()
+) (types.TallyResult, error) {
+	exchangeRate, err := strategy.Aggregate(ballot)
+	if err != nil {
+		return types.TallyResult{}, err
+	}
 
+	median, err := ballot.WeightedMedian()
 	if err != nil {
-		return sdk.ZeroDec(), err
+		return types.TallyResult{}, err
 	}
 
 	standardDeviation, err := ballot.StandardDeviation()
 	if err != nil {
-		return sdk.ZeroDec(), err
+		return types.TallyResult{}, err
 	}
 
-	// rewardSpread is the MAX((weightedMedian * (rewardBand/2)), standardDeviation)
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
+	// rewardSpread is the MAX((exchangeRate * (rewardBand/2)), standardDeviation)
+	rewardSpread := exchangeRate.Mul(rewardBand.QuoInt64(2))
 	rewardSpread = sdk.MaxDec(rewardSpread, standardDeviation)
 
+	var winnerCount uint64
+
 	for _, tallyVote := range ballot {
 		// Filter ballot winners. For voters, we filter out the tally vote iff:
-		// (weightedMedian - rewardSpread) <= ExchangeRate <= (weightedMedian + rewardSpread)
-		if (tallyVote.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			tallyVote.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))) ||
+		// (exchangeRate - rewardSpread) <= ExchangeRate <= (exchangeRate + rewardSpread)
+		if (tallyVote.ExchangeRate.GTE(exchangeRate.Sub(rewardSpread)) &&
+			tallyVote.ExchangeRate.LTE(exchangeRate.Add(rewardSpread))) ||
 			!tallyVote.ExchangeRate.IsPositive() {
 			key := tallyVote.Voter.String()
 			claim := validatorClaimMap[key]
@@ -129,8 +259,20 @@ func Tally(
 			claim.Weight += tallyVote.Power
 			claim.WinCount++
 			validatorClaimMap[key] = claim
+
+			if tallyVote.ExchangeRate.IsPositive() {
+				winnerCount++
+			}
 		}
 	}
 
-	return weightedMedian, nil
+	return types.TallyResult{
+		ExchangeRate:       exchangeRate,
+		Median:             median,
+		StandardDeviation:  standardDeviation,
+		RewardSpread:       rewardSpread,
+		ParticipatingPower: ballot.Power(),
+		VoterCount:         uint64(len(ballot)),
+		WinnerCount:        winnerCount,
+	}, nil
 }
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// SetTallyResult stores the statistics of a tally, keyed by its denom and
+// vote period.
+func (k Keeper) SetTallyResult(ctx sdk.Context, tallyResult types.TallyResult) {
+	store := ctx.KVStore(k.storeKey)
+	tallyResult.Denom = strings.ToUpper(tallyResult.Denom)
+
+	bz := k.cdc.MustMarshal(&tallyResult)
+	store.Set(types.GetTallyResultKey(tallyResult.Denom, tallyResult.VotePeriod), bz)
+}
+
+// SetTallyResultWithEvent emits the statistics of a tally as an ABCI event and
+// stores them if the statistics are enabled.
+func (k Keeper) SetTallyResultWithEvent(ctx sdk.Context, tallyResult types.TallyResult) {
+	if k.GetTallyResultRetention(ctx) > 0 {
+		k.SetTallyResult(ctx, tallyResult)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTallyResult,
+			sdk.NewAttribute(types.EventAttrKeyDenom, tallyResult.Denom),
+			sdk.NewAttribute(types.EventAttrKeyVotePeriod, strconv.FormatUint(tallyResult.VotePeriod, 10)),
+			sdk.NewAttribute(types.EventAttrKeyExchangeRate, tallyResult.ExchangeRate.String()),
+			sdk.NewAttribute(types.EventAttrKeyMedian, tallyResult.Median.String()),
+			sdk.NewAttribute(types.EventAttrKeyStdDev, tallyResult.StandardDeviation.String()),
+			sdk.NewAttribute(types.EventAttrKeyRewardSpread, tallyResult.RewardSpread.String()),
+			sdk.NewAttribute(types.EventAttrKeyPower, strconv.FormatInt(tallyResult.ParticipatingPower, 10)),
+			sdk.NewAttribute(types.EventAttrKeySupportRatio, tallyResult.SupportRatio.String()),
+			sdk.NewAttribute(types.EventAttrKeyVoterCount, strconv.FormatUint(tallyResult.VoterCount, 10)),
+			sdk.NewAttribute(types.EventAttrKeyWinnerCount, strconv.FormatUint(tallyResult.WinnerCount, 10)),
+		),
+	)
+}
+
+// IterateTallyResults iterates over the tally results of a denom with a vote
+// period within [startPeriod, endPeriod], in ascending order. A zero endPeriod
+// leaves the range unbounded.
+func (k Keeper) IterateTallyResults(
+	ctx sdk.Context,
+	denom string,
+	startPeriod, endPeriod uint64,
+	handler func(types.TallyResult) bool,
+) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetTallyResultPrefix(strings.ToUpper(denom)),
+	)
+
+	var end []byte
+	if endPeriod != 0 {
+		end = sdk.Uint64ToBigEndian(endPeriod + 1)
+	}
+
+	iter := store.Iterator(sdk.Uint64ToBigEndian(startPeriod), end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var tallyResult types.TallyResult
+
+		k.cdc.MustUnmarshal(iter.Value(), &tallyResult)
+
+		if handler(tallyResult) {
+			break
+		}
+	}
+}
+
+// IterateAllTallyResults iterates over the tally results of all denoms in the
+// store.
+func (k Keeper) IterateAllTallyResults(ctx sdk.Context, handler func(types.TallyResult) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixTallyResult)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var tallyResult types.TallyResult
+
+		k.cdc.MustUnmarshal(iter.Value(), &tallyResult)
+
+		if handler(tallyResult) {
+			break
+		}
+	}
+}
+
+// GetLatestTallyResult returns the tally result of a denom with the highest
+// vote period.
+func (k Keeper) GetLatestTallyResult(ctx sdk.Context, denom string) (types.TallyResult, bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetTallyResultPrefix(strings.ToUpper(denom)),
+	)
+
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TallyResult{}, false
+	}
+
+	var tallyResult types.TallyResult
+	k.cdc.MustUnmarshal(iter.Value(), &tallyResult)
+
+	return tallyResult, true
+}
+
+// PruneTallyResults deletes the tally results of the denoms of the accept list
+// of the vote periods older than the last TallyResultRetention vote periods,
+// all of them if the statistics are disabled. Results of denoms removed from
+// the accept list are deleted on removal.
+func (k Keeper) PruneTallyResults(ctx sdk.Context, params types.Params) {
+	currentPeriod := uint64(ctx.BlockHeight()) / params.VotePeriod
+
+	var cutoff uint64
+	switch {
+	case params.TallyResultRetention == 0:
+		cutoff = currentPeriod + 1
+	case currentPeriod+1 > params.TallyResultRetention:
+		cutoff = currentPeriod + 1 - params.TallyResultRetention
+	default:
+		return
+	}
+
+	for _, denom := range params.AcceptList {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.GetTallyResultPrefix(strings.ToUpper(denom.SymbolDenom)),
+		)
+
+		// the keys are big endian vote periods, so the stale results come first
+		iter := store.Iterator(nil, sdk.Uint64ToBigEndian(cutoff))
+
+		var staleKeys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			staleKeys = append(staleKeys, iter.Key())
+		}
+
+		iter.Close()
+
+		for _, key := range staleKeys {
+			store.Delete(key)
+		}
+	}
+}
+
+// DeleteTallyResults deletes all the tally results of a denom.
+func (k Keeper) DeleteTallyResults(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetTallyResultPrefix(strings.ToUpper(denom)))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func newTestTallyResult(denom string, votePeriod uint64) types.TallyResult {
+	return types.TallyResult{
+		Denom:             denom,
+		VotePeriod:        votePeriod,
+		ExchangeRate:      sdk.OneDec(),
+		Median:            sdk.OneDec(),
+		StandardDeviation: sdk.ZeroDec(),
+		RewardSpread:      sdk.ZeroDec(),
+		SupportRatio:      sdk.OneDec(),
+	}
+}
+
+func (s *KeeperTestSuite) TestIterateTallyResults() {
+	app, ctx := s.app, s.ctx
+
+	for period := uint64(1); period <= 5; period++ {
+		app.OracleKeeper.SetTallyResult(ctx, newTestTallyResult(types.AtomSymbol, period))
+	}
+
+	app.OracleKeeper.SetTallyResult(ctx, newTestTallyResult(types.PersistenceSymbol, 6))
+
+	var periods []uint64
+
+	app.OracleKeeper.IterateTallyResults(ctx, "atom", 2, 4, func(tallyResult types.TallyResult) bool {
+		periods = append(periods, tallyResult.VotePeriod)
+		return false
+	})
+	s.Require().Equal([]uint64{2, 3, 4}, periods)
+
+	latest, found := app.OracleKeeper.GetLatestTallyResult(ctx, types.AtomSymbol)
+	s.Require().True(found)
+	s.Require().Equal(uint64(5), latest.VotePeriod)
+
+	_, found = app.OracleKeeper.GetLatestTallyResult(ctx, "OSMO")
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestPruneTallyResults() {
+	app, ctx := s.app, s.ctx
+	params := app.OracleKeeper.GetParams(ctx)
+	params.VotePeriod = 10
+	params.TallyResultRetention = 3
+
+	// the current vote period is 10 at the initial height
+	for _, period := range []uint64{6, 7, 8, 9, 10} {
+		app.OracleKeeper.SetTallyResult(ctx, newTestTallyResult(types.AtomSymbol, period))
+	}
+
+	app.OracleKeeper.PruneTallyResults(ctx, params)
+
+	var periods []uint64
+
+	app.OracleKeeper.IterateAllTallyResults(ctx, func(tallyResult types.TallyResult) bool {
+		periods = append(periods, tallyResult.VotePeriod)
+		return false
+	})
+	s.Require().Equal([]uint64{8, 9, 10}, periods)
+
+	// disabling the statistics prunes all of them
+	params.TallyResultRetention = 0
+	app.OracleKeeper.PruneTallyResults(ctx, params)
+
+	periods = nil
+
+	app.OracleKeeper.IterateAllTallyResults(ctx, func(tallyResult types.TallyResult) bool {
+		periods = append(periods, tallyResult.VotePeriod)
+		return false
+	})
+	s.Require().Empty(periods)
+}
+
+func (s *KeeperTestSuite) TestQuerier_TallyResults() {
+	app, ctx := s.app, s.ctx
+
+	app.OracleKeeper.SetTallyResultWithEvent(ctx, newTestTallyResult(types.AtomSymbol, 9))
+	app.OracleKeeper.SetTallyResultWithEvent(ctx, newTestTallyResult(types.AtomSymbol, 10))
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		emitted = emitted || event.Type == types.EventTypeTallyResult
+	}
+	s.Require().True(emitted)
+
+	resp, err := s.queryClient.TallyResults(ctx.Context(), &types.QueryTallyResultsRequest{
+		Denom:       types.AtomSymbol,
+		StartPeriod: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.TallyResults, 1)
+	s.Require().Equal(uint64(10), resp.TallyResults[0].VotePeriod)
+
+	_, err = s.queryClient.TallyResults(ctx.Context(), &types.QueryTallyResultsRequest{
+		Denom:       types.AtomSymbol,
+		StartPeriod: 10,
+		EndPeriod:   9,
+	})
+	s.Require().Error(err)
+
+	latest, err := s.queryClient.LatestTallyResults(ctx.Context(), &types.QueryLatestTallyResultsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(latest.TallyResults, 1)
+	s.Require().Equal(types.AtomSymbol, latest.TallyResults[0].Denom)
+	s.Require().Equal(uint64(10), latest.TallyResults[0].VotePeriod)
+
+	// disabling the statistics stops recording new tallies
+	params := app.OracleKeeper.GetParams(ctx)
+	params.TallyResultRetention = 0
+	app.OracleKeeper.SetParams(ctx, params)
+
+	app.OracleKeeper.SetTallyResultWithEvent(ctx, newTestTallyResult(types.AtomSymbol, 11))
+
+	latest, err = s.queryClient.LatestTallyResults(ctx.Context(), &types.QueryLatestTallyResultsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), latest.TallyResults[0].VotePeriod)
+}
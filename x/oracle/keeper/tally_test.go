@@ -91,20 +91,56 @@ func (s *KeeperTestSuite) TestTally() {
 				val(5).String(): types.NewClaim(40002, 40002, 1, val(5)),
 			},
 		},
+		{
+			Description: "reward spread centered on the trimmed mean",
+			// Tally 4 votes
+			// rewardBand: 0.100000000000000000
+			// trimmedMean: 1.150000000000000000
+			// standardDeviation: 0.122474487139158905
+			// rewardSpread: 0.122474487139158905
+
+			Ballot: types.ExchangeRateBallot{
+				types.NewVoteForTally(sdk.MustNewDecFromStr("1.0"), "AAA", val(1), 10000),
+				types.NewVoteForTally(sdk.MustNewDecFromStr("1.1"), "AAA", val(2), 10000),
+				types.NewVoteForTally(sdk.MustNewDecFromStr("1.2"), "AAA", val(3), 10000),
+				types.NewVoteForTally(sdk.MustNewDecFromStr("1.3"), "AAA", val(4), 10000),
+			},
+			Strategy:   types.NewTrimmedMeanStrategy(types.DefaultTrimFraction),
+			RewardBand: sdk.MustNewDecFromStr("0.1"),
+			ValidatorClaimMap: map[string]types.Claim{
+				val(1).String(): types.NewClaim(10000, 0, 0, val(1)),
+				val(2).String(): types.NewClaim(10000, 0, 0, val(2)),
+				val(3).String(): types.NewClaim(10000, 0, 0, val(3)),
+				val(4).String(): types.NewClaim(10000, 0, 0, val(4)),
+			},
+			ExpectedWeightedMedian: sdk.MustNewDecFromStr("1.15"),
+			ExpectedValidatorClaimMap: map[string]types.Claim{
+				val(1).String(): types.NewClaim(10000, 0, 0, val(1)),
+				val(2).String(): types.NewClaim(10000, 10000, 1, val(2)),
+				val(3).String(): types.NewClaim(10000, 10000, 1, val(3)),
+				val(4).String(): types.NewClaim(10000, 0, 0, val(4)),
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
 		s.T().Log("TestTally Case:", testCase.Description)
 
+		strategy := testCase.Strategy
+		if strategy == nil {
+			strategy = types.WeightedMedianStrategy{}
+		}
+
 		resultValidatorClaimMap := copyValidatorClaimMap(testCase.ValidatorClaimMap)
-		weightedMedian, err := keeper.Tally(
+		tallyResult, err := keeper.Tally(
 			testCase.Ballot,
+			strategy,
 			testCase.RewardBand,
 			resultValidatorClaimMap,
 		)
 
 		s.Require().NoError(err)
-		s.Require().EqualValues(testCase.ExpectedWeightedMedian, weightedMedian)
+		s.Require().EqualValues(testCase.ExpectedWeightedMedian, tallyResult.ExchangeRate)
 		s.Require().EqualValues(testCase.ExpectedValidatorClaimMap, resultValidatorClaimMap)
 	}
 }
@@ -113,6 +149,7 @@ type tallyTestCase struct {
 	Description string
 
 	Ballot            types.ExchangeRateBallot
+	Strategy          types.AggregationStrategy
 	RewardBand        sdk.Dec
 	ValidatorClaimMap map[string]types.Claim
 
@@ -174,9 +211,13 @@ func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyBelowThreshold() {
 	err = app.OracleKeeper.BuildClaimsMapAndTally(ctx, params)
 	s.Require().NoError(err)
 
-	// we haven't reached the vote threshold yet, so the exchange rate not updated and is reset
-	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
-	s.Require().ErrorContains(err, types.ErrUnknownDenom.Error())
+	// we haven't reached the vote threshold yet, so the last exchange rate is kept
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("1.0"), rate)
+
+	_, found := app.OracleKeeper.GetExchangeRateMetadata(ctx, types.AtomSymbol)
+	s.Require().False(found)
 
 	// rest of validators marked with misses
 	for valN := 1; valN < len(valAddresses); valN++ {
@@ -238,6 +279,23 @@ func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyAboveThreshold() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(sdk.MustNewDecFromStr("999.0"), newRate)
 
+	metadata, found := app.OracleKeeper.GetExchangeRateMetadata(ctx, types.AtomSymbol)
+	s.Require().True(found)
+	s.Require().Equal(uint64(ctx.BlockHeight()), metadata.LastUpdateHeight)
+	s.Require().Equal(uint64(halfOfBondedValidatorsCount), metadata.VoterCount)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), metadata.PowerShare)
+
+	// the statistics of the tally are recorded
+	tallyResult, found := app.OracleKeeper.GetLatestTallyResult(ctx, types.AtomSymbol)
+	s.Require().True(found)
+	s.Require().Equal(uint64(ctx.BlockHeight()), tallyResult.VotePeriod)
+	s.Require().Equal(sdk.MustNewDecFromStr("999.0"), tallyResult.ExchangeRate)
+	s.Require().Equal(sdk.MustNewDecFromStr("999.0"), tallyResult.Median)
+	s.Require().True(tallyResult.StandardDeviation.IsZero())
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), tallyResult.SupportRatio)
+	s.Require().Equal(uint64(halfOfBondedValidatorsCount), tallyResult.VoterCount)
+	s.Require().Equal(uint64(halfOfBondedValidatorsCount), tallyResult.WinnerCount)
+
 	// first half of validators doesn't have a miss
 	for valN := 0; valN < halfOfBondedValidatorsCount; valN++ {
 		s.Require().Zero(
@@ -255,3 +313,236 @@ func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyAboveThreshold() {
 		)
 	}
 }
+
+// TestBuildClaimsMapAndTallyDenomOverrides is a test for tallying with the vote
+// threshold and minimum voters overridden per denom.
+func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyDenomOverrides() {
+	// custom app and context for this test
+	app, ctx := s.initAppAndContext()
+
+	// generate 10 equal validators in consensus
+	_, valAddresses, err := testutil.StakingAddValidators(
+		app.BankKeeper,
+		app.StakingKeeper,
+		ctx,
+		10,
+	)
+	s.Require().NoError(err)
+
+	lowThreshold := sdk.NewDecWithPrec(35, 2)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 1
+	params.VoteThreshold = sdk.NewDecWithPrec(50, 2)
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6, VoteThreshold: &lowThreshold},
+		{BaseDenom: types.PersistenceDenom, SymbolDenom: types.PersistenceSymbol, Exponent: 6},
+		{BaseDenom: "uosmo", SymbolDenom: "OSMO", Exponent: 6, VoteThreshold: &lowThreshold, MinVoters: 5},
+	}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	s.T().Log("TestBuildClaimsMapAndTally: 4 votes out of 10 only pass the overridden threshold of 35%")
+
+	for valN := 0; valN < 4; valN++ {
+		app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddresses[valN], types.NewAggregateExchangeRateVote(
+			types.ExchangeRateTuples{
+				types.NewExchangeRateTuple(types.AtomSymbol, sdk.NewDec(10)),
+				types.NewExchangeRateTuple(types.PersistenceSymbol, sdk.NewDec(10)),
+				types.NewExchangeRateTuple("OSMO", sdk.NewDec(10)),
+			},
+			valAddresses[valN],
+		))
+	}
+
+	err = app.OracleKeeper.BuildClaimsMapAndTally(ctx, params)
+	s.Require().NoError(err)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.AtomSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(10), rate)
+
+	// below the global threshold
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.PersistenceSymbol)
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	// below the minimum number of voters
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, "OSMO")
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+}
+
+// TestBuildClaimsMapAndTallyScopedFeeder tests that a validator voting through
+// a scoped feeder abstains from the other denoms of the accept list, including
+// the denoms added after the feeder.
+func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyScopedFeeder() {
+	// custom app and context for this test
+	app, ctx := s.initAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+
+	_, valAddresses, err := testutil.StakingAddValidators(
+		app.BankKeeper,
+		app.StakingKeeper,
+		ctx,
+		3,
+	)
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 1
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6},
+		{BaseDenom: types.PersistenceDenom, SymbolDenom: types.PersistenceSymbol, Exponent: 6},
+	}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	valAddr := valAddresses[0]
+	feederAddr := sdk.AccAddress([]byte("feeder______________"))
+
+	_, err = msgServer.AddFeeder(sdk.WrapSDKContext(ctx), types.NewMsgAddFeeder(
+		valAddr, feederAddr, 0, []string{types.AtomSymbol},
+	))
+	s.Require().NoError(err)
+
+	// the scoped feeder prevotes and reveals for its validator
+	vote := func(ctx sdk.Context, rates string) error {
+		salt, err := generateSalt(32)
+		s.Require().NoError(err)
+
+		hash := types.GetAggregateVoteHash(salt, rates, valAddr)
+		_, err = msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRatePrevote(
+			hash, feederAddr, valAddr,
+		))
+		s.Require().NoError(err)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRateVote(
+			salt, rates, feederAddr, valAddr,
+		))
+
+		return err
+	}
+
+	// the feeder can not vote on a denom out of its scope
+	s.Require().ErrorIs(vote(ctx, "ATOM:10.0,XPRT:1.0"), types.ErrNoVotingPermission)
+	s.Require().NoError(vote(ctx, "ATOM:10.0"))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	aggregateVote, err := app.OracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ExchangeRateTuples{
+		types.NewExchangeRateTuple(types.AtomSymbol, sdk.NewDec(10)),
+		types.NewExchangeRateTuple(types.PersistenceSymbol, sdk.ZeroDec()),
+	}, aggregateVote.ExchangeRateTuples)
+
+	for _, addr := range valAddresses[1:] {
+		app.OracleKeeper.SetAggregateExchangeRateVote(ctx, addr, types.NewAggregateExchangeRateVote(
+			types.ExchangeRateTuples{
+				types.NewExchangeRateTuple(types.AtomSymbol, sdk.NewDec(10)),
+				types.NewExchangeRateTuple(types.PersistenceSymbol, sdk.OneDec()),
+			},
+			addr,
+		))
+	}
+
+	s.Require().NoError(app.OracleKeeper.BuildClaimsMapAndTally(ctx, params))
+
+	for _, addr := range valAddresses {
+		s.Require().Zero(app.OracleKeeper.GetMissCounter(ctx, addr))
+	}
+
+	// the abstaining vote does not move the rate
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.PersistenceSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	// the feeder keeps voting once a denom is added out of its scope
+	s.Require().NoError(app.OracleKeeper.AddAcceptListDenom(ctx, types.Denom{
+		BaseDenom: "uosmo", SymbolDenom: "OSMO", Exponent: 6,
+	}))
+	s.Require().NoError(vote(ctx, "ATOM:10.0"))
+
+	aggregateVote, err = app.OracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().Len(aggregateVote.ExchangeRateTuples, 3)
+}
+
+func (s *KeeperTestSuite) TestBuildClaimsMapAndTallyAcceptListChanges() {
+	// custom app and context for this test
+	app, ctx := s.initAppAndContext()
+
+	_, valAddresses, err := testutil.StakingAddValidators(
+		app.BankKeeper,
+		app.StakingKeeper,
+		ctx,
+		2,
+	)
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 10
+	params.VoteThreshold = sdk.NewDecWithPrec(34, 2)
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, Exponent: 6},
+		{BaseDenom: types.PersistenceDenom, SymbolDenom: types.PersistenceSymbol, Exponent: 6},
+	}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	osmo := types.Denom{BaseDenom: "uosmo", SymbolDenom: "OSMO", Exponent: 6}
+
+	setVote := func(ctx sdk.Context, addr sdk.ValAddress, osmoRate sdk.Dec) {
+		tuples := types.ExchangeRateTuples{
+			types.NewExchangeRateTuple(types.AtomSymbol, sdk.NewDec(10)),
+			types.NewExchangeRateTuple(types.PersistenceSymbol, sdk.OneDec()),
+		}
+		if osmoRate.IsPositive() {
+			tuples = append(tuples, types.NewExchangeRateTuple(osmo.SymbolDenom, osmoRate))
+		}
+
+		app.OracleKeeper.SetAggregateExchangeRateVote(ctx, addr, types.NewAggregateExchangeRateVote(tuples, addr))
+	}
+
+	// the second validator votes before the denom is added in the vote period
+	ctx = ctx.WithBlockHeight(109)
+	setVote(ctx, valAddresses[1], sdk.ZeroDec())
+
+	s.Require().NoError(app.OracleKeeper.AddAcceptListDenom(ctx, osmo))
+	setVote(ctx, valAddresses[0], sdk.NewDec(2))
+
+	// the added denom is tallied, but missing it is not counted
+	s.Require().NoError(app.OracleKeeper.BuildClaimsMapAndTally(ctx, app.OracleKeeper.GetParams(ctx)))
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, osmo.SymbolDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(2), rate)
+
+	for _, addr := range valAddresses {
+		s.Require().Zero(app.OracleKeeper.GetMissCounter(ctx, addr))
+	}
+
+	// from the next vote period on, the denom is required
+	ctx = ctx.WithBlockHeight(119)
+	setVote(ctx, valAddresses[0], sdk.NewDec(2))
+	setVote(ctx, valAddresses[1], sdk.ZeroDec())
+
+	s.Require().NoError(app.OracleKeeper.BuildClaimsMapAndTally(ctx, app.OracleKeeper.GetParams(ctx)))
+	s.Require().Zero(app.OracleKeeper.GetMissCounter(ctx, valAddresses[0]))
+	s.Require().Equal(uint64(1), app.OracleKeeper.GetMissCounter(ctx, valAddresses[1]))
+	s.Require().Equal(uint64(1), app.OracleKeeper.GetDenomMissCounter(ctx, valAddresses[1], osmo.SymbolDenom))
+
+	window := uint64(ctx.BlockHeight()) / params.SlashWindow
+
+	performance, found := app.OracleKeeper.GetValidatorPerformance(ctx, valAddresses[1], window)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), performance.Won)
+	s.Require().Equal(uint64(1), performance.Missed)
+
+	// removing the denom takes back the vote periods missed on it only
+	s.Require().NoError(app.OracleKeeper.RemoveAcceptListDenom(ctx, osmo.SymbolDenom))
+	s.Require().Zero(app.OracleKeeper.GetMissCounter(ctx, valAddresses[1]))
+	s.Require().Zero(app.OracleKeeper.GetDenomMissCounter(ctx, valAddresses[1], osmo.SymbolDenom))
+
+	performance, found = app.OracleKeeper.GetValidatorPerformance(ctx, valAddresses[1], window)
+	s.Require().True(found)
+	s.Require().Equal(uint64(2), performance.Won)
+	s.Require().Zero(performance.Missed)
+}
@@ -0,0 +1,29 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// MigrateParams performs in-place params migrations from v1 to v2. The
+// migration sets the params added since v1 to their defaults:
+//
+// - HistoryRetention, MaxStaleness, PerformanceWindows, JailDuration,
+// RewardDenoms, FeeShare and TallyResultRetention.
+//
+// The params already in the store are kept as they are.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if paramSpace.Has(ctx, pair.Key) {
+			continue
+		}
+
+		paramSpace.Set(ctx, pair.Key, pair.Value)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/incubus-network/fanfury-sdk/v2/x/oracle/migrations/v2"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+
+	legacyAmino := codec.NewLegacyAmino()
+	paramSpace := paramstypes.NewSubspace(codec.NewProtoCodec(nil), legacyAmino, storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the params of v1
+	votePeriod := uint64(10)
+	slashWindow := uint64(1000)
+	rewardDistributionWindow := types.DefaultRewardDistributionWindow
+	paramSpace.Set(ctx, types.KeyVotePeriod, &votePeriod)
+	paramSpace.Set(ctx, types.KeyVoteThreshold, &types.DefaultVoteThreshold)
+	paramSpace.Set(ctx, types.KeyRewardBand, &types.DefaultRewardBand)
+	paramSpace.Set(ctx, types.KeyRewardDistributionWindow, &rewardDistributionWindow)
+	paramSpace.Set(ctx, types.KeyAcceptList, &types.DefaultAcceptList)
+	paramSpace.Set(ctx, types.KeySlashFraction, &types.DefaultSlashFraction)
+	paramSpace.Set(ctx, types.KeySlashWindow, &slashWindow)
+	paramSpace.Set(ctx, types.KeyMinValidPerWindow, &types.DefaultMinValidPerWindow)
+
+	require.False(t, paramSpace.Has(ctx, types.KeyHistoryRetention))
+	require.NoError(t, v2.MigrateParams(ctx, paramSpace))
+
+	var params types.Params
+	require.NotPanics(t, func() { paramSpace.GetParamSet(ctx, &params) })
+	require.NoError(t, params.Validate())
+
+	// the params of v1 are kept and the new params are set to their defaults
+	expected := types.DefaultParams()
+	expected.VotePeriod = votePeriod
+	expected.SlashWindow = slashWindow
+	require.Equal(t, expected, params)
+}
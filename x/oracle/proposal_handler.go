@@ -0,0 +1,35 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/keeper"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// NewParamChangeProposalHandler wraps the param change proposal handler to
+// validate the oracle parameters together once a proposal changed any of them,
+// as the params module only validates the changed keys one at a time. The
+// proposal fails, and its changes are discarded, if the parameters are invalid.
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govv1beta1.Handler) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+
+		paramChange, ok := content.(*proposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+
+		for _, change := range paramChange.Changes {
+			if change.Subspace == types.ModuleName {
+				return k.ValidateParams(ctx)
+			}
+		}
+
+		return nil
+	}
+}
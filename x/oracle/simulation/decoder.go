@@ -0,0 +1,120 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding oracle type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixExchangeRate):
+			var rateA, rateB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &rateA)
+			cdc.MustUnmarshal(kvB.Value, &rateB)
+			return fmt.Sprintf("%v\n%v", rateA, rateB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixFeederDelegation):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixMissCounter):
+			var counterA, counterB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &counterA)
+			cdc.MustUnmarshal(kvB.Value, &counterB)
+			return fmt.Sprintf("%v\n%v", counterA.Value, counterB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAggregateExchangeRatePrevote):
+			var prevoteA, prevoteB types.AggregateExchangeRatePrevote
+			cdc.MustUnmarshal(kvA.Value, &prevoteA)
+			cdc.MustUnmarshal(kvB.Value, &prevoteB)
+			return fmt.Sprintf("%v\n%v", prevoteA, prevoteB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAggregateExchangeRateVote):
+			var voteA, voteB types.AggregateExchangeRateVote
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixHistoricExchangeRate):
+			var rateA, rateB types.HistoricExchangeRate
+			cdc.MustUnmarshal(kvA.Value, &rateA)
+			cdc.MustUnmarshal(kvB.Value, &rateB)
+			return fmt.Sprintf("%v\n%v", rateA, rateB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixExchangeRateMetadata):
+			var metadataA, metadataB types.ExchangeRateMetadata
+			cdc.MustUnmarshal(kvA.Value, &metadataA)
+			cdc.MustUnmarshal(kvB.Value, &metadataB)
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixHaltedDenom):
+			var haltedA, haltedB types.HaltedDenom
+			cdc.MustUnmarshal(kvA.Value, &haltedA)
+			cdc.MustUnmarshal(kvB.Value, &haltedB)
+			return fmt.Sprintf("%v\n%v", haltedA, haltedB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixValidatorPerformance):
+			var performanceA, performanceB types.ValidatorPerformance
+			cdc.MustUnmarshal(kvA.Value, &performanceA)
+			cdc.MustUnmarshal(kvB.Value, &performanceB)
+			return fmt.Sprintf("%v\n%v", performanceA, performanceB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixOracleJail):
+			var jailA, jailB types.OracleJail
+			cdc.MustUnmarshal(kvA.Value, &jailA)
+			cdc.MustUnmarshal(kvB.Value, &jailB)
+			return fmt.Sprintf("%v\n%v", jailA, jailB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixRewardSchedule):
+			var scheduleA, scheduleB types.RewardSchedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyNextRewardScheduleID):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTallyResult):
+			var resultA, resultB types.TallyResult
+			cdc.MustUnmarshal(kvA.Value, &resultA)
+			cdc.MustUnmarshal(kvB.Value, &resultB)
+			return fmt.Sprintf("%v\n%v", resultA, resultB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixFeeder):
+			var feederA, feederB types.Feeder
+			cdc.MustUnmarshal(kvA.Value, &feederA)
+			cdc.MustUnmarshal(kvB.Value, &feederB)
+			return fmt.Sprintf("%v\n%v", feederA, feederB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixRemotePrice):
+			var priceA, priceB types.RemotePrice
+			cdc.MustUnmarshal(kvA.Value, &priceA)
+			cdc.MustUnmarshal(kvB.Value, &priceB)
+			return fmt.Sprintf("%v\n%v", priceA, priceB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixDenomMissCounter):
+			var counterA, counterB types.DenomMissCounter
+			cdc.MustUnmarshal(kvA.Value, &counterA)
+			cdc.MustUnmarshal(kvB.Value, &counterB)
+			return fmt.Sprintf("%v\n%v", counterA, counterB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAddedDenom):
+			var addedA, addedB types.AddedDenom
+			cdc.MustUnmarshal(kvA.Value, &addedA)
+			cdc.MustUnmarshal(kvB.Value, &addedB)
+			return fmt.Sprintf("%v\n%v", addedA, addedB)
+
+		default:
+			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	furyapp "github.com/incubus-network/fanfury-sdk/v2/app"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/simulation"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+var (
+	delPk      = ed25519.GenPrivKey().PubKey()
+	feederAddr = sdk.AccAddress(delPk.Address())
+	valAddr    = sdk.ValAddress(delPk.Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := furyapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	exchangeRate := sdk.DecProto{Dec: sdk.NewDecWithPrec(1234, 1)}
+	missCounter := gogotypes.UInt64Value{Value: 3}
+	prevote := types.NewAggregateExchangeRatePrevote(
+		types.GetAggregateVoteHash("salt", "ATOM:12.3", valAddr), valAddr, 10,
+	)
+	vote := types.NewAggregateExchangeRateVote(
+		types.ExchangeRateTuples{types.NewExchangeRateTuple(types.AtomSymbol, sdk.NewDecWithPrec(123, 1))}, valAddr,
+	)
+	blockTime := time.Unix(10, 0).UTC()
+	historic := types.NewHistoricExchangeRate(types.AtomSymbol, sdk.NewDecWithPrec(123, 1), 10, blockTime)
+	metadata := types.NewExchangeRateMetadata(types.AtomSymbol, 10, blockTime, 3, sdk.NewDecWithPrec(5, 1))
+	halted := types.NewHaltedDenom(types.AtomSymbol, 10, sdk.NewDecWithPrec(123, 1), sdk.NewDec(20))
+	performance := types.NewValidatorPerformance(valAddr, 0)
+	jail := types.NewOracleJail(valAddr, 10, blockTime, 5, sdk.NewDecWithPrec(1, 2), sdk.NewInt(100))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	schedule := types.NewRewardSchedule(1, feederAddr, types.NewRewardSchedulePlan(1, 10, funds), funds)
+	tallyResult := types.TallyResult{
+		Denom:             types.AtomSymbol,
+		VotePeriod:        1,
+		BlockHeight:       10,
+		ExchangeRate:      sdk.NewDecWithPrec(123, 1),
+		Median:            sdk.NewDecWithPrec(123, 1),
+		StandardDeviation: sdk.ZeroDec(),
+		RewardSpread:      sdk.ZeroDec(),
+		SupportRatio:      sdk.OneDec(),
+	}
+	feeder := types.NewFeeder(valAddr, feederAddr, 0, nil)
+	remotePrice := types.NewRemotePrice(types.AtomSymbol, sdk.NewDecWithPrec(125, 1), 500, 10)
+	denomMissCounter := types.NewDenomMissCounter(valAddr, types.AtomSymbol, 2)
+	addedDenom := types.NewAddedDenom(types.AtomSymbol, 1)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetExchangeRateKey(types.AtomSymbol), Value: cdc.MustMarshal(&exchangeRate)},
+			{Key: types.GetFeederDelegationKey(valAddr), Value: feederAddr.Bytes()},
+			{Key: types.GetMissCounterKey(valAddr), Value: cdc.MustMarshal(&missCounter)},
+			{Key: types.GetAggregateExchangeRatePrevoteKey(valAddr), Value: cdc.MustMarshal(&prevote)},
+			{Key: types.GetAggregateExchangeRateVoteKey(valAddr), Value: cdc.MustMarshal(&vote)},
+			{Key: types.GetHistoricExchangeRateKey(types.AtomSymbol, 10), Value: cdc.MustMarshal(&historic)},
+			{Key: types.GetExchangeRateMetadataKey(types.AtomSymbol), Value: cdc.MustMarshal(&metadata)},
+			{Key: types.GetHaltedDenomKey(types.AtomSymbol), Value: cdc.MustMarshal(&halted)},
+			{Key: types.GetValidatorPerformanceKey(valAddr, 0), Value: cdc.MustMarshal(&performance)},
+			{Key: types.GetOracleJailKey(valAddr), Value: cdc.MustMarshal(&jail)},
+			{Key: types.GetRewardScheduleKey(1), Value: cdc.MustMarshal(&schedule)},
+			{Key: types.KeyNextRewardScheduleID, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.GetTallyResultKey(types.AtomSymbol, 1), Value: cdc.MustMarshal(&tallyResult)},
+			{Key: types.GetFeederKey(valAddr, feederAddr), Value: cdc.MustMarshal(&feeder)},
+			{Key: types.GetRemotePriceKey(types.AtomSymbol), Value: cdc.MustMarshal(&remotePrice)},
+			{Key: types.GetDenomMissCounterKey(types.AtomSymbol, valAddr), Value: cdc.MustMarshal(&denomMissCounter)},
+			{Key: types.GetAddedDenomKey(types.AtomSymbol), Value: cdc.MustMarshal(&addedDenom)},
+			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+		panics      bool
+	}{
+		{"ExchangeRate", fmt.Sprintf("%v\n%v", exchangeRate, exchangeRate), false},
+		{"FeederDelegation", fmt.Sprintf("%v\n%v", feederAddr, feederAddr), false},
+		{"MissCounter", fmt.Sprintf("%v\n%v", missCounter.Value, missCounter.Value), false},
+		{"AggregateExchangeRatePrevote", fmt.Sprintf("%v\n%v", prevote, prevote), false},
+		{"AggregateExchangeRateVote", fmt.Sprintf("%v\n%v", vote, vote), false},
+		{"HistoricExchangeRate", fmt.Sprintf("%v\n%v", historic, historic), false},
+		{"ExchangeRateMetadata", fmt.Sprintf("%v\n%v", metadata, metadata), false},
+		{"HaltedDenom", fmt.Sprintf("%v\n%v", halted, halted), false},
+		{"ValidatorPerformance", fmt.Sprintf("%v\n%v", performance, performance), false},
+		{"OracleJail", fmt.Sprintf("%v\n%v", jail, jail), false},
+		{"RewardSchedule", fmt.Sprintf("%v\n%v", schedule, schedule), false},
+		{"NextRewardScheduleID", "2\n2", false},
+		{"TallyResult", fmt.Sprintf("%v\n%v", tallyResult, tallyResult), false},
+		{"Feeder", fmt.Sprintf("%v\n%v", feeder, feeder), false},
+		{"RemotePrice", fmt.Sprintf("%v\n%v", remotePrice, remotePrice), false},
+		{"DenomMissCounter", fmt.Sprintf("%v\n%v", denomMissCounter, denomMissCounter), false},
+		{"AddedDenom", fmt.Sprintf("%v\n%v", addedDenom, addedDenom), false},
+		{"other", "", true},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panics {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// Simulation parameter constants
+const (
+	VotePeriod               = "vote_period"
+	VoteThreshold            = "vote_threshold"
+	RewardBand               = "reward_band"
+	RewardDistributionWindow = "reward_distribution_window"
+	SlashFraction            = "slash_fraction"
+	SlashWindow              = "slash_window"
+	MinValidPerWindow        = "min_valid_per_window"
+	FeeShare                 = "fee_share"
+)
+
+// SimulationAcceptList is the accept list of the simulations, the exchange
+// rates of its denoms are voted on by the simulated validators.
+var SimulationAcceptList = types.DenomList{
+	{
+		BaseDenom:   types.PersistenceDenom,
+		SymbolDenom: types.PersistenceSymbol,
+		Exponent:    types.PersistenceExponent,
+	},
+	{
+		BaseDenom:   types.AtomDenom,
+		SymbolDenom: types.AtomSymbol,
+		Exponent:    types.AtomExponent,
+	},
+}
+
+// GenVotePeriod randomized VotePeriod
+func GenVotePeriod(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 5, 20))
+}
+
+// GenVoteThreshold randomized VoteThreshold
+func GenVoteThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 34, 100)), 2)
+}
+
+// GenRewardBand randomized RewardBand
+func GenRewardBand(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(100)), 2)
+}
+
+// GenRewardDistributionWindow randomized RewardDistributionWindow
+func GenRewardDistributionWindow(r *rand.Rand, votePeriod uint64) uint64 {
+	return votePeriod * uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenSlashFraction randomized SlashFraction
+func GenSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(100)), 4)
+}
+
+// GenSlashWindow randomized SlashWindow
+func GenSlashWindow(r *rand.Rand, votePeriod uint64) uint64 {
+	return votePeriod * uint64(simulation.RandIntBetween(r, 100, 1000))
+}
+
+// GenMinValidPerWindow randomized MinValidPerWindow
+func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(6)), 2)
+}
+
+// GenFeeShare randomized FeeShare
+func GenFeeShare(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(20)), 2)
+}
+
+// GenExchangeRate randomized exchange rate
+func GenExchangeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 100_000)), 3)
+}
+
+// RandomizedGenState generates a random GenesisState for oracle
+func RandomizedGenState(simState *module.SimulationState) {
+	var votePeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotePeriod, &votePeriod, simState.Rand,
+		func(r *rand.Rand) { votePeriod = GenVotePeriod(r) },
+	)
+
+	var voteThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VoteThreshold, &voteThreshold, simState.Rand,
+		func(r *rand.Rand) { voteThreshold = GenVoteThreshold(r) },
+	)
+
+	var rewardBand sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RewardBand, &rewardBand, simState.Rand,
+		func(r *rand.Rand) { rewardBand = GenRewardBand(r) },
+	)
+
+	var rewardDistributionWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RewardDistributionWindow, &rewardDistributionWindow, simState.Rand,
+		func(r *rand.Rand) { rewardDistributionWindow = GenRewardDistributionWindow(r, votePeriod) },
+	)
+
+	var slashFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFraction, &slashFraction, simState.Rand,
+		func(r *rand.Rand) { slashFraction = GenSlashFraction(r) },
+	)
+
+	var slashWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashWindow, &slashWindow, simState.Rand,
+		func(r *rand.Rand) { slashWindow = GenSlashWindow(r, votePeriod) },
+	)
+
+	var minValidPerWindow sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinValidPerWindow, &minValidPerWindow, simState.Rand,
+		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
+	)
+
+	var feeShare sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeShare, &feeShare, simState.Rand,
+		func(r *rand.Rand) { feeShare = GenFeeShare(r) },
+	)
+
+	params := types.DefaultParams()
+	params.VotePeriod = votePeriod
+	params.VoteThreshold = voteThreshold
+	params.RewardBand = rewardBand
+	params.RewardDistributionWindow = rewardDistributionWindow
+	params.AcceptList = SimulationAcceptList
+	params.SlashFraction = slashFraction
+	params.SlashWindow = slashWindow
+	params.MinValidPerWindow = minValidPerWindow
+	params.HistoryRetention = slashWindow
+	params.MaxStaleness = 0
+	params.TallyResultRetention = 10
+	params.RewardDenoms = []string{sdk.DefaultBondDenom}
+	params.FeeShare = feeShare
+
+	rates := make([]types.ExchangeRateTuple, len(SimulationAcceptList))
+	for i, denom := range SimulationAcceptList {
+		rates[i] = types.ExchangeRateTuple{Denom: denom.SymbolDenom, ExchangeRate: GenExchangeRate(simState.Rand)}
+	}
+
+	oracleGenesis := types.DefaultGenesisState()
+	oracleGenesis.Params = params
+	oracleGenesis.ExchangeRates = rates
+
+	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated oracle parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(oracleGenesis)
+}
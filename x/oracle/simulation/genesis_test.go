@@ -0,0 +1,53 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/simulation"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+// Abonormal scenarios are not tested here.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var oracleGenesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &oracleGenesis)
+
+		require.NoError(t, types.ValidateGenesis(&oracleGenesis))
+		require.Equal(t, simulation.SimulationAcceptList, oracleGenesis.Params.AcceptList)
+		require.Equal(t, []string{sdk.DefaultBondDenom}, oracleGenesis.Params.RewardDenoms)
+		require.Len(t, oracleGenesis.ExchangeRates, len(simulation.SimulationAcceptList))
+
+		for _, rate := range oracleGenesis.ExchangeRates {
+			require.True(t, rate.ExchangeRate.IsPositive())
+		}
+	}
+}
@@ -0,0 +1,396 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	furyappparams "github.com/incubus-network/fanfury-sdk/v2/app/params"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/keeper"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgAggregateExchangeRatePrevote = "op_weight_msg_aggregate_exchange_rate_prevote"
+	OpWeightMsgAggregateExchangeRateVote    = "op_weight_msg_aggregate_exchange_rate_vote"
+	OpWeightMsgDelegateFeedConsent          = "op_weight_msg_delegate_feed_consent"
+	OpWeightMsgAddFundsToRewardPool         = "op_weight_msg_add_funds_to_reward_pool"
+
+	saltLength = 32 // 64 hex characters
+)
+
+// pendingVote is the vote a validator committed to with its prevote, revealed
+// in the following vote period.
+type pendingVote struct {
+	salt          string
+	exchangeRates string
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgAggregateExchangeRatePrevote int
+		weightMsgAggregateExchangeRateVote    int
+		weightMsgDelegateFeedConsent          int
+		weightMsgAddFundsToRewardPool         int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAggregateExchangeRatePrevote, &weightMsgAggregateExchangeRatePrevote, nil,
+		func(_ *rand.Rand) {
+			weightMsgAggregateExchangeRatePrevote = furyappparams.DefaultWeightMsgAggregateExchangeRatePrevote
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAggregateExchangeRateVote, &weightMsgAggregateExchangeRateVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgAggregateExchangeRateVote = furyappparams.DefaultWeightMsgAggregateExchangeRateVote
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateFeedConsent, &weightMsgDelegateFeedConsent, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegateFeedConsent = furyappparams.DefaultWeightMsgDelegateFeedConsent
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddFundsToRewardPool, &weightMsgAddFundsToRewardPool, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddFundsToRewardPool = furyappparams.DefaultWeightMsgAddFundsToRewardPool
+		},
+	)
+
+	// the prevotes submitted during the simulation, by validator
+	pendingVotes := make(map[string]pendingVote)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAggregateExchangeRatePrevote,
+			SimulateMsgAggregateExchangeRatePrevote(ak, bk, k, pendingVotes),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAggregateExchangeRateVote,
+			SimulateMsgAggregateExchangeRateVote(ak, bk, k, pendingVotes),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegateFeedConsent,
+			SimulateMsgDelegateFeedConsent(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddFundsToRewardPool,
+			SimulateMsgAddFundsToRewardPool(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgAggregateExchangeRatePrevote generates a MsgAggregateExchangeRatePrevote
+// of a random bonded validator, and schedules the reveal of its vote in the
+// following vote period.
+func SimulateMsgAggregateExchangeRatePrevote(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, pendingVotes map[string]pendingVote,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRatePrevote, "no bonded validator"), nil, nil
+		}
+
+		valAddr := validators[r.Intn(len(validators))].GetOperator()
+
+		if k.HasAggregateExchangeRatePrevote(ctx, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRatePrevote, "prevote already submitted"), nil, nil
+		}
+
+		feederAddr, err := k.GetFeederDelegation(ctx, valAddr)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRatePrevote, "unable to get feeder"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, feederAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRatePrevote, "unable to find feeder account"), nil, nil
+		}
+
+		salt := randomSalt(r)
+		exchangeRates := randomExchangeRates(r, ctx, k)
+
+		msg := types.NewMsgAggregateExchangeRatePrevote(
+			types.GetAggregateVoteHash(salt, exchangeRates, valAddr), feederAddr, valAddr,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         furyappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil {
+			return opMsg, nil, err
+		}
+
+		pendingVotes[valAddr.String()] = pendingVote{salt: salt, exchangeRates: exchangeRates}
+
+		// reveal the vote at a random height of the following vote period
+		votePeriod := k.GetVotePeriod(ctx)
+		revealHeight := (uint64(ctx.BlockHeight())/votePeriod+1)*votePeriod + uint64(r.Int63n(int64(votePeriod)))
+
+		futureOps := []simtypes.FutureOperation{{
+			BlockHeight: int(revealHeight),
+			Op:          simulateReveal(ak, bk, k, pendingVotes, valAddr),
+		}}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgAggregateExchangeRateVote generates a MsgAggregateExchangeRateVote
+// revealing the pending vote of a random validator.
+func SimulateMsgAggregateExchangeRateVote(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, pendingVotes map[string]pendingVote,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if len(pendingVotes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "no pending vote"), nil, nil
+		}
+
+		// sort the validators to keep the simulation deterministic
+		voters := make([]string, 0, len(pendingVotes))
+		for voter := range pendingVotes {
+			voters = append(voters, voter)
+		}
+		sort.Strings(voters)
+
+		valAddr, err := sdk.ValAddressFromBech32(voters[r.Intn(len(voters))])
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "invalid validator address"), nil, err
+		}
+
+		return simulateReveal(ak, bk, k, pendingVotes, valAddr)(r, app, ctx, accs, chainID)
+	}
+}
+
+// simulateReveal generates a MsgAggregateExchangeRateVote revealing the pending
+// vote of the given validator, as long as the current block is in the vote
+// period following its prevote.
+func simulateReveal(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, pendingVotes map[string]pendingVote, valAddr sdk.ValAddress,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		vote, found := pendingVotes[valAddr.String()]
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "no pending vote"), nil, nil
+		}
+
+		prevote, err := k.GetAggregateExchangeRatePrevote(ctx, valAddr)
+		if err != nil || prevote.Hash != types.GetAggregateVoteHash(vote.salt, vote.exchangeRates, valAddr).String() {
+			// the prevote was already revealed or cleared at the end of its vote period
+			delete(pendingVotes, valAddr.String())
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "prevote not found"), nil, nil
+		}
+
+		votePeriod := k.GetVotePeriod(ctx)
+		switch uint64(ctx.BlockHeight())/votePeriod - prevote.SubmitBlock/votePeriod {
+		case 0:
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "reveal period not started"), nil, nil
+		case 1:
+		default:
+			delete(pendingVotes, valAddr.String())
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "reveal period passed"), nil, nil
+		}
+
+		feederAddr, err := k.GetFeederDelegation(ctx, valAddr)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "unable to get feeder"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, feederAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "unable to find feeder account"), nil, nil
+		}
+
+		msg := types.NewMsgAggregateExchangeRateVote(vote.salt, vote.exchangeRates, feederAddr, valAddr)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         furyappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		opMsg, futureOps, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err == nil {
+			delete(pendingVotes, valAddr.String())
+		}
+
+		return opMsg, futureOps, err
+	}
+}
+
+// SimulateMsgDelegateFeedConsent generates a MsgDelegateFeedConsent of a random
+// bonded validator to a random account.
+func SimulateMsgDelegateFeedConsent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateFeedConsent, "no bonded validator"), nil, nil
+		}
+
+		valAddr := validators[r.Intn(len(validators))].GetOperator()
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateFeedConsent, "unable to find operator account"), nil, nil
+		}
+
+		delegate, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgDelegateFeedConsent(valAddr, delegate.Address)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         furyappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgAddFundsToRewardPool generates a MsgAddFundsToRewardPool with
+// random funds of a reward denom, reserved for a random reward schedule half of
+// the time.
+func SimulateMsgAddFundsToRewardPool(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		rewardDenoms := k.GetRewardDenoms(ctx)
+		if len(rewardDenoms) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddFundsToRewardPool, "no reward denom"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		denom := rewardDenoms[r.Intn(len(rewardDenoms))]
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if !spendable.AmountOf(denom).IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddFundsToRewardPool, "insufficient funds"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, spendable.AmountOf(denom))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddFundsToRewardPool, "unable to generate amount"), nil, err
+		}
+
+		funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
+		msg := types.NewMsgAddFundsToRewardPool(simAccount.Address, funds)
+
+		if r.Intn(2) == 0 {
+			periods := simtypes.RandIntBetween(r, 1, 100)
+
+			amountPerPeriod := amount.QuoRaw(int64(periods))
+			if !amountPerPeriod.IsPositive() {
+				amountPerPeriod = sdk.OneInt()
+			}
+
+			msg = types.NewMsgAddFundsToRewardSchedule(simAccount.Address, funds, types.RewardSchedulePlan{
+				EndHeight:       uint64(ctx.BlockHeight()) + uint64(periods)*k.GetVotePeriod(ctx),
+				AmountPerPeriod: sdk.NewCoins(sdk.NewCoin(denom, amountPerPeriod)),
+			})
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           furyappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: funds,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomSalt returns a random salt of 64 hex characters.
+func randomSalt(r *rand.Rand) string {
+	bz := make([]byte, saltLength)
+	r.Read(bz)
+
+	return hex.EncodeToString(bz)
+}
+
+// randomExchangeRates returns the exchange rates of the accept list, moved
+// randomly by up to 1% from the current exchange rates.
+func randomExchangeRates(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) string {
+	acceptList := k.GetAcceptList(ctx)
+	tuples := make([]string, 0, len(acceptList))
+
+	for _, denom := range acceptList {
+		exchangeRate, err := k.GetExchangeRate(ctx, denom.SymbolDenom)
+		if err != nil {
+			exchangeRate = GenExchangeRate(r)
+		}
+
+		move := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, -100, 101)), 4)
+		exchangeRate = exchangeRate.Add(exchangeRate.Mul(move))
+
+		if !exchangeRate.IsPositive() {
+			exchangeRate = GenExchangeRate(r)
+		}
+
+		tuples = append(tuples, fmt.Sprintf("%s:%s", strings.ToUpper(denom.SymbolDenom), exchangeRate))
+	}
+
+	return strings.Join(tuples, ",")
+}
@@ -0,0 +1,180 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	furyapp "github.com/incubus-network/fanfury-sdk/v2/app"
+	furyappparams "github.com/incubus-network/fanfury-sdk/v2/app/params"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/simulation"
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+// TestWeightedOperations tests the weights of the operations.
+func TestWeightedOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	app, ctx, accs := createTestApp(t, r, 3)
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{furyappparams.DefaultWeightMsgAggregateExchangeRatePrevote, types.ModuleName, types.TypeMsgAggregateExchangeRatePrevote},
+		{furyappparams.DefaultWeightMsgAggregateExchangeRateVote, types.ModuleName, types.TypeMsgAggregateExchangeRateVote},
+		{furyappparams.DefaultWeightMsgDelegateFeedConsent, types.ModuleName, types.TypeMsgDelegateFeedConsent},
+		{furyappparams.DefaultWeightMsgAddFundsToRewardPool, types.ModuleName, types.TypeMsgAddFundsToRewardPool},
+	}
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.OracleKeeper)
+	require.Len(t, weightedOps, len(expected))
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		require.NoError(t, err)
+
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(t, expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(t, expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(t, expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+// TestSimulateMsgAggregateExchangeRateVote tests that a prevote is revealed in
+// the vote period following it.
+func TestSimulateMsgAggregateExchangeRateVote(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	app, ctx, accs := createTestApp(t, r, 3)
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.VotePeriod = 2
+	app.OracleKeeper.SetParams(ctx, params)
+
+	weightedOps := simulation.WeightedOperations(
+		make(simtypes.AppParams), app.AppCodec(), app.AccountKeeper, app.BankKeeper, app.OracleKeeper,
+	)
+	prevoteOp, voteOp := weightedOps[0].Op(), weightedOps[1].Op()
+
+	operationMsg, futureOps, err := prevoteOp(r, app.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Len(t, futureOps, 1)
+
+	// the vote is revealed in the following vote period
+	revealHeight := int64(futureOps[0].BlockHeight)
+	require.GreaterOrEqual(t, revealHeight, int64(4))
+	require.Less(t, revealHeight, int64(6))
+
+	operationMsg, _, err = voteOp(r, app.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, "reveal period not started", operationMsg.Comment)
+
+	for ctx.BlockHeight() < revealHeight {
+		ctx = nextBlock(app)
+	}
+
+	operationMsg, _, err = futureOps[0].Op(r, app.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	var msg types.MsgAggregateExchangeRateVote
+	types.ModuleCdc.MustUnmarshalJSON(operationMsg.Msg, &msg)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	require.NoError(t, err)
+
+	_, err = app.OracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, app.OracleKeeper.HasAggregateExchangeRatePrevote(ctx, valAddr))
+
+	// the vote is revealed only once
+	operationMsg, _, err = voteOp(r, app.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+}
+
+// TestSimulateMsgAddFundsToRewardPool tests the normal scenario of a valid
+// message of type types.MsgAddFundsToRewardPool.
+func TestSimulateMsgAddFundsToRewardPool(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	app, ctx, accs := createTestApp(t, r, 3)
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.RewardDenoms = []string{sdk.DefaultBondDenom}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	op := simulation.SimulateMsgAddFundsToRewardPool(app.AccountKeeper, app.BankKeeper, app.OracleKeeper)
+
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	var msg types.MsgAddFundsToRewardPool
+	types.ModuleCdc.MustUnmarshalJSON(operationMsg.Msg, &msg)
+
+	moduleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, moduleAddr).IsAllGTE(msg.Funds))
+}
+
+// createTestApp returns an app whose first account operates its only
+// validator, along with the context of its current block.
+func createTestApp(t *testing.T, r *rand.Rand, n int) (*furyapp.FuryApp, sdk.Context, []simtypes.Account) {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	tmPk, err := cryptocodec.ToTmPubKeyInterface(accounts[0].PubKey)
+	require.NoError(t, err)
+
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(tmPk, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	app := furyapp.SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 200)))
+
+	for _, account := range accounts {
+		if app.AccountKeeper.GetAccount(ctx, account.Address) == nil {
+			app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, account.Address))
+		}
+		require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, account.Address, initCoins))
+	}
+
+	return app, ctx, accounts
+}
+
+// nextBlock commits the current block and begins the next one.
+func nextBlock(app *furyapp.FuryApp) sdk.Context {
+	height := app.LastBlockHeight() + 1
+
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+
+	header := tmproto.Header{Height: height + 1, AppHash: app.LastCommitID().Hash}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	return app.BaseApp.NewContext(false, header)
+}
@@ -0,0 +1,201 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Names of the aggregation strategies a denom of the accept list can select.
+const (
+	AggregationWeightedMedian    = "weighted_median"
+	AggregationTrimmedMean       = "trimmed_mean"
+	AggregationMADFilteredMedian = "mad_filtered_median"
+)
+
+// Default parameters of the aggregation strategies
+var (
+	// DefaultTrimFraction trims a quarter of the voting power from each end of
+	// the ballot, i.e. the trimmed mean is the interquartile mean.
+	DefaultTrimFraction = sdk.NewDecWithPrec(25, 2)
+	// DefaultMADThreshold discards the votes deviating from the median by more
+	// than three median absolute deviations.
+	DefaultMADThreshold = sdk.NewDec(3)
+)
+
+// AggregationStrategy aggregates the exchange rates of a ballot into the
+// exchange rate of its denom.
+type AggregationStrategy interface {
+	// Name returns the name the strategy is selected by.
+	Name() string
+	// Aggregate returns the aggregated exchange rate of the ballot.
+	// CONTRACT: The ballot must be sorted.
+	Aggregate(ballot ExchangeRateBallot) (sdk.Dec, error)
+}
+
+// GetAggregationStrategy returns the aggregation strategy of the given name.
+// The empty name selects the weighted median.
+func GetAggregationStrategy(name string) (AggregationStrategy, error) {
+	switch name {
+	case "", AggregationWeightedMedian:
+		return WeightedMedianStrategy{}, nil
+	case AggregationTrimmedMean:
+		return NewTrimmedMeanStrategy(DefaultTrimFraction), nil
+	case AggregationMADFilteredMedian:
+		return NewMADFilteredMedianStrategy(DefaultMADThreshold), nil
+	default:
+		return nil, fmt.Errorf("unknown aggregation strategy: %s", name)
+	}
+}
+
+// WeightedMedianStrategy aggregates a ballot into its median weighted by the
+// power of the votes.
+type WeightedMedianStrategy struct{}
+
+var _ AggregationStrategy = WeightedMedianStrategy{}
+
+// Name implements AggregationStrategy
+func (WeightedMedianStrategy) Name() string { return AggregationWeightedMedian }
+
+// Aggregate implements AggregationStrategy
+func (WeightedMedianStrategy) Aggregate(ballot ExchangeRateBallot) (sdk.Dec, error) {
+	return ballot.WeightedMedian()
+}
+
+// TrimmedMeanStrategy aggregates a ballot into the mean of its exchange rates
+// weighted by the power of the votes, after trimming a fraction of the voting
+// power from each end of the ballot. Votes straddling a cut count with their
+// untrimmed power only. Abstaining votes are ignored.
+type TrimmedMeanStrategy struct {
+	TrimFraction sdk.Dec
+}
+
+var _ AggregationStrategy = TrimmedMeanStrategy{}
+
+// NewTrimmedMeanStrategy creates a TrimmedMeanStrategy instance trimming the
+// given fraction, in [0, 0.5), of the voting power from each end.
+func NewTrimmedMeanStrategy(trimFraction sdk.Dec) TrimmedMeanStrategy {
+	return TrimmedMeanStrategy{TrimFraction: trimFraction}
+}
+
+// Name implements AggregationStrategy
+func (TrimmedMeanStrategy) Name() string { return AggregationTrimmedMean }
+
+// Aggregate implements AggregationStrategy
+func (s TrimmedMeanStrategy) Aggregate(ballot ExchangeRateBallot) (sdk.Dec, error) {
+	if !sort.IsSorted(ballot) {
+		return sdk.ZeroDec(), ErrBallotNotSorted
+	}
+
+	votes := ballot.withoutAbstains()
+
+	totalPower := votes.Power()
+	if totalPower == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
+	lower := sdk.NewDec(totalPower).Mul(s.TrimFraction)
+	upper := sdk.NewDec(totalPower).Sub(lower)
+
+	var (
+		sum    = sdk.ZeroDec()
+		weight = sdk.ZeroDec()
+		pivot  = sdk.ZeroDec()
+	)
+
+	for _, v := range votes {
+		start := pivot
+		pivot = pivot.Add(sdk.NewDec(v.Power))
+
+		untrimmed := sdk.MinDec(pivot, upper).Sub(sdk.MaxDec(start, lower))
+		if !untrimmed.IsPositive() {
+			continue
+		}
+
+		sum = sum.Add(v.ExchangeRate.Mul(untrimmed))
+		weight = weight.Add(untrimmed)
+	}
+
+	// nothing is left if at least half of the power is trimmed from each end
+	if weight.IsZero() {
+		return votes.WeightedMedian()
+	}
+
+	return sum.Quo(weight), nil
+}
+
+// MADFilteredMedianStrategy aggregates a ballot into its weighted median after
+// discarding the outliers, i.e. the votes deviating from the weighted median
+// by more than a threshold times the weighted median absolute deviation (MAD).
+// Abstaining votes are ignored.
+type MADFilteredMedianStrategy struct {
+	Threshold sdk.Dec
+}
+
+var _ AggregationStrategy = MADFilteredMedianStrategy{}
+
+// NewMADFilteredMedianStrategy creates a MADFilteredMedianStrategy instance
+// discarding the votes deviating by more than threshold MADs.
+func NewMADFilteredMedianStrategy(threshold sdk.Dec) MADFilteredMedianStrategy {
+	return MADFilteredMedianStrategy{Threshold: threshold}
+}
+
+// Name implements AggregationStrategy
+func (MADFilteredMedianStrategy) Name() string { return AggregationMADFilteredMedian }
+
+// Aggregate implements AggregationStrategy
+func (s MADFilteredMedianStrategy) Aggregate(ballot ExchangeRateBallot) (sdk.Dec, error) {
+	if !sort.IsSorted(ballot) {
+		return sdk.ZeroDec(), ErrBallotNotSorted
+	}
+
+	votes := ballot.withoutAbstains()
+	if len(votes) == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
+	median, err := votes.WeightedMedian()
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	deviations := make(ExchangeRateBallot, len(votes))
+	for i, v := range votes {
+		deviations[i] = v
+		deviations[i].ExchangeRate = v.ExchangeRate.Sub(median).Abs()
+	}
+
+	sort.Sort(deviations)
+
+	mad, err := deviations.WeightedMedian()
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	limit := mad.Mul(s.Threshold)
+
+	// filtering keeps the ballot sorted
+	filtered := make(ExchangeRateBallot, 0, len(votes))
+	for _, v := range votes {
+		if v.ExchangeRate.Sub(median).Abs().LTE(limit) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered.WeightedMedian()
+}
+
+// withoutAbstains returns the votes of the ballot with a positive exchange
+// rate, keeping their order.
+func (pb ExchangeRateBallot) withoutAbstains() ExchangeRateBallot {
+	votes := make(ExchangeRateBallot, 0, len(pb))
+
+	for _, v := range pb {
+		if v.ExchangeRate.IsPositive() {
+			votes = append(votes, v)
+		}
+	}
+
+	return votes
+}
@@ -0,0 +1,232 @@
+package types
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBallot(rates []string, powers []int64) ExchangeRateBallot {
+	ballot := ExchangeRateBallot{}
+	for i, rate := range rates {
+		ballot = append(ballot, NewVoteForTally(
+			sdk.MustNewDecFromStr(rate),
+			PersistenceDenom,
+			sdk.ValAddress(fmt.Sprintf("validator%d", i)),
+			powers[i],
+		))
+	}
+
+	sort.Sort(ballot)
+
+	return ballot
+}
+
+func TestGetAggregationStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		err      bool
+	}{
+		{"", AggregationWeightedMedian, false},
+		{AggregationWeightedMedian, AggregationWeightedMedian, false},
+		{AggregationTrimmedMean, AggregationTrimmedMean, false},
+		{AggregationMADFilteredMedian, AggregationMADFilteredMedian, false},
+		{"mean", "", true},
+	}
+
+	for _, tc := range tests {
+		strategy, err := GetAggregationStrategy(tc.name)
+		if tc.err {
+			require.Error(t, err)
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, strategy.Name())
+	}
+}
+
+func TestWeightedMedianStrategy(t *testing.T) {
+	ballot := newTestBallot([]string{"1.0", "2.0", "3.0", "100.0"}, []int64{1, 1, 1, 1})
+
+	expected, err := ballot.WeightedMedian()
+	require.NoError(t, err)
+
+	rate, err := WeightedMedianStrategy{}.Aggregate(ballot)
+	require.NoError(t, err)
+	require.Equal(t, expected, rate)
+}
+
+func TestTrimmedMeanStrategy(t *testing.T) {
+	tests := []struct {
+		rates        []string
+		powers       []int64
+		trimFraction sdk.Dec
+		expected     sdk.Dec
+	}{
+		{
+			// the outlier is trimmed
+			[]string{"1.0", "2.0", "3.0", "100.0"},
+			[]int64{1, 1, 1, 1},
+			DefaultTrimFraction,
+			sdk.MustNewDecFromStr("2.5"),
+		},
+		{
+			// abstaining votes are ignored
+			[]string{"0.0", "1.0", "2.0", "3.0", "4.0"},
+			[]int64{100, 1, 1, 1, 1},
+			DefaultTrimFraction,
+			sdk.MustNewDecFromStr("2.5"),
+		},
+		{
+			// a vote straddling a cut counts with its untrimmed power only
+			[]string{"1.0", "2.0", "4.0"},
+			[]int64{2, 1, 1},
+			DefaultTrimFraction,
+			sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			// nothing trimmed is the weighted mean
+			[]string{"1.0", "5.0"},
+			[]int64{1, 3},
+			sdk.ZeroDec(),
+			sdk.MustNewDecFromStr("4.0"),
+		},
+		{
+			// everything trimmed falls back to the weighted median
+			[]string{"1.0", "2.0", "3.0"},
+			[]int64{1, 1, 1},
+			sdk.NewDecWithPrec(5, 1),
+			sdk.MustNewDecFromStr("1.0"),
+		},
+		{
+			// empty ballot
+			[]string{},
+			[]int64{},
+			DefaultTrimFraction,
+			sdk.ZeroDec(),
+		},
+	}
+
+	for i, tc := range tests {
+		ballot := newTestBallot(tc.rates, tc.powers)
+
+		rate, err := NewTrimmedMeanStrategy(tc.trimFraction).Aggregate(ballot)
+		require.NoError(t, err, "test case %d", i)
+		require.Equal(t, tc.expected, rate, "test case %d", i)
+	}
+}
+
+func TestMADFilteredMedianStrategy(t *testing.T) {
+	tests := []struct {
+		rates     []string
+		powers    []int64
+		threshold sdk.Dec
+		expected  sdk.Dec
+	}{
+		{
+			// the outliers are discarded before taking the median
+			[]string{"1.0", "2.0", "3.0", "100.0", "101.0"},
+			[]int64{1, 1, 1, 1, 1},
+			DefaultMADThreshold,
+			sdk.MustNewDecFromStr("1.0"),
+		},
+		{
+			// a large threshold keeps every vote
+			[]string{"1.0", "2.0", "3.0", "100.0", "101.0"},
+			[]int64{1, 1, 1, 1, 1},
+			sdk.NewDec(1000),
+			sdk.MustNewDecFromStr("2.0"),
+		},
+		{
+			// a zero MAD keeps the votes equal to the median only
+			[]string{"1.0", "1.0", "1.0", "7.0"},
+			[]int64{1, 1, 1, 1},
+			DefaultMADThreshold,
+			sdk.MustNewDecFromStr("1.0"),
+		},
+		{
+			// abstaining votes are ignored
+			[]string{"0.0", "2.0", "3.0", "4.0"},
+			[]int64{100, 1, 1, 1},
+			DefaultMADThreshold,
+			sdk.MustNewDecFromStr("2.0"),
+		},
+		{
+			// empty ballot
+			[]string{},
+			[]int64{},
+			DefaultMADThreshold,
+			sdk.ZeroDec(),
+		},
+	}
+
+	for i, tc := range tests {
+		ballot := newTestBallot(tc.rates, tc.powers)
+
+		rate, err := NewMADFilteredMedianStrategy(tc.threshold).Aggregate(ballot)
+		require.NoError(t, err, "test case %d", i)
+		require.Equal(t, tc.expected, rate, "test case %d", i)
+	}
+}
+
+func TestAggregationStrategyUnsorted(t *testing.T) {
+	ballot := newTestBallot([]string{"1.0", "2.0", "3.0"}, []int64{1, 1, 1})
+	ballot.Swap(0, 2)
+
+	for _, name := range []string{AggregationWeightedMedian, AggregationTrimmedMean, AggregationMADFilteredMedian} {
+		strategy, err := GetAggregationStrategy(name)
+		require.NoError(t, err)
+
+		_, err = strategy.Aggregate(ballot)
+		require.ErrorIs(t, err, ErrBallotNotSorted, name)
+	}
+}
+
+func benchmarkAggregationStrategy(b *testing.B, name string) {
+	for _, validators := range []int{100, 1000, 10000} {
+		r := rand.New(rand.NewSource(int64(validators)))
+
+		ballot := make(ExchangeRateBallot, validators)
+		for i := range ballot {
+			ballot[i] = NewVoteForTally(
+				sdk.NewDecWithPrec(r.Int63n(1_000_000)+1, 3),
+				PersistenceDenom,
+				sdk.ValAddress(fmt.Sprintf("validator%d", i)),
+				r.Int63n(1_000_000)+1,
+			)
+		}
+
+		sort.Sort(ballot)
+
+		strategy, err := GetAggregationStrategy(name)
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("validators=%d", validators), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := strategy.Aggregate(ballot); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkWeightedMedianStrategy(b *testing.B) {
+	benchmarkAggregationStrategy(b, AggregationWeightedMedian)
+}
+
+func BenchmarkTrimmedMeanStrategy(b *testing.B) {
+	benchmarkAggregationStrategy(b, AggregationTrimmedMean)
+}
+
+func BenchmarkMADFilteredMedianStrategy(b *testing.B) {
+	benchmarkAggregationStrategy(b, AggregationMADFilteredMedian)
+}
@@ -20,6 +20,9 @@ const (
 	AtomDenom    string = "ibc/4A17832B26BF318D052563EFFE677C1DE11DF8CE104F00204860F3E3439818B2"
 	AtomSymbol   string = "ATOM"
 	AtomExponent        = uint32(6)
+
+	// USDSymbol is the implicit quote currency of exchange rates
+	USDSymbol string = "USD"
 )
 
 type (
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// NewHaltedDenom creates a HaltedDenom instance
+func NewHaltedDenom(
+	denom string,
+	haltHeight uint64,
+	exchangeRate sdk.Dec,
+	rejectedExchangeRate sdk.Dec,
+) HaltedDenom {
+	return HaltedDenom{
+		Denom:                denom,
+		HaltHeight:           haltHeight,
+		ExchangeRate:         exchangeRate,
+		RejectedExchangeRate: rejectedExchangeRate,
+	}
+}
+
+// String implement stringify
+func (h HaltedDenom) String() string {
+	out, _ := yaml.Marshal(h)
+	return string(out)
+}
+
+// ExceedsDeviation returns true if the relative change from the last exchange
+// rate to the new one is larger than the maximum deviation.
+func ExceedsDeviation(lastRate, newRate, maxDeviation sdk.Dec) bool {
+	if !lastRate.IsPositive() {
+		return false
+	}
+
+	return newRate.Sub(lastRate).Abs().Quo(lastRate).GT(maxDeviation)
+}
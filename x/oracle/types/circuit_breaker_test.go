@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExceedsDeviation(t *testing.T) {
+	maxDeviation := sdk.NewDecWithPrec(10, 2)
+
+	tests := []struct {
+		name     string
+		lastRate sdk.Dec
+		newRate  sdk.Dec
+		exceeds  bool
+	}{
+		{"unchanged", sdk.NewDec(10), sdk.NewDec(10), false},
+		{"up to the bound", sdk.NewDec(10), sdk.NewDec(11), false},
+		{"down to the bound", sdk.NewDec(10), sdk.NewDec(9), false},
+		{"above the bound", sdk.NewDec(10), sdk.NewDecWithPrec(111, 1), true},
+		{"below the bound", sdk.NewDec(10), sdk.NewDecWithPrec(89, 1), true},
+		{"no last rate", sdk.ZeroDec(), sdk.NewDec(100), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exceeds, ExceedsDeviation(tc.lastRate, tc.newRate, maxDeviation))
+		})
+	}
+}
+
+func TestDenomValidateMaxDeviation(t *testing.T) {
+	denom := DenomPersistence
+
+	maxDeviation := sdk.NewDecWithPrec(20, 2)
+	denom.MaxDeviation = &maxDeviation
+	require.NoError(t, denom.Validate())
+
+	zero := sdk.ZeroDec()
+	denom.MaxDeviation = &zero
+	require.Error(t, denom.Validate())
+}
@@ -32,16 +32,30 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "persistence/oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "persistence/oracle/MsgAggregateExchangeRateVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "persistence/oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&MsgAddFeeder{}, "persistence/oracle/MsgAddFeeder", nil)
+	cdc.RegisterConcrete(&MsgRevokeFeeder{}, "persistence/oracle/MsgRevokeFeeder", nil)
 	cdc.RegisterConcrete(&MsgAddFundsToRewardPool{}, "persistence/oracle/MsgAddFundsToRewardPool", nil)
+	cdc.RegisterConcrete(&MsgAddDenom{}, "persistence/oracle/MsgAddDenom", nil)
+	cdc.RegisterConcrete(&MsgUpdateDenom{}, "persistence/oracle/MsgUpdateDenom", nil)
+	cdc.RegisterConcrete(&MsgRemoveDenom{}, "persistence/oracle/MsgRemoveDenom", nil)
+	cdc.RegisterConcrete(&MsgResetCircuitBreaker{}, "persistence/oracle/MsgResetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgCreateRewardSchedule{}, "persistence/oracle/MsgCreateRewardSchedule", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDelegateFeedConsent{},
+		&MsgAddFeeder{},
+		&MsgRevokeFeeder{},
 		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
 		&MsgAddFundsToRewardPool{},
+		&MsgAddDenom{},
+		&MsgUpdateDenom{},
+		&MsgRemoveDenom{},
+		&MsgResetCircuitBreaker{},
+		&MsgCreateRewardSchedule{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v3"
 )
 
@@ -16,7 +18,72 @@ func (d Denom) String() string {
 func (d Denom) Equal(d1 *Denom) bool {
 	return d.BaseDenom == d1.BaseDenom &&
 		d.SymbolDenom == d1.SymbolDenom &&
-		d.Exponent == d1.Exponent
+		d.Exponent == d1.Exponent &&
+		decPtrEqual(d.VoteThreshold, d1.VoteThreshold) &&
+		decPtrEqual(d.RewardBand, d1.RewardBand) &&
+		d.MinVoters == d1.MinVoters &&
+		decPtrEqual(d.MaxDeviation, d1.MaxDeviation) &&
+		d.QuoteDenom == d1.QuoteDenom &&
+		d.AggregationStrategy == d1.AggregationStrategy &&
+		d.RemotePriceSource.Equal(d1.RemotePriceSource)
+}
+
+// decPtrEqual checks whether two optional decimals are both unset or equal.
+func decPtrEqual(d1, d2 *sdk.Dec) bool {
+	if d1 == nil || d2 == nil {
+		return d1 == d2
+	}
+
+	return d1.Equal(*d2)
+}
+
+// Validate performs a basic validation of the denom fields.
+func (d Denom) Validate() error {
+	if len(d.BaseDenom) == 0 {
+		return fmt.Errorf("oracle parameter AcceptList Denom must have BaseDenom")
+	}
+
+	if len(d.SymbolDenom) == 0 {
+		return fmt.Errorf("oracle parameter AcceptList Denom must have SymbolDenom")
+	}
+
+	if d.VoteThreshold != nil {
+		if err := ValidateVoteThreshold(*d.VoteThreshold); err != nil {
+			return fmt.Errorf("oracle parameter AcceptList Denom %s has invalid VoteThreshold: %w", d.SymbolDenom, err)
+		}
+	}
+
+	if d.RewardBand != nil {
+		if err := validateRewardBand(*d.RewardBand); err != nil {
+			return fmt.Errorf("oracle parameter AcceptList Denom %s has invalid RewardBand: %w", d.SymbolDenom, err)
+		}
+	}
+
+	if d.MaxDeviation != nil && !d.MaxDeviation.IsPositive() {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s MaxDeviation must be positive: %s", d.SymbolDenom, d.MaxDeviation)
+	}
+
+	if strings.EqualFold(d.QuoteDenom, d.SymbolDenom) || strings.EqualFold(d.QuoteDenom, USDSymbol) {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s has invalid QuoteDenom: %s", d.SymbolDenom, d.QuoteDenom)
+	}
+
+	if _, err := GetAggregationStrategy(d.AggregationStrategy); err != nil {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s has invalid AggregationStrategy: %w", d.SymbolDenom, err)
+	}
+
+	if d.RemotePriceSource != nil {
+		if err := d.RemotePriceSource.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter AcceptList Denom %s has invalid RemotePriceSource: %w", d.SymbolDenom, err)
+		}
+
+		if strings.EqualFold(d.RemotePriceSource.QuoteSymbolDenom, d.SymbolDenom) {
+			return fmt.Errorf(
+				"oracle parameter AcceptList Denom %s has RemotePriceSource quoted in itself", d.SymbolDenom,
+			)
+		}
+	}
+
+	return nil
 }
 
 // DenomList is array of Denom
@@ -41,3 +108,80 @@ func (dl DenomList) Contains(symbolDenom string) bool {
 
 	return false
 }
+
+// Find returns the denom with the given SymbolDenom (e.g. XPRT), if any.
+func (dl DenomList) Find(symbolDenom string) (Denom, bool) {
+	for _, d := range dl {
+		if strings.EqualFold(d.SymbolDenom, symbolDenom) {
+			return d, true
+		}
+	}
+
+	return Denom{}, false
+}
+
+// FindByBaseDenom returns the denom with the given BaseDenom (e.g. uxprt), if
+// any.
+func (dl DenomList) FindByBaseDenom(baseDenom string) (Denom, bool) {
+	for _, d := range dl {
+		if d.BaseDenom == baseDenom {
+			return d, true
+		}
+	}
+
+	return Denom{}, false
+}
+
+// ValidateQuoteDenoms checks that the QuoteDenom of every denom is in the list
+// and that following the quote denoms always ends in USD. The remote prices
+// must be quoted in USD or a denom of the list as well.
+func (dl DenomList) ValidateQuoteDenoms() error {
+	for _, d := range dl {
+		if source := d.RemotePriceSource; source != nil &&
+			!strings.EqualFold(source.QuoteSymbolDenom, USDSymbol) && !dl.Contains(source.QuoteSymbolDenom) {
+			return fmt.Errorf(
+				"oracle parameter AcceptList Denom %s has RemotePriceSource QuoteSymbolDenom %s not in the AcceptList",
+				d.SymbolDenom, source.QuoteSymbolDenom,
+			)
+		}
+
+		visited := map[string]bool{}
+
+		for current := d; current.QuoteDenom != ""; {
+			visited[strings.ToUpper(current.SymbolDenom)] = true
+
+			quote, found := dl.Find(current.QuoteDenom)
+			if !found {
+				return fmt.Errorf(
+					"oracle parameter AcceptList Denom %s has QuoteDenom %s not in the AcceptList",
+					current.SymbolDenom, current.QuoteDenom,
+				)
+			}
+
+			if visited[strings.ToUpper(quote.SymbolDenom)] {
+				return fmt.Errorf("oracle parameter AcceptList Denom %s has cyclic QuoteDenom", d.SymbolDenom)
+			}
+
+			current = quote
+		}
+	}
+
+	return nil
+}
+
+// NewDenomMissCounter creates a DenomMissCounter instance
+func NewDenomMissCounter(operator sdk.ValAddress, denom string, missCounter uint64) DenomMissCounter {
+	return DenomMissCounter{
+		ValidatorAddress: operator.String(),
+		Denom:            denom,
+		MissCounter:      missCounter,
+	}
+}
+
+// NewAddedDenom creates an AddedDenom instance
+func NewAddedDenom(denom string, votePeriod uint64) AddedDenom {
+	return AddedDenom{
+		Denom:      denom,
+		VotePeriod: votePeriod,
+	}
+}
@@ -107,3 +107,39 @@ func TestDenomListContains(t *testing.T) {
 		require.Equal(t, testCase.symbolInList, testCase.denomList.Contains(testCase.denomSymbol))
 	}
 }
+
+func TestDenomListValidateQuoteDenoms(t *testing.T) {
+	dl := types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol},
+		{BaseDenom: "ibc/statom", SymbolDenom: "STATOM", QuoteDenom: "atom"},
+	}
+	require.NoError(t, dl.ValidateQuoteDenoms())
+
+	dl = types.DenomList{
+		{BaseDenom: "ibc/statom", SymbolDenom: "STATOM", QuoteDenom: types.AtomSymbol},
+	}
+	require.ErrorContains(t, dl.ValidateQuoteDenoms(), "not in the AcceptList")
+
+	dl = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, QuoteDenom: "STATOM"},
+		{BaseDenom: "ibc/statom", SymbolDenom: "STATOM", QuoteDenom: types.AtomSymbol},
+	}
+	require.ErrorContains(t, dl.ValidateQuoteDenoms(), "cyclic QuoteDenom")
+
+	denom := types.Denom{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, QuoteDenom: "usd"}
+	require.ErrorContains(t, denom.Validate(), "invalid QuoteDenom")
+
+	// the remote prices are quoted in USD or a denom of the list
+	source := newRemotePriceSource(t)
+	source.QuoteSymbolDenom = "STATOM"
+	dl = types.DenomList{
+		{BaseDenom: types.AtomDenom, SymbolDenom: types.AtomSymbol, RemotePriceSource: &source},
+	}
+	require.ErrorContains(t, dl.ValidateQuoteDenoms(), "QuoteSymbolDenom STATOM not in the AcceptList")
+
+	dl = append(dl, types.Denom{BaseDenom: "ibc/statom", SymbolDenom: "STATOM"})
+	require.NoError(t, dl.ValidateQuoteDenoms())
+
+	source.QuoteSymbolDenom = "atom"
+	require.ErrorContains(t, dl[0].Validate(), "quoted in itself")
+}
@@ -18,11 +18,25 @@ var (
 	ErrVerificationFailed    = errors.Register(ModuleName, 7, "hash verification failed")
 	ErrRevealPeriodMissMatch = errors.Register(ModuleName, 8,
 		"reveal period of submitted vote does not match with registered prevote")
-	ErrInvalidSaltLength  = errors.Register(ModuleName, 9, "invalid salt length; must be 64")
-	ErrInvalidSaltFormat  = errors.Register(ModuleName, 10, "invalid salt format")
-	ErrNoAggregatePrevote = errors.Register(ModuleName, 11, "no aggregate prevote")
-	ErrNoAggregateVote    = errors.Register(ModuleName, 12, "no aggregate vote")
-	ErrUnknownDenom       = errors.Register(ModuleName, 13, "unknown denom")
-	ErrExistingPrevote    = errors.Register(ModuleName, 15, "prevote already submitted for this voting period")
-	ErrBallotNotSorted    = errors.Register(ModuleName, 16, "ballot must be sorted before this operation")
+	ErrInvalidSaltLength      = errors.Register(ModuleName, 9, "invalid salt length; must be 64")
+	ErrInvalidSaltFormat      = errors.Register(ModuleName, 10, "invalid salt format")
+	ErrNoAggregatePrevote     = errors.Register(ModuleName, 11, "no aggregate prevote")
+	ErrNoAggregateVote        = errors.Register(ModuleName, 12, "no aggregate vote")
+	ErrUnknownDenom           = errors.Register(ModuleName, 13, "unknown denom")
+	ErrExistingPrevote        = errors.Register(ModuleName, 15, "prevote already submitted for this voting period")
+	ErrBallotNotSorted        = errors.Register(ModuleName, 16, "ballot must be sorted before this operation")
+	ErrNoHistoricRate         = errors.Register(ModuleName, 17, "no historic exchange rate")
+	ErrExistingDenom          = errors.Register(ModuleName, 18, "denom already in the accept list")
+	ErrExpiredRate            = errors.Register(ModuleName, 19, "exchange rate expired")
+	ErrDenomNotHalted         = errors.Register(ModuleName, 20, "denom not halted by the circuit breaker")
+	ErrInvalidQuoteDenom      = errors.Register(ModuleName, 21, "invalid quote denom")
+	ErrOracleJailed           = errors.Register(ModuleName, 22, "validator jailed for missing oracle votes")
+	ErrInvalidRewardSchedule  = errors.Register(ModuleName, 23, "invalid reward schedule")
+	ErrInsufficientRewardPool = errors.Register(ModuleName, 24, "insufficient unscheduled reward pool balance")
+	ErrInvalidFeeder          = errors.Register(ModuleName, 25, "invalid feeder")
+	ErrFeederNotFound         = errors.Register(ModuleName, 26, "feeder not found")
+	ErrInvalidRemotePrice     = errors.Register(ModuleName, 27, "invalid remote price")
+	ErrExistingVote           = errors.Register(ModuleName, 28, "vote already submitted for this voting period")
+	ErrHaltedRate             = errors.Register(ModuleName, 29, "exchange rate halted by the circuit breaker expired")
+	ErrInvalidParams          = errors.Register(ModuleName, 30, "invalid oracle params")
 )
@@ -2,12 +2,28 @@ package types
 
 // Oracle module event types
 const (
-	EventTypeExchangeRateUpdate = "exchange_rate_update"
-	EventTypePrevote            = "prevote"
-	EventTypeVote               = "vote"
-	EventTypeFeedDelegate       = "feed_delegate"
-	EventTypeAggregatePrevote   = "aggregate_prevote"
-	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeExchangeRateUpdate  = "exchange_rate_update"
+	EventTypePrevote             = "prevote"
+	EventTypeVote                = "vote"
+	EventTypeFeedDelegate        = "feed_delegate"
+	EventTypeAggregatePrevote    = "aggregate_prevote"
+	EventTypeAggregateVote       = "aggregate_vote"
+	EventTypeAddDenom            = "add_denom"
+	EventTypeUpdateDenom         = "update_denom"
+	EventTypeRemoveDenom         = "remove_denom"
+	EventTypeCircuitBreakerHalt  = "circuit_breaker_halt"
+	EventTypeCircuitBreakerReset = "circuit_breaker_reset"
+	EventTypeMissWarning         = "miss_warning"
+	EventTypeOracleJail          = "oracle_jail"
+	EventTypeRewardSchedule      = "reward_schedule"
+	EventTypeRewardScheduleEnd   = "reward_schedule_end"
+	EventTypeFeeShare            = "fee_share"
+	EventTypeTallyResult         = "tally_result"
+	EventTypeAddFeeder           = "add_feeder"
+	EventTypeRevokeFeeder        = "revoke_feeder"
+	EventTypeRemotePrice         = "remote_price"
+	EventTypeRemotePriceFallback = "remote_price_fallback"
+	EventTypeRemotePriceReject   = "remote_price_reject"
 
 	EventAttrKeyDenom         = "denom"
 	EventAttrKeyVoter         = "voter"
@@ -15,5 +31,33 @@ const (
 	EventAttrKeyExchangeRates = "exchange_rates"
 	EventAttrKeyOperator      = "operator"
 	EventAttrKeyFeeder        = "feeder"
+	EventAttrKeyBaseDenom     = "base_denom"
+	EventAttrKeyExponent      = "exponent"
+	EventAttrKeyRejectedRate  = "rejected_exchange_rate"
+	EventAttrKeyMaxDeviation  = "max_deviation"
+	EventAttrKeyHeight        = "height"
+	EventAttrKeyMissCounter   = "miss_counter"
+	EventAttrKeyMaxMisses     = "max_misses"
+	EventAttrKeyPower         = "power"
+	EventAttrKeyBurnedCoins   = "burned_coins"
+	EventAttrKeyJailedUntil   = "jailed_until"
+	EventAttrKeyScheduleID    = "schedule_id"
+	EventAttrKeyFunder        = "funder"
+	EventAttrKeyStartHeight   = "start_height"
+	EventAttrKeyEndHeight     = "end_height"
+	EventAttrKeyAmount        = "amount_per_period"
+	EventAttrKeyFunds         = "funds"
+	EventAttrKeyFeeShare      = "fee_share"
+	EventAttrKeyVotePeriod    = "vote_period"
+	EventAttrKeyMedian        = "median"
+	EventAttrKeyStdDev        = "standard_deviation"
+	EventAttrKeyRewardSpread  = "reward_spread"
+	EventAttrKeySupportRatio  = "support_ratio"
+	EventAttrKeyVoterCount    = "voter_count"
+	EventAttrKeyWinnerCount   = "winner_count"
+	EventAttrKeyExpiryHeight  = "expiry_height"
+	EventAttrKeyDenoms        = "denoms"
+	EventAttrKeyPrice         = "price"
+	EventAttrKeyRemoteHeight  = "remote_height"
 	EventAttrValueCategory    = ModuleName
 )
@@ -1,11 +1,16 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icqtypes "github.com/incubus-network/fanfury-sdk/v2/x/interchainquery/types"
+	slashingtypes "github.com/incubus-network/fanfury-sdk/v2/x/lsnative/slashing/types"
 )
 
 // StakingKeeper defines the expected interface contract defined by the x/staking
@@ -21,6 +26,13 @@ type StakingKeeper interface {
 	PowerReduction(ctx sdk.Context) (res math.Int)
 }
 
+// SlashingKeeper defines the expected interface contract defined by the
+// x/slashing module.
+type SlashingKeeper interface {
+	GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+}
+
 // DistributionKeeper defines the expected interface contract defined by the
 // x/distribution module.
 type DistributionKeeper interface {
@@ -51,3 +63,12 @@ type BankKeeper interface {
 	// only used for simulation
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// InterchainQueryKeeper defines the expected interface contract defined by the
+// x/interchainquery module.
+type InterchainQueryKeeper interface {
+	MakeRequest(ctx sdk.Context, connectionID, chainID, queryType string, request []byte, period math.Int, module, callbackID string, ttl uint64)
+	IterateQueries(ctx sdk.Context, fn func(index int64, queryInfo icqtypes.Query) (stop bool))
+	DeleteQuery(ctx sdk.Context, id string)
+	GetDatapointForID(ctx sdk.Context, id string) (icqtypes.DataPoint, error)
+}
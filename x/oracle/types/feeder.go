@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// MaxFeedersPerValidator is the maximum number of feeders a validator can
+// register in addition to its feeder delegation.
+const MaxFeedersPerValidator = 10
+
+// NewFeeder creates a Feeder instance
+func NewFeeder(validator sdk.ValAddress, address sdk.AccAddress, expiryHeight uint64, denoms []string) Feeder {
+	return Feeder{
+		Validator:    validator.String(),
+		Address:      address.String(),
+		ExpiryHeight: expiryHeight,
+		Denoms:       denoms,
+	}
+}
+
+// String implement stringify
+func (f Feeder) String() string {
+	out, _ := yaml.Marshal(f)
+	return string(out)
+}
+
+// IsActive returns true if the feeder has not expired at the given height.
+func (f Feeder) IsActive(height uint64) bool {
+	return f.ExpiryHeight == 0 || height < f.ExpiryHeight
+}
+
+// CoversDenom returns true if the feeder is allowed to vote on the given
+// symbol denom.
+func (f Feeder) CoversDenom(denom string) bool {
+	if len(f.Denoms) == 0 {
+		return true
+	}
+
+	for _, d := range f.Denoms {
+		if strings.EqualFold(d, denom) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateFeederDenoms validates the denoms a feeder is restricted to.
+func ValidateFeederDenoms(denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+
+	for _, denom := range denoms {
+		if strings.TrimSpace(denom) == "" {
+			return errors.Wrap(ErrInvalidFeeder, "denom must not be empty")
+		}
+
+		upper := strings.ToUpper(denom)
+		if seen[upper] {
+			return errors.Wrapf(ErrInvalidFeeder, "duplicate denom: %s", denom)
+		}
+
+		seen[upper] = true
+	}
+
+	return nil
+}
@@ -14,6 +14,17 @@ func NewGenesisState(
 	missCounters []MissCounter,
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
+	historicExchangeRates []HistoricExchangeRate,
+	exchangeRateMetadata []ExchangeRateMetadata,
+	haltedDenoms []HaltedDenom,
+	validatorPerformances []ValidatorPerformance,
+	oracleJails []OracleJail,
+	rewardSchedules []RewardSchedule,
+	tallyResults []TallyResult,
+	feeders []Feeder,
+	remotePrices []RemotePrice,
+	denomMissCounters []DenomMissCounter,
+	addedDenoms []AddedDenom,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -22,6 +33,17 @@ func NewGenesisState(
 		MissCounters:                  missCounters,
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		HistoricExchangeRates:         historicExchangeRates,
+		ExchangeRateMetadata:          exchangeRateMetadata,
+		HaltedDenoms:                  haltedDenoms,
+		ValidatorPerformances:         validatorPerformances,
+		OracleJails:                   oracleJails,
+		RewardSchedules:               rewardSchedules,
+		TallyResults:                  tallyResults,
+		Feeders:                       feeders,
+		RemotePrices:                  remotePrices,
+		DenomMissCounters:             denomMissCounters,
+		AddedDenoms:                   addedDenoms,
 	}
 }
 
@@ -35,6 +57,17 @@ func DefaultGenesisState() *GenesisState {
 		MissCounters:                  []MissCounter{},
 		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
 		AggregateExchangeRateVotes:    []AggregateExchangeRateVote{},
+		HistoricExchangeRates:         []HistoricExchangeRate{},
+		ExchangeRateMetadata:          []ExchangeRateMetadata{},
+		HaltedDenoms:                  []HaltedDenom{},
+		ValidatorPerformances:         []ValidatorPerformance{},
+		OracleJails:                   []OracleJail{},
+		RewardSchedules:               []RewardSchedule{},
+		TallyResults:                  []TallyResult{},
+		Feeders:                       []Feeder{},
+		RemotePrices:                  []RemotePrice{},
+		DenomMissCounters:             []DenomMissCounter{},
+		AddedDenoms:                   []AddedDenom{},
 	}
 }
 
@@ -32,6 +32,17 @@ type GenesisState struct {
 	MissCounters                  []MissCounter                  `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	HistoricExchangeRates         []HistoricExchangeRate         `protobuf:"bytes,7,rep,name=historic_exchange_rates,json=historicExchangeRates,proto3" json:"historic_exchange_rates"`
+	ExchangeRateMetadata          []ExchangeRateMetadata         `protobuf:"bytes,8,rep,name=exchange_rate_metadata,json=exchangeRateMetadata,proto3" json:"exchange_rate_metadata"`
+	HaltedDenoms                  []HaltedDenom                  `protobuf:"bytes,9,rep,name=halted_denoms,json=haltedDenoms,proto3" json:"halted_denoms"`
+	ValidatorPerformances         []ValidatorPerformance         `protobuf:"bytes,10,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
+	OracleJails                   []OracleJail                   `protobuf:"bytes,11,rep,name=oracle_jails,json=oracleJails,proto3" json:"oracle_jails"`
+	RewardSchedules               []RewardSchedule               `protobuf:"bytes,12,rep,name=reward_schedules,json=rewardSchedules,proto3" json:"reward_schedules"`
+	TallyResults                  []TallyResult                  `protobuf:"bytes,13,rep,name=tally_results,json=tallyResults,proto3" json:"tally_results"`
+	Feeders                       []Feeder                       `protobuf:"bytes,14,rep,name=feeders,proto3" json:"feeders"`
+	RemotePrices                  []RemotePrice                  `protobuf:"bytes,15,rep,name=remote_prices,json=remotePrices,proto3" json:"remote_prices"`
+	DenomMissCounters             []DenomMissCounter             `protobuf:"bytes,16,rep,name=denom_miss_counters,json=denomMissCounters,proto3" json:"denom_miss_counters"`
+	AddedDenoms                   []AddedDenom                   `protobuf:"bytes,17,rep,name=added_denoms,json=addedDenoms,proto3" json:"added_denoms"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +120,83 @@ func (m *GenesisState) GetAggregateExchangeRateVotes() []AggregateExchangeRateVo
 	return nil
 }
 
+func (m *GenesisState) GetHistoricExchangeRates() []HistoricExchangeRate {
+	if m != nil {
+		return m.HistoricExchangeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRateMetadata() []ExchangeRateMetadata {
+	if m != nil {
+		return m.ExchangeRateMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHaltedDenoms() []HaltedDenom {
+	if m != nil {
+		return m.HaltedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleJails() []OracleJail {
+	if m != nil {
+		return m.OracleJails
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardSchedules() []RewardSchedule {
+	if m != nil {
+		return m.RewardSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTallyResults() []TallyResult {
+	if m != nil {
+		return m.TallyResults
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeders() []Feeder {
+	if m != nil {
+		return m.Feeders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemotePrices() []RemotePrice {
+	if m != nil {
+		return m.RemotePrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomMissCounters() []DenomMissCounter {
+	if m != nil {
+		return m.DenomMissCounters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddedDenoms() []AddedDenom {
+	if m != nil {
+		return m.AddedDenoms
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -219,10 +307,129 @@ func (m *MissCounter) GetMissCounter() uint64 {
 	return 0
 }
 
+// DenomMissCounter defines the number of vote periods of a slash window a
+// validator missed on a single denom only. These misses are taken back when the
+// denom is removed from the accept list.
+type DenomMissCounter struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	MissCounter      uint64 `protobuf:"varint,3,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+}
+
+func (m *DenomMissCounter) Reset()         { *m = DenomMissCounter{} }
+func (m *DenomMissCounter) String() string { return proto.CompactTextString(m) }
+func (*DenomMissCounter) ProtoMessage()    {}
+func (*DenomMissCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81656282a5df3295, []int{3}
+}
+func (m *DenomMissCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMissCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMissCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMissCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMissCounter.Merge(m, src)
+}
+func (m *DenomMissCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMissCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMissCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMissCounter proto.InternalMessageInfo
+
+func (m *DenomMissCounter) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DenomMissCounter) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomMissCounter) GetMissCounter() uint64 {
+	if m != nil {
+		return m.MissCounter
+	}
+	return 0
+}
+
+// AddedDenom defines a denom added to the accept list during a vote period. It
+// is left out of the miss counting of that vote period.
+type AddedDenom struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	VotePeriod uint64 `protobuf:"varint,2,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+}
+
+func (m *AddedDenom) Reset()         { *m = AddedDenom{} }
+func (m *AddedDenom) String() string { return proto.CompactTextString(m) }
+func (*AddedDenom) ProtoMessage()    {}
+func (*AddedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81656282a5df3295, []int{4}
+}
+func (m *AddedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddedDenom.Merge(m, src)
+}
+func (m *AddedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddedDenom proto.InternalMessageInfo
+
+func (m *AddedDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AddedDenom) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "persistence.oracle.v1beta1.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "persistence.oracle.v1beta1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "persistence.oracle.v1beta1.MissCounter")
+	proto.RegisterType((*DenomMissCounter)(nil), "persistence.oracle.v1beta1.DenomMissCounter")
+	proto.RegisterType((*AddedDenom)(nil), "persistence.oracle.v1beta1.AddedDenom")
 }
 
 func init() {
@@ -230,40 +437,61 @@ func init() {
 }
 
 var fileDescriptor_81656282a5df3295 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x36, 0x8d, 0xc4, 0x25, 0xa9, 0xda, 0x53, 0x87, 0x60, 0xa9, 0x6e, 0xc8, 0xd2,
-	0x0c, 0xc4, 0x56, 0x83, 0x90, 0x90, 0x18, 0xa0, 0x81, 0xc2, 0x84, 0xa8, 0x5c, 0x60, 0x60, 0xb1,
-	0x2e, 0xf6, 0xab, 0x6b, 0x11, 0xfb, 0xac, 0x7b, 0x97, 0x50, 0x16, 0x16, 0x06, 0x56, 0x66, 0x3e,
-	0x02, 0x33, 0x1f, 0xa2, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0xf9, 0x22, 0x28, 0x77, 0xd7, 0xc6, 0x14,
-	0x62, 0xd4, 0x2d, 0xf7, 0xee, 0xff, 0xff, 0xbd, 0xff, 0xd3, 0xbd, 0x98, 0x74, 0x73, 0x10, 0x98,
-	0xa0, 0x84, 0x2c, 0x04, 0x8f, 0x0b, 0x16, 0x8e, 0xc0, 0x9b, 0xec, 0x0d, 0x41, 0xb2, 0x3d, 0x2f,
-	0x86, 0x0c, 0x30, 0x41, 0x37, 0x17, 0x5c, 0x72, 0x6a, 0x17, 0x94, 0xae, 0x56, 0xba, 0x46, 0x69,
-	0x6f, 0xc5, 0x3c, 0xe6, 0x4a, 0xe6, 0xcd, 0x7f, 0x69, 0x87, 0xbd, 0x5b, 0xc2, 0x36, 0x00, 0x2d,
-	0xbc, 0x19, 0x72, 0x4c, 0x39, 0x06, 0x9a, 0xa0, 0x0f, 0xfa, 0xaa, 0xf3, 0x61, 0x8d, 0x34, 0x9e,
-	0xea, 0x1c, 0x47, 0x92, 0x49, 0xa0, 0x0f, 0x49, 0x2d, 0x67, 0x82, 0xa5, 0xd8, 0xb2, 0xda, 0x56,
-	0xb7, 0xde, 0xef, 0xb8, 0xcb, 0x73, 0xb9, 0x87, 0x4a, 0x39, 0xa8, 0x9e, 0xfd, 0xd8, 0xa9, 0xf8,
-	0xc6, 0x47, 0x19, 0xa1, 0xc7, 0x00, 0x11, 0x88, 0x20, 0x82, 0x11, 0xc4, 0x4c, 0x26, 0x3c, 0xc3,
-	0xd6, 0x4a, 0x7b, 0xb5, 0x5b, 0xef, 0xdf, 0x2e, 0xa3, 0x3d, 0x51, 0xae, 0xc7, 0x97, 0x26, 0xc3,
-	0xdd, 0x3c, 0xbe, 0x52, 0x47, 0x9a, 0x93, 0x75, 0x38, 0x0d, 0x4f, 0x58, 0x16, 0x43, 0x20, 0x98,
-	0x04, 0x6c, 0xad, 0x2a, 0x7c, 0xaf, 0x0c, 0x7f, 0x60, 0x1c, 0x3e, 0x93, 0xf0, 0x62, 0x9c, 0x8f,
-	0x60, 0x60, 0xcf, 0xf9, 0x5f, 0x7e, 0xee, 0xd0, 0xbf, 0xae, 0xd0, 0x6f, 0x42, 0xa1, 0x86, 0xd4,
-	0x27, 0xcd, 0x34, 0x41, 0x0c, 0x42, 0x3e, 0xce, 0x24, 0x08, 0x6c, 0x55, 0x55, 0xc3, 0xdd, 0xb2,
-	0x86, 0xcf, 0x12, 0xc4, 0x47, 0x5a, 0x6f, 0x46, 0x69, 0xa4, 0x8b, 0x12, 0xd2, 0x8f, 0x16, 0x69,
-	0xb3, 0x38, 0x16, 0xf3, 0xb1, 0x20, 0xf8, 0x63, 0xa0, 0x20, 0x17, 0x30, 0xe1, 0xf3, 0xc1, 0xd6,
-	0x54, 0x9f, 0x7b, 0x65, 0x7d, 0xf6, 0x2f, 0x18, 0xc5, 0x31, 0x0e, 0x35, 0xc0, 0x34, 0xde, 0x66,
-	0x25, 0x1a, 0xa4, 0xef, 0xc9, 0xf6, 0xb2, 0x20, 0x3a, 0x45, 0x4d, 0xa5, 0xb8, 0x7b, 0xed, 0x14,
-	0xaf, 0x16, 0x11, 0x6c, 0xb6, 0x4c, 0x80, 0x9d, 0xcf, 0x16, 0xd9, 0xb8, 0xfa, 0xfa, 0xf4, 0x01,
-	0x59, 0x37, 0x7b, 0xc4, 0xa2, 0x48, 0x00, 0xea, 0x8d, 0xbc, 0x31, 0x68, 0x7d, 0xfb, 0xda, 0xdb,
-	0x32, 0x4b, 0xbc, 0xaf, 0x6f, 0x8e, 0xa4, 0x48, 0xb2, 0xd8, 0x6f, 0x6a, 0xbd, 0x29, 0xd2, 0x03,
-	0xb2, 0x39, 0x61, 0xa3, 0x24, 0x62, 0x92, 0x2f, 0x18, 0x2b, 0xff, 0x61, 0x6c, 0x5c, 0x5a, 0x4c,
-	0xbd, 0xf3, 0x96, 0xd4, 0x0b, 0x2f, 0xf9, 0x6f, 0xaa, 0x75, 0x5d, 0x2a, 0xbd, 0x45, 0x1a, 0xc5,
-	0x85, 0x52, 0xb9, 0xaa, 0x7e, 0xbd, 0xb0, 0x20, 0x83, 0x97, 0x67, 0x53, 0xc7, 0x3a, 0x9f, 0x3a,
-	0xd6, 0xaf, 0xa9, 0x63, 0x7d, 0x9a, 0x39, 0x95, 0xf3, 0x99, 0x53, 0xf9, 0x3e, 0x73, 0x2a, 0xaf,
-	0xef, 0xc7, 0x89, 0x3c, 0x19, 0x0f, 0xdd, 0x90, 0xa7, 0x5e, 0xe1, 0x49, 0x9e, 0x67, 0x50, 0x3c,
-	0xf6, 0x30, 0x7a, 0xe3, 0x4d, 0xfa, 0xde, 0xe9, 0xc5, 0xc7, 0x41, 0xbe, 0xcb, 0x01, 0x87, 0x35,
-	0xf5, 0xcf, 0xbf, 0xf3, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x34, 0x1d, 0xac, 0x9b, 0x04, 0x00,
-	0x00,
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x9b, 0x34, 0xa5, 0xb3, 0x9b, 0x74, 0x33, 0x84, 0x62, 0x56, 0xea, 0x26, 0xec, 0x81,
+	0x46, 0x88, 0xec, 0xd2, 0x20, 0xa4, 0xde, 0x20, 0xdb, 0x16, 0x2a, 0xa4, 0xaa, 0x2b, 0xa7, 0xea,
+	0x01, 0x0e, 0xd6, 0xac, 0xfd, 0xd6, 0x6b, 0x6a, 0x7b, 0xac, 0x79, 0xe3, 0x4d, 0x73, 0xe1, 0xca,
+	0x15, 0x71, 0xe4, 0x27, 0x70, 0xe6, 0x47, 0xf4, 0x58, 0x71, 0xe2, 0x04, 0x28, 0xf9, 0x09, 0xfc,
+	0x01, 0xe4, 0x99, 0x71, 0xec, 0x6c, 0x36, 0xb6, 0x22, 0xf5, 0xb6, 0xfe, 0xe6, 0xfb, 0xbe, 0xf7,
+	0x9e, 0xdf, 0xf3, 0x9b, 0x25, 0x7b, 0x29, 0x08, 0x0c, 0x51, 0x42, 0xe2, 0xc1, 0x90, 0x0b, 0xe6,
+	0x45, 0x30, 0x9c, 0x3f, 0x98, 0x80, 0x64, 0x0f, 0x86, 0x01, 0x24, 0x80, 0x21, 0x0e, 0x52, 0xc1,
+	0x25, 0xa7, 0xdd, 0x0a, 0x73, 0xa0, 0x99, 0x03, 0xc3, 0xec, 0x6e, 0x07, 0x3c, 0xe0, 0x8a, 0x36,
+	0xcc, 0x7f, 0x69, 0x45, 0xf7, 0x7e, 0x8d, 0xb7, 0x31, 0xd0, 0xc4, 0x8f, 0x3c, 0x8e, 0x31, 0x47,
+	0x57, 0x3b, 0xe8, 0x07, 0x7d, 0xd4, 0xff, 0xaf, 0x4d, 0xda, 0xdf, 0xea, 0x3c, 0x8e, 0x24, 0x93,
+	0x40, 0xbf, 0x26, 0xeb, 0x29, 0x13, 0x2c, 0x46, 0xdb, 0xda, 0xb5, 0xf6, 0x5a, 0x07, 0xfd, 0xc1,
+	0xd5, 0x79, 0x0d, 0xc6, 0x8a, 0x39, 0x5a, 0x7b, 0xf3, 0xf7, 0xce, 0x8a, 0x63, 0x74, 0x94, 0x11,
+	0x3a, 0x05, 0xf0, 0x41, 0xb8, 0x3e, 0x44, 0x10, 0x30, 0x19, 0xf2, 0x04, 0xed, 0x1b, 0xbb, 0xab,
+	0x7b, 0xad, 0x83, 0xcf, 0xea, 0xdc, 0xbe, 0x51, 0xaa, 0xc7, 0xe7, 0x22, 0xe3, 0xbb, 0x35, 0x5d,
+	0xc0, 0x91, 0xa6, 0x64, 0x13, 0x5e, 0x7b, 0x33, 0x96, 0x04, 0xe0, 0x0a, 0x26, 0x01, 0xed, 0x55,
+	0x65, 0xbf, 0x5f, 0x67, 0xff, 0xc4, 0x28, 0x1c, 0x26, 0xe1, 0x45, 0x96, 0x46, 0x30, 0xea, 0xe6,
+	0xfe, 0xbf, 0xff, 0xb3, 0x43, 0x2f, 0x1d, 0xa1, 0xb3, 0x01, 0x15, 0x0c, 0xa9, 0x43, 0x36, 0xe2,
+	0x10, 0xd1, 0xf5, 0x78, 0x96, 0x48, 0x10, 0x68, 0xaf, 0xa9, 0x80, 0xf7, 0xeb, 0x02, 0x3e, 0x0b,
+	0x11, 0x1f, 0x69, 0xbe, 0x29, 0xa5, 0x1d, 0x97, 0x10, 0xd2, 0x9f, 0x2d, 0xb2, 0xcb, 0x82, 0x40,
+	0xe4, 0x65, 0x81, 0x7b, 0xa1, 0x20, 0x37, 0x15, 0x30, 0xe7, 0x79, 0x61, 0x37, 0x55, 0x9c, 0x87,
+	0x75, 0x71, 0x0e, 0x0b, 0x8f, 0x6a, 0x19, 0x63, 0x6d, 0x60, 0x02, 0xdf, 0x63, 0x35, 0x1c, 0xa4,
+	0x3f, 0x91, 0x7b, 0x57, 0x25, 0xa2, 0xb3, 0x58, 0x57, 0x59, 0x7c, 0x79, 0xed, 0x2c, 0x5e, 0x96,
+	0x29, 0x74, 0xd9, 0x55, 0x04, 0xa4, 0x09, 0xf9, 0x70, 0x16, 0xa2, 0xe4, 0x22, 0xf4, 0xdc, 0x85,
+	0xc6, 0xde, 0x52, 0x91, 0x3f, 0xaf, 0x8b, 0xfc, 0xd4, 0x48, 0xab, 0xbe, 0x26, 0xe8, 0x07, 0xb3,
+	0x25, 0x67, 0x48, 0x23, 0x72, 0xf7, 0x62, 0x95, 0x31, 0x48, 0xe6, 0x33, 0xc9, 0xec, 0xf7, 0x9a,
+	0xc3, 0x55, 0xad, 0x9e, 0x19, 0x9d, 0x09, 0xb7, 0x0d, 0x4b, 0xce, 0xf2, 0xd9, 0x99, 0xb1, 0x48,
+	0x82, 0xef, 0xfa, 0x90, 0xf0, 0x18, 0xed, 0xdb, 0xcd, 0xb3, 0xf3, 0x54, 0x09, 0x1e, 0xe7, 0xfc,
+	0x62, 0x76, 0x66, 0x25, 0x84, 0x34, 0x26, 0x77, 0xe7, 0x2c, 0x0a, 0x7d, 0x26, 0xb9, 0x70, 0x53,
+	0x10, 0x53, 0x2e, 0x62, 0x96, 0x78, 0x80, 0x36, 0x69, 0xae, 0xe0, 0x65, 0xa1, 0x1c, 0x97, 0xc2,
+	0xe2, 0x85, 0xcd, 0x97, 0x9c, 0x21, 0x7d, 0x4e, 0xda, 0xda, 0xc3, 0xfd, 0x91, 0x85, 0x11, 0xda,
+	0x2d, 0x15, 0xe4, 0x93, 0xba, 0x20, 0xcf, 0xd5, 0xe3, 0x77, 0x2c, 0x8c, 0x8c, 0x75, 0x8b, 0x9f,
+	0x23, 0x48, 0x7f, 0x20, 0x1d, 0x01, 0xc7, 0x4c, 0xf8, 0x2e, 0x7a, 0x33, 0xf0, 0xb3, 0x08, 0xd0,
+	0x6e, 0x2b, 0xd3, 0x4f, 0xeb, 0x4c, 0x1d, 0xa5, 0x39, 0x32, 0x12, 0x63, 0x7c, 0x47, 0x5c, 0x40,
+	0xd5, 0xc7, 0x2a, 0x59, 0x14, 0x9d, 0xb8, 0x02, 0x30, 0x8b, 0x24, 0xda, 0x1b, 0xcd, 0x2f, 0xfc,
+	0x45, 0x2e, 0x70, 0x14, 0xbf, 0x78, 0xe1, 0xb2, 0x84, 0x90, 0x8e, 0xc8, 0x2d, 0xbd, 0x87, 0xd0,
+	0xde, 0xdc, 0x5d, 0x6d, 0x5a, 0x8c, 0x7a, 0x95, 0x19, 0xa3, 0x42, 0x98, 0xe7, 0x25, 0x20, 0xe6,
+	0xea, 0xf3, 0x0e, 0xf3, 0x5e, 0xdd, 0x69, 0xce, 0xcb, 0x51, 0x82, 0x71, 0xce, 0x2f, 0xf2, 0x12,
+	0x25, 0x84, 0x74, 0x42, 0xde, 0x57, 0x53, 0xe5, 0x5e, 0x5c, 0x4f, 0x9d, 0xe6, 0x75, 0xab, 0x26,
+	0xe9, 0xf2, 0x8e, 0xda, 0xf2, 0x17, 0x70, 0xd5, 0x7d, 0xe6, 0xfb, 0xe5, 0xfc, 0x6e, 0x35, 0x77,
+	0xff, 0x30, 0xe7, 0x57, 0xc7, 0xb7, 0xc5, 0xce, 0x11, 0xec, 0xff, 0x66, 0x91, 0xce, 0xe2, 0xb6,
+	0xa7, 0x5f, 0x91, 0x4d, 0x73, 0x6f, 0x30, 0xdf, 0x17, 0x80, 0xfa, 0x06, 0xba, 0x3d, 0xb2, 0xff,
+	0xfc, 0x63, 0x7f, 0xdb, 0x5c, 0x5a, 0x87, 0xfa, 0xe4, 0x48, 0x8a, 0x30, 0x09, 0x9c, 0x0d, 0xcd,
+	0x37, 0x20, 0x7d, 0x42, 0xb6, 0xca, 0x6f, 0xa2, 0xf0, 0xb8, 0xd1, 0xe0, 0xd1, 0x39, 0x97, 0x18,
+	0xbc, 0x7f, 0x4c, 0x5a, 0x95, 0xea, 0x97, 0xbb, 0x5a, 0xd7, 0x75, 0xa5, 0x1f, 0x93, 0x76, 0xb5,
+	0x43, 0x2a, 0xaf, 0x35, 0xa7, 0x55, 0xb9, 0x10, 0xfa, 0xbf, 0x5a, 0xa4, 0xb3, 0xd8, 0x94, 0x77,
+	0x15, 0x7e, 0x9b, 0xdc, 0x54, 0xcd, 0xd3, 0xef, 0xc3, 0xd1, 0x0f, 0x97, 0x92, 0x5a, 0xbd, 0x9c,
+	0xd4, 0x23, 0x42, 0xca, 0x5e, 0x96, 0x36, 0x56, 0xd5, 0x66, 0x87, 0xb4, 0xe6, 0x6a, 0xaa, 0x41,
+	0x84, 0xdc, 0x37, 0xa5, 0x91, 0x1c, 0x1a, 0x2b, 0x64, 0xe4, 0xbc, 0x39, 0xed, 0x59, 0x6f, 0x4f,
+	0x7b, 0xd6, 0xbf, 0xa7, 0x3d, 0xeb, 0x97, 0xb3, 0xde, 0xca, 0xdb, 0xb3, 0xde, 0xca, 0x5f, 0x67,
+	0xbd, 0x95, 0xef, 0x1f, 0x06, 0xa1, 0x9c, 0x65, 0x93, 0x81, 0xc7, 0xe3, 0x61, 0x98, 0x78, 0xd9,
+	0x24, 0xc3, 0xfd, 0x04, 0xe4, 0x31, 0x17, 0xaf, 0x86, 0x53, 0x96, 0x4c, 0x33, 0x71, 0xb2, 0x8f,
+	0xfe, 0xab, 0xe1, 0xfc, 0x60, 0xf8, 0xba, 0xf8, 0x8f, 0x23, 0x4f, 0x52, 0xc0, 0xc9, 0xba, 0xfa,
+	0x03, 0xf3, 0xc5, 0xff, 0x03, 0x00, 0x32, 0x13, 0x96, 0xd7, 0x62, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -286,6 +514,164 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AddedDenoms) > 0 {
+		for iNdEx := len(m.AddedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.DenomMissCounters) > 0 {
+		for iNdEx := len(m.DenomMissCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMissCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RemotePrices) > 0 {
+		for iNdEx := len(m.RemotePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemotePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Feeders) > 0 {
+		for iNdEx := len(m.Feeders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TallyResults) > 0 {
+		for iNdEx := len(m.TallyResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RewardSchedules) > 0 {
+		for iNdEx := len(m.RewardSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.OracleJails) > 0 {
+		for iNdEx := len(m.OracleJails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleJails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.HaltedDenoms) > 0 {
+		for iNdEx := len(m.HaltedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HaltedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ExchangeRateMetadata) > 0 {
+		for iNdEx := len(m.ExchangeRateMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.HistoricExchangeRates) > 0 {
+		for iNdEx := len(m.HistoricExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.AggregateExchangeRateVotes) > 0 {
 		for iNdEx := len(m.AggregateExchangeRateVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -441,21 +827,98 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DenomMissCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DenomMissCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Params.Size()
@@ -490,6 +953,72 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HistoricExchangeRates) > 0 {
+		for _, e := range m.HistoricExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRateMetadata) > 0 {
+		for _, e := range m.ExchangeRateMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HaltedDenoms) > 0 {
+		for _, e := range m.HaltedDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleJails) > 0 {
+		for _, e := range m.OracleJails {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardSchedules) > 0 {
+		for _, e := range m.RewardSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TallyResults) > 0 {
+		for _, e := range m.TallyResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Feeders) > 0 {
+		for _, e := range m.Feeders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemotePrices) > 0 {
+		for _, e := range m.RemotePrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMissCounters) > 0 {
+		for _, e := range m.DenomMissCounters {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddedDenoms) > 0 {
+		for _, e := range m.AddedDenoms {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -526,6 +1055,42 @@ func (m *MissCounter) Size() (n int) {
 	return n
 }
 
+func (m *DenomMissCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MissCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.MissCounter))
+	}
+	return n
+}
+
+func (m *AddedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.VotePeriod))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -551,19 +1116,392 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederDelegations = append(m.FeederDelegations, FeederDelegation{})
+			if err := m.FeederDelegations[len(m.FeederDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissCounters = append(m.MissCounters, MissCounter{})
+			if err := m.MissCounters[len(m.MissCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRateVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRateVotes = append(m.AggregateExchangeRateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateExchangeRateVotes[len(m.AggregateExchangeRateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricExchangeRates = append(m.HistoricExchangeRates, HistoricExchangeRate{})
+			if err := m.HistoricExchangeRates[len(m.HistoricExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateMetadata = append(m.ExchangeRateMetadata, ExchangeRateMetadata{})
+			if err := m.ExchangeRateMetadata[len(m.ExchangeRateMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedDenoms = append(m.HaltedDenoms, HaltedDenom{})
+			if err := m.HaltedDenoms[len(m.HaltedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleJails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleJails = append(m.OracleJails, OracleJail{})
+			if err := m.OracleJails[len(m.OracleJails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSchedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -590,13 +1528,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardSchedules = append(m.RewardSchedules, RewardSchedule{})
+			if err := m.RewardSchedules[len(m.RewardSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -623,14 +1562,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederDelegations = append(m.FeederDelegations, FeederDelegation{})
-			if err := m.FeederDelegations[len(m.FeederDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TallyResults = append(m.TallyResults, TallyResult{})
+			if err := m.TallyResults[len(m.TallyResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -657,14 +1596,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Feeders = append(m.Feeders, Feeder{})
+			if err := m.Feeders[len(m.Feeders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -691,14 +1630,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissCounters = append(m.MissCounters, MissCounter{})
-			if err := m.MissCounters[len(m.MissCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemotePrices = append(m.RemotePrices, RemotePrice{})
+			if err := m.RemotePrices[len(m.RemotePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMissCounters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -725,14 +1664,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomMissCounters = append(m.DenomMissCounters, DenomMissCounter{})
+			if err := m.DenomMissCounters[len(m.DenomMissCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -759,8 +1698,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateExchangeRateVotes = append(m.AggregateExchangeRateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateExchangeRateVotes[len(m.AggregateExchangeRateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AddedDenoms = append(m.AddedDenoms, AddedDenom{})
+			if err := m.AddedDenoms[len(m.AddedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1000,6 +1939,240 @@ func (m *MissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomMissCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMissCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// NewHistoricExchangeRate creates a HistoricExchangeRate instance
+func NewHistoricExchangeRate(
+	denom string,
+	exchangeRate sdk.Dec,
+	blockHeight uint64,
+	blockTime time.Time,
+) HistoricExchangeRate {
+	return HistoricExchangeRate{
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+		BlockHeight:  blockHeight,
+		BlockTime:    blockTime,
+	}
+}
+
+// String implement stringify
+func (r HistoricExchangeRate) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fanfury-sdk/v2/utils"
+)
+
+// OracleHooks defines the hooks invoked by the oracle module, so that modules
+// depending on prices need not poll the exchange rates every block.
+type OracleHooks interface {
+	// AfterExchangeRateUpdated is called after a tallied exchange rate of a denom is stored.
+	AfterExchangeRateUpdated(ctx sdk.Context, denom string, exchangeRate sdk.Dec) error
+	// AfterBallotDropped is called when the ballot of a denom fails to reach
+	// its vote threshold or minimum voters, leaving its exchange rate unchanged.
+	AfterBallotDropped(ctx sdk.Context, denom string) error
+	// AfterValidatorMissed is called when a validator misses a vote period,
+	// with its miss counter in the current slash window.
+	AfterValidatorMissed(ctx sdk.Context, operator sdk.ValAddress, missCounter uint64) error
+}
+
+var _ OracleHooks = MultiOracleHooks{}
+
+// MultiOracleHooks combines multiple oracle hooks, all hook functions are run
+// in array sequence.
+type MultiOracleHooks []OracleHooks
+
+func NewMultiOracleHooks(hooks ...OracleHooks) MultiOracleHooks {
+	return hooks
+}
+
+// AfterExchangeRateUpdated is called after a tallied exchange rate of a denom is stored.
+func (h MultiOracleHooks) AfterExchangeRateUpdated(ctx sdk.Context, denom string, exchangeRate sdk.Dec) error {
+	for i := range h {
+		hook := h[i]
+		panicCatchingOracleHook(ctx, func(ctx sdk.Context) error {
+			return hook.AfterExchangeRateUpdated(ctx, denom, exchangeRate)
+		})
+	}
+
+	return nil
+}
+
+// AfterBallotDropped is called when the ballot of a denom is dropped.
+func (h MultiOracleHooks) AfterBallotDropped(ctx sdk.Context, denom string) error {
+	for i := range h {
+		hook := h[i]
+		panicCatchingOracleHook(ctx, func(ctx sdk.Context) error {
+			return hook.AfterBallotDropped(ctx, denom)
+		})
+	}
+
+	return nil
+}
+
+// AfterValidatorMissed is called when a validator misses a vote period.
+func (h MultiOracleHooks) AfterValidatorMissed(ctx sdk.Context, operator sdk.ValAddress, missCounter uint64) error {
+	for i := range h {
+		hook := h[i]
+		panicCatchingOracleHook(ctx, func(ctx sdk.Context) error {
+			return hook.AfterValidatorMissed(ctx, operator, missCounter)
+		})
+	}
+
+	return nil
+}
+
+// panicCatchingOracleHook runs a hook in a cached context, discarding its state
+// changes if it errors or panics.
+func panicCatchingOracleHook(ctx sdk.Context, hookFn func(ctx sdk.Context) error) {
+	if err := utils.ApplyFuncIfNoError(ctx, hookFn); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("error in oracle hook %v", err))
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// NewOracleJail creates an OracleJail instance
+func NewOracleJail(
+	validator sdk.ValAddress,
+	jailHeight uint64,
+	jailedUntil time.Time,
+	missCounter uint64,
+	validVoteRate sdk.Dec,
+	slashedTokens math.Int,
+) OracleJail {
+	return OracleJail{
+		Validator:     validator.String(),
+		JailHeight:    jailHeight,
+		JailedUntil:   jailedUntil,
+		MissCounter:   missCounter,
+		ValidVoteRate: validVoteRate,
+		SlashedTokens: slashedTokens,
+	}
+}
+
+// String implement stringify
+func (j OracleJail) String() string {
+	out, _ := yaml.Marshal(j)
+	return string(out)
+}
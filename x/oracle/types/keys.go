@@ -26,6 +26,18 @@ var (
 	KeyPrefixMissCounter                  = []byte{0x03} // prefix for each key to a miss counter
 	KeyPrefixAggregateExchangeRatePrevote = []byte{0x04} // prefix for each key to a aggregate prevote
 	KeyPrefixAggregateExchangeRateVote    = []byte{0x05} // prefix for each key to a aggregate vote
+	KeyPrefixHistoricExchangeRate         = []byte{0x06} // prefix for each key to a historic rate
+	KeyPrefixExchangeRateMetadata         = []byte{0x07} // prefix for each key to a rate metadata
+	KeyPrefixHaltedDenom                  = []byte{0x08} // prefix for each key to a halted denom
+	KeyPrefixValidatorPerformance         = []byte{0x09} // prefix for each key to a validator performance
+	KeyPrefixOracleJail                   = []byte{0x0A} // prefix for each key to an oracle jail
+	KeyPrefixRewardSchedule               = []byte{0x0B} // prefix for each key to a reward schedule
+	KeyNextRewardScheduleID               = []byte{0x0C} // key for the next reward schedule id
+	KeyPrefixTallyResult                  = []byte{0x0D} // prefix for each key to a tally result
+	KeyPrefixFeeder                       = []byte{0x0E} // prefix for each key to a registered feeder
+	KeyPrefixRemotePrice                  = []byte{0x0F} // prefix for each key to a remote price
+	KeyPrefixDenomMissCounter             = []byte{0x10} // prefix for each key to a denom miss counter
+	KeyPrefixAddedDenom                   = []byte{0x11} // prefix for each key to an added denom
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -59,3 +71,113 @@ func GetAggregateExchangeRateVoteKey(v sdk.ValAddress) (key []byte) {
 	key = append(key, KeyPrefixAggregateExchangeRateVote...)
 	return append(key, address.MustLengthPrefix(v)...)
 }
+
+// GetHistoricExchangeRatePrefix - stored by *denom*
+func GetHistoricExchangeRatePrefix(denom string) (key []byte) {
+	key = append(key, KeyPrefixHistoricExchangeRate...)
+	key = append(key, []byte(denom)...)
+
+	return append(key, 0) // append 0 for null-termination
+}
+
+// GetHistoricExchangeRateKey - stored by *denom* and *block height*
+func GetHistoricExchangeRateKey(denom string, height uint64) (key []byte) {
+	key = GetHistoricExchangeRatePrefix(denom)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetExchangeRateMetadataKey - stored by *denom*
+func GetExchangeRateMetadataKey(denom string) (key []byte) {
+	key = append(key, KeyPrefixExchangeRateMetadata...)
+	key = append(key, []byte(denom)...)
+
+	return append(key, 0) // append 0 for null-termination
+}
+
+// GetHaltedDenomKey - stored by *denom*
+func GetHaltedDenomKey(denom string) (key []byte) {
+	key = append(key, KeyPrefixHaltedDenom...)
+	key = append(key, []byte(denom)...)
+
+	return append(key, 0) // append 0 for null-termination
+}
+
+// GetValidatorPerformancePrefix - stored by *Validator* address
+func GetValidatorPerformancePrefix(v sdk.ValAddress) (key []byte) {
+	key = append(key, KeyPrefixValidatorPerformance...)
+	return append(key, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorPerformanceKey - stored by *Validator* address and *slash window*
+func GetValidatorPerformanceKey(v sdk.ValAddress, window uint64) (key []byte) {
+	key = GetValidatorPerformancePrefix(v)
+	return append(key, sdk.Uint64ToBigEndian(window)...)
+}
+
+// GetOracleJailKey - stored by *Validator* address
+func GetOracleJailKey(v sdk.ValAddress) (key []byte) {
+	key = append(key, KeyPrefixOracleJail...)
+	return append(key, address.MustLengthPrefix(v)...)
+}
+
+// GetRewardScheduleKey - stored by *schedule id*
+func GetRewardScheduleKey(id uint64) (key []byte) {
+	key = append(key, KeyPrefixRewardSchedule...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTallyResultPrefix - stored by *denom*
+func GetTallyResultPrefix(denom string) (key []byte) {
+	key = append(key, KeyPrefixTallyResult...)
+	key = append(key, []byte(denom)...)
+
+	return append(key, 0) // append 0 for null-termination
+}
+
+// GetTallyResultKey - stored by *denom* and *vote period*
+func GetTallyResultKey(denom string, votePeriod uint64) (key []byte) {
+	key = GetTallyResultPrefix(denom)
+	return append(key, sdk.Uint64ToBigEndian(votePeriod)...)
+}
+
+// GetFeederPrefix - stored by *Validator* address
+func GetFeederPrefix(v sdk.ValAddress) (key []byte) {
+	key = append(key, KeyPrefixFeeder...)
+	return append(key, address.MustLengthPrefix(v)...)
+}
+
+// GetFeederKey - stored by *Validator* address and *feeder* address
+func GetFeederKey(v sdk.ValAddress, feeder sdk.AccAddress) (key []byte) {
+	key = GetFeederPrefix(v)
+	return append(key, address.MustLengthPrefix(feeder)...)
+}
+
+// GetRemotePriceKey - stored by *denom*
+func GetRemotePriceKey(denom string) (key []byte) {
+	key = append(key, KeyPrefixRemotePrice...)
+	key = append(key, []byte(denom)...)
+
+	return append(key, 0) // append 0 for null-termination
+}
+
+// GetDenomMissCounterPrefix - stored by *denom*
+func GetDenomMissCounterPrefix(denom string) (key []byte) {
+	key = append(key, KeyPrefixDenomMissCounter...)
+	key = append(key, []byte(denom)...)
+
+	return append(key, 0) // append 0 for null-termination
+}
+
+// GetDenomMissCounterKey - stored by *denom* and *Validator* address
+func GetDenomMissCounterKey(denom string, v sdk.ValAddress) (key []byte) {
+	key = GetDenomMissCounterPrefix(denom)
+	return append(key, address.MustLengthPrefix(v)...)
+}
+
+// GetAddedDenomKey - stored by *denom*
+func GetAddedDenomKey(denom string) (key []byte) {
+	key = append(key, KeyPrefixAddedDenom...)
+	key = append(key, []byte(denom)...)
+
+	return append(key, 0) // append 0 for null-termination
+}
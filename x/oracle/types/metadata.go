@@ -0,0 +1,37 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// NewExchangeRateMetadata creates a ExchangeRateMetadata instance
+func NewExchangeRateMetadata(
+	denom string,
+	lastUpdateHeight uint64,
+	lastUpdateTime time.Time,
+	voterCount uint64,
+	powerShare sdk.Dec,
+) ExchangeRateMetadata {
+	return ExchangeRateMetadata{
+		Denom:            denom,
+		LastUpdateHeight: lastUpdateHeight,
+		LastUpdateTime:   lastUpdateTime,
+		VoterCount:       voterCount,
+		PowerShare:       powerShare,
+	}
+}
+
+// IsExpired returns true if the rate was last tallied more than maxStaleness
+// blocks before the given height. A zero maxStaleness never expires.
+func (m ExchangeRateMetadata) IsExpired(height, maxStaleness uint64) bool {
+	return maxStaleness != 0 && height > m.LastUpdateHeight+maxStaleness
+}
+
+// String implement stringify
+func (m ExchangeRateMetadata) String() string {
+	out, _ := yaml.Marshal(m)
+	return string(out)
+}
@@ -10,16 +10,30 @@ import (
 
 var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
+	_ sdk.Msg = &MsgAddFeeder{}
+	_ sdk.Msg = &MsgRevokeFeeder{}
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgAddDenom{}
+	_ sdk.Msg = &MsgUpdateDenom{}
+	_ sdk.Msg = &MsgRemoveDenom{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+	_ sdk.Msg = &MsgCreateRewardSchedule{}
 )
 
 // Messages types constants
 const (
 	TypeMsgDelegateFeedConsent          = "delegate_feeder"
+	TypeMsgAddFeeder                    = "add_feeder"
+	TypeMsgRevokeFeeder                 = "revoke_feeder"
 	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
 	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
 	TypeMsgAddFundsToRewardPool         = "add_funds_to_reward_pool"
+	TypeMsgAddDenom                     = "add_denom"
+	TypeMsgUpdateDenom                  = "update_denom"
+	TypeMsgRemoveDenom                  = "remove_denom"
+	TypeMsgResetCircuitBreaker          = "reset_circuit_breaker"
+	TypeMsgCreateRewardSchedule         = "create_reward_schedule"
 )
 
 func NewMsgAggregateExchangeRatePrevote(
@@ -189,6 +203,93 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgAddFeeder creates a MsgAddFeeder instance
+func NewMsgAddFeeder(
+	operatorAddress sdk.ValAddress,
+	feederAddress sdk.AccAddress,
+	expiryHeight uint64,
+	denoms []string,
+) *MsgAddFeeder {
+	return &MsgAddFeeder{
+		Operator:     operatorAddress.String(),
+		Feeder:       feederAddress.String(),
+		ExpiryHeight: expiryHeight,
+		Denoms:       denoms,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAddFeeder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAddFeeder) Type() string { return TypeMsgAddFeeder }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAddFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddFeeder) GetSigners() []sdk.AccAddress {
+	operator, _ := sdk.ValAddressFromBech32(msg.Operator)
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddFeeder) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid feeder address (%s)", err)
+	}
+
+	return ValidateFeederDenoms(msg.Denoms)
+}
+
+// NewMsgRevokeFeeder creates a MsgRevokeFeeder instance
+func NewMsgRevokeFeeder(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress) *MsgRevokeFeeder {
+	return &MsgRevokeFeeder{
+		Operator: operatorAddress.String(),
+		Feeder:   feederAddress.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRevokeFeeder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRevokeFeeder) Type() string { return TypeMsgRevokeFeeder }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRevokeFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRevokeFeeder) GetSigners() []sdk.AccAddress {
+	operator, _ := sdk.ValAddressFromBech32(msg.Operator)
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRevokeFeeder) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid feeder address (%s)", err)
+	}
+
+	return nil
+}
+
 func NewMsgAddFundsToRewardPool(from sdk.AccAddress, funds sdk.Coins) *MsgAddFundsToRewardPool {
 	return &MsgAddFundsToRewardPool{
 		From:  from.String(),
@@ -196,6 +297,20 @@ func NewMsgAddFundsToRewardPool(from sdk.AccAddress, funds sdk.Coins) *MsgAddFun
 	}
 }
 
+// NewMsgAddFundsToRewardSchedule creates a MsgAddFundsToRewardPool instance
+// reserving the funds for a reward schedule
+func NewMsgAddFundsToRewardSchedule(
+	from sdk.AccAddress,
+	funds sdk.Coins,
+	plan RewardSchedulePlan,
+) *MsgAddFundsToRewardPool {
+	return &MsgAddFundsToRewardPool{
+		From:     from.String(),
+		Funds:    funds,
+		Schedule: &plan,
+	}
+}
+
 // Route implements sdk.Msg
 func (m MsgAddFundsToRewardPool) Route() string { return RouterKey }
 
@@ -209,20 +324,224 @@ func (m MsgAddFundsToRewardPool) GetSignBytes() []byte {
 
 // GetSigners implements sdk.Msg
 func (m MsgAddFundsToRewardPool) GetSigners() []sdk.AccAddress {
-	operator, _ := sdk.ValAddressFromBech32(m.From)
-	return []sdk.AccAddress{sdk.AccAddress(operator)}
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
 }
 
 // ValidateBasic implements sdk.Msg
 func (m MsgAddFundsToRewardPool) ValidateBasic() error {
-	_, err := sdk.ValAddressFromBech32(m.From)
+	_, err := sdk.AccAddressFromBech32(m.From)
 	if err != nil {
-		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if !m.Funds.IsValid() || m.Funds.Empty() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid funds: %s", m.Funds)
+	}
+
+	if m.Schedule != nil {
+		return m.Schedule.ValidateBasic(m.Funds)
+	}
+
+	return nil
+}
+
+// NewMsgAddDenom creates a MsgAddDenom instance
+func NewMsgAddDenom(authority sdk.AccAddress, denom Denom) *MsgAddDenom {
+	return &MsgAddDenom{
+		Authority: authority.String(),
+		Denom:     denom,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAddDenom) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAddDenom) Type() string { return TypeMsgAddDenom }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAddDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddDenom) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
 	}
 
-	if coins := sdk.NewCoins(m.Funds...); coins.Empty() {
-		return errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	if err := msg.Denom.Validate(); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
 	return nil
 }
+
+// NewMsgUpdateDenom creates a MsgUpdateDenom instance
+func NewMsgUpdateDenom(authority sdk.AccAddress, denom Denom) *MsgUpdateDenom {
+	return &MsgUpdateDenom{
+		Authority: authority.String(),
+		Denom:     denom,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateDenom) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateDenom) Type() string { return TypeMsgUpdateDenom }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateDenom) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := msg.Denom.Validate(); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRemoveDenom creates a MsgRemoveDenom instance
+func NewMsgRemoveDenom(authority sdk.AccAddress, symbolDenom string) *MsgRemoveDenom {
+	return &MsgRemoveDenom{
+		Authority:   authority.String(),
+		SymbolDenom: symbolDenom,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRemoveDenom) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRemoveDenom) Type() string { return TypeMsgRemoveDenom }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRemoveDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveDenom) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if len(msg.SymbolDenom) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "symbol denom must not be empty")
+	}
+
+	return nil
+}
+
+// NewMsgResetCircuitBreaker creates a MsgResetCircuitBreaker instance
+func NewMsgResetCircuitBreaker(authority sdk.AccAddress, symbolDenom string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority.String(),
+		SymbolDenom: symbolDenom,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgResetCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgResetCircuitBreaker) Type() string { return TypeMsgResetCircuitBreaker }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if len(msg.SymbolDenom) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "symbol denom must not be empty")
+	}
+
+	return nil
+}
+
+// NewMsgCreateRewardSchedule creates a MsgCreateRewardSchedule instance
+func NewMsgCreateRewardSchedule(
+	authority sdk.AccAddress,
+	funds sdk.Coins,
+	plan RewardSchedulePlan,
+) *MsgCreateRewardSchedule {
+	return &MsgCreateRewardSchedule{
+		Authority: authority.String(),
+		Funds:     funds,
+		Schedule:  plan,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateRewardSchedule) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateRewardSchedule) Type() string { return TypeMsgCreateRewardSchedule }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateRewardSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateRewardSchedule) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateRewardSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.Funds.Empty() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "funds must not be empty")
+	}
+
+	return msg.Schedule.ValidateBasic(msg.Funds)
+}
@@ -41,6 +41,44 @@ func TestMsgFeederDelegation(t *testing.T) {
 	}
 }
 
+func TestMsgAddFeeder(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	tests := []struct {
+		operator   sdk.ValAddress
+		feeder     sdk.AccAddress
+		denoms     []string
+		expectPass bool
+	}{
+		{sdk.ValAddress(addrs[0]), addrs[1], nil, true},
+		{sdk.ValAddress(addrs[0]), addrs[1], []string{AtomSymbol, PersistenceSymbol}, true},
+		{sdk.ValAddress(addrs[0]), addrs[1], []string{AtomSymbol, "atom"}, false},
+		{sdk.ValAddress(addrs[0]), addrs[1], []string{""}, false},
+		{sdk.ValAddress{}, addrs[1], nil, false},
+		{sdk.ValAddress(addrs[0]), sdk.AccAddress{}, nil, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAddFeeder(tc.operator, tc.feeder, 100, tc.denoms)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	msg := NewMsgRevokeFeeder(sdk.ValAddress(addrs[0]), addrs[1])
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+
+	msg = NewMsgRevokeFeeder(sdk.ValAddress(addrs[0]), sdk.AccAddress{})
+	require.Error(t, msg.ValidateBasic())
+}
+
 func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
 	addrs := []sdk.AccAddress{
 		sdk.AccAddress([]byte("addr1_______________")),
@@ -112,7 +150,7 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 	}{
 		{addrs[0], addrs[0], validSalt, exchangeRates, true, "test should pass"},
 		{addrs[0], addrs[0], validSalt, invalidExchangeRates, false, msgInvalidExchangeRates},
-		{addrs[0], addrs[0], validSalt, zeroExchangeRates, false, msgInvalidOraclePrice},
+		{addrs[0], addrs[0], validSalt, zeroExchangeRates, true, "abstaining should pass"},
 		{addrs[0], addrs[0], validSalt, negativeExchangeRates, false, msgInvalidOraclePrice},
 		{addrs[0], addrs[0], validSalt, overFlowMsgExchangeRates, false, msgInvalidOverflowExceedCharacter},
 		{addrs[0], addrs[0], validSalt, overFlowExchangeRates, false, msgInvalidOverflowValue},
@@ -172,3 +210,86 @@ func TestMsgDelegateFeedConsent(t *testing.T) {
 	require.NotNil(t, msgFeedConsent.GetSignBytes())
 	require.Equal(t, msgFeedConsent.GetSigners(), []sdk.AccAddress{sdk.AccAddress(vals[0])})
 }
+
+func TestMsgAddDenom(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+
+	msg := NewMsgAddDenom(authority, DenomPersistence)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = NewMsgAddDenom(sdk.AccAddress{}, DenomPersistence)
+	require.ErrorContains(t, msg.ValidateBasic(), "invalid authority address")
+
+	msg = NewMsgAddDenom(authority, Denom{BaseDenom: PersistenceDenom})
+	require.ErrorContains(t, msg.ValidateBasic(), "must have SymbolDenom")
+}
+
+func TestMsgUpdateDenom(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+
+	msg := NewMsgUpdateDenom(authority, DenomPersistence)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = NewMsgUpdateDenom(authority, Denom{SymbolDenom: PersistenceSymbol})
+	require.ErrorContains(t, msg.ValidateBasic(), "must have BaseDenom")
+}
+
+func TestMsgRemoveDenom(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+
+	msg := NewMsgRemoveDenom(authority, PersistenceSymbol)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = NewMsgRemoveDenom(authority, "")
+	require.ErrorContains(t, msg.ValidateBasic(), "symbol denom must not be empty")
+}
+
+func TestMsgResetCircuitBreaker(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+
+	msg := NewMsgResetCircuitBreaker(authority, PersistenceSymbol)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = NewMsgResetCircuitBreaker(authority, "")
+	require.ErrorContains(t, msg.ValidateBasic(), "symbol denom must not be empty")
+}
+
+func TestMsgAddFundsToRewardPool(t *testing.T) {
+	from := sdk.AccAddress([]byte("from________________"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 2500))
+
+	msg := NewMsgAddFundsToRewardPool(from, funds)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{from}, msg.GetSigners())
+
+	msg = NewMsgAddFundsToRewardPool(from, sdk.Coins{})
+	require.ErrorContains(t, msg.ValidateBasic(), "invalid funds")
+
+	plan := NewRewardSchedulePlan(10, 100, sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 1000)))
+	msg = NewMsgAddFundsToRewardSchedule(from, funds, plan)
+	require.NoError(t, msg.ValidateBasic())
+
+	plan = NewRewardSchedulePlan(100, 10, sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 1000)))
+	msg = NewMsgAddFundsToRewardSchedule(from, funds, plan)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidRewardSchedule)
+
+	plan = NewRewardSchedulePlan(10, 100, sdk.NewCoins(sdk.NewInt64Coin(AtomDenom, 1000)))
+	msg = NewMsgAddFundsToRewardSchedule(from, funds, plan)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidRewardSchedule)
+}
+
+func TestMsgCreateRewardSchedule(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 2500))
+	plan := NewRewardSchedulePlan(0, 100, sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 1000)))
+
+	msg := NewMsgCreateRewardSchedule(authority, funds, plan)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = NewMsgCreateRewardSchedule(authority, sdk.Coins{}, plan)
+	require.ErrorContains(t, msg.ValidateBasic(), "funds must not be empty")
+
+	plan = NewRewardSchedulePlan(0, 0, sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 1000)))
+	msg = NewMsgCreateRewardSchedule(authority, funds, plan)
+	require.ErrorContains(t, msg.ValidateBasic(), "end height must be positive")
+}
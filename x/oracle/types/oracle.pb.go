@@ -6,18 +6,24 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +42,28 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// history_retention is the number of blocks tallied exchange rates are kept
+	// in the historic store. Zero disables the history.
+	HistoryRetention uint64 `protobuf:"varint,9,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty" yaml:"history_retention"`
+	// max_staleness is the number of blocks after its last successful tally
+	// that an exchange rate expires. Zero disables the expiry.
+	MaxStaleness uint64 `protobuf:"varint,10,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty" yaml:"max_staleness"`
+	// performance_windows is the number of slash windows the vote statistics of
+	// validators are kept for. Zero disables the statistics.
+	PerformanceWindows uint64 `protobuf:"varint,11,opt,name=performance_windows,json=performanceWindows,proto3" json:"performance_windows,omitempty" yaml:"performance_windows"`
+	// jail_duration is the time a validator jailed for missing oracle votes
+	// can not unjail for.
+	JailDuration time.Duration `protobuf:"bytes,12,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration,omitempty" yaml:"jail_duration"`
+	// reward_denoms are the denoms of the reward pool the unscheduled balance of
+	// which is paid out to ballot winners over the reward distribution window.
+	RewardDenoms []string `protobuf:"bytes,13,rep,name=reward_denoms,json=rewardDenoms,proto3" json:"reward_denoms,omitempty" yaml:"reward_denoms"`
+	// fee_share is the fraction of the collected fees of the reward denoms moved
+	// into the reward pool every block, before they are distributed. Zero
+	// disables the fee share.
+	FeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=fee_share,json=feeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_share" yaml:"fee_share"`
+	// tally_result_retention is the number of vote periods the statistics of
+	// the tallies of every denom are kept for. Zero disables the statistics.
+	TallyResultRetention uint64 `protobuf:"varint,15,opt,name=tally_result_retention,json=tallyResultRetention,proto3" json:"tally_result_retention,omitempty" yaml:"tally_result_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -98,11 +126,74 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStaleness() uint64 {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
+func (m *Params) GetPerformanceWindows() uint64 {
+	if m != nil {
+		return m.PerformanceWindows
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetRewardDenoms() []string {
+	if m != nil {
+		return m.RewardDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetTallyResultRetention() uint64 {
+	if m != nil {
+		return m.TallyResultRetention
+	}
+	return 0
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	BaseDenom   string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
 	SymbolDenom string `protobuf:"bytes,2,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty" yaml:"symbol_denom"`
 	Exponent    uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty" yaml:"exponent"`
+	// vote_threshold overrides the global VoteThreshold for the denom.
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold,omitempty"`
+	// reward_band overrides the global RewardBand for the denom.
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// min_voters is the minimum number of validators that must vote on the denom
+	// for its ballot to be tallied. Zero disables the check.
+	MinVoters uint64 `protobuf:"varint,6,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters,omitempty"`
+	// max_deviation is the maximum relative change of the exchange rate of the
+	// denom in a vote period, beyond which the denom is halted. Unset disables
+	// the circuit breaker.
+	MaxDeviation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation,omitempty" yaml:"max_deviation,omitempty"`
+	// quote_denom is the symbol of the accept list denom the exchange rate of the
+	// denom is quoted in. Empty quotes the exchange rate in USD.
+	QuoteDenom string `protobuf:"bytes,8,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom,omitempty"`
+	// aggregation_strategy is the name of the strategy aggregating the ballot of
+	// the denom into its exchange rate: weighted_median, trimmed_mean or
+	// mad_filtered_median. Unset selects the weighted median.
+	AggregationStrategy string `protobuf:"bytes,9,opt,name=aggregation_strategy,json=aggregationStrategy,proto3" json:"aggregation_strategy,omitempty" yaml:"aggregation_strategy,omitempty"`
+	// remote_price_source reads the price of the denom from a remote DEX pool
+	// through interchain queries. Unset disables the remote price.
+	RemotePriceSource *RemotePriceSource `protobuf:"bytes,10,opt,name=remote_price_source,json=remotePriceSource,proto3" json:"remote_price_source,omitempty" yaml:"remote_price_source,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -137,6 +228,120 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
+// RemotePriceSource - struct to configure the DEX pool on a remote chain the
+// price of a denom is derived from, and how the price is used in the tally
+type RemotePriceSource struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	ChainId      string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// pool_address is the address of the account holding the pool reserves on
+	// the remote chain.
+	PoolAddress string `protobuf:"bytes,3,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty" yaml:"pool_address"`
+	// base_denom is the remote denom of the reserve priced by the pool, i.e. the
+	// denom of the accept list.
+	BaseDenom string `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// quote_denom is the remote denom of the reserve the price is quoted in,
+	// corresponding to quote_symbol_denom.
+	QuoteDenom    string `protobuf:"bytes,5,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	BaseExponent  uint32 `protobuf:"varint,6,opt,name=base_exponent,json=baseExponent,proto3" json:"base_exponent,omitempty" yaml:"base_exponent"`
+	QuoteExponent uint32 `protobuf:"varint,7,opt,name=quote_exponent,json=quoteExponent,proto3" json:"quote_exponent,omitempty" yaml:"quote_exponent"`
+	// policy is how the remote price is used in the tally: fallback replaces the
+	// exchange rate of a vote period without quorum, sanity_bound rejects a
+	// tallied exchange rate deviating from the remote price.
+	Policy string `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty" yaml:"policy"`
+	// query_period is the number of blocks between two queries of the pool.
+	QueryPeriod uint64 `protobuf:"varint,9,opt,name=query_period,json=queryPeriod,proto3" json:"query_period,omitempty" yaml:"query_period"`
+	// max_age is the number of blocks after its receipt the remote price is used
+	// for.
+	MaxAge uint64 `protobuf:"varint,10,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty" yaml:"max_age"`
+	// max_deviation is the maximum relative deviation of the tallied exchange
+	// rate from the remote price. Only used by the sanity_bound policy.
+	MaxDeviation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation,omitempty" yaml:"max_deviation,omitempty"`
+	// quote_symbol_denom is the symbol denom of the accept list, or USD, the
+	// quote_denom reserve corresponds to. The remote price is converted from it
+	// to the asset the exchange rate of the denom is quoted in.
+	QuoteSymbolDenom string `protobuf:"bytes,12,opt,name=quote_symbol_denom,json=quoteSymbolDenom,proto3" json:"quote_symbol_denom,omitempty" yaml:"quote_symbol_denom"`
+	// query_ttl is the number of blocks the reserves read by the queries of the
+	// pool are kept to be paired into a remote price. It must cover the blocks
+	// between the responses to the queries of both reserves.
+	QueryTtl uint64 `protobuf:"varint,13,opt,name=query_ttl,json=queryTtl,proto3" json:"query_ttl,omitempty" yaml:"query_ttl"`
+}
+
+func (m *RemotePriceSource) Reset()      { *m = RemotePriceSource{} }
+func (*RemotePriceSource) ProtoMessage() {}
+func (*RemotePriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{2}
+}
+func (m *RemotePriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemotePriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemotePriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemotePriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemotePriceSource.Merge(m, src)
+}
+func (m *RemotePriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemotePriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemotePriceSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemotePriceSource proto.InternalMessageInfo
+
+// RemotePrice - struct to store the price of a denom derived from the reserves
+// of its remote DEX pool
+type RemotePrice struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// price is quoted in the quote_symbol_denom of the remote price source.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// remote_height is the height of the remote chain the reserves were read at.
+	RemoteHeight uint64 `protobuf:"varint,3,opt,name=remote_height,json=remoteHeight,proto3" json:"remote_height,omitempty" yaml:"remote_height"`
+	// local_height is the block height the reserves were received at.
+	LocalHeight uint64 `protobuf:"varint,4,opt,name=local_height,json=localHeight,proto3" json:"local_height,omitempty" yaml:"local_height"`
+}
+
+func (m *RemotePrice) Reset()      { *m = RemotePrice{} }
+func (*RemotePrice) ProtoMessage() {}
+func (*RemotePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{3}
+}
+func (m *RemotePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemotePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemotePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemotePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemotePrice.Merge(m, src)
+}
+func (m *RemotePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemotePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemotePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemotePrice proto.InternalMessageInfo
+
 // AggregateExchangeRatePrevote -
 // struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
@@ -151,7 +356,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc8322d7a861841e, []int{2}
+	return fileDescriptor_dc8322d7a861841e, []int{4}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +395,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc8322d7a861841e, []int{3}
+	return fileDescriptor_dc8322d7a861841e, []int{5}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +433,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc8322d7a861841e, []int{4}
+	return fileDescriptor_dc8322d7a861841e, []int{6}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,12 +462,405 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
+// HistoricExchangeRate - struct to store a tallied exchange rate together with
+// the block it was tallied at
+type HistoricExchangeRate struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	BlockHeight  uint64                                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	BlockTime    time.Time                              `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time" yaml:"block_time"`
+}
+
+func (m *HistoricExchangeRate) Reset()      { *m = HistoricExchangeRate{} }
+func (*HistoricExchangeRate) ProtoMessage() {}
+func (*HistoricExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{7}
+}
+func (m *HistoricExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricExchangeRate.Merge(m, src)
+}
+func (m *HistoricExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricExchangeRate proto.InternalMessageInfo
+
+// Feeder - struct to store an account registered by a validator operator to
+// submit oracle votes on its behalf, in addition to its feeder delegation
+type Feeder struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// expiry_height is the block height from which on the feeder is no longer
+	// active. Zero never expires.
+	ExpiryHeight uint64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height,omitempty"`
+	// denoms restricts the feeder to votes on these symbol denoms. Empty allows
+	// all denoms. The votes of the feeder abstain from the other denoms of the
+	// accept list.
+	Denoms []string `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms,omitempty"`
+}
+
+func (m *Feeder) Reset()      { *m = Feeder{} }
+func (*Feeder) ProtoMessage() {}
+func (*Feeder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{8}
+}
+func (m *Feeder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Feeder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Feeder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Feeder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Feeder.Merge(m, src)
+}
+func (m *Feeder) XXX_Size() int {
+	return m.Size()
+}
+func (m *Feeder) XXX_DiscardUnknown() {
+	xxx_messageInfo_Feeder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Feeder proto.InternalMessageInfo
+
+// TallyResult - struct to store the statistics of the tally of the ballot of a
+// denom in a vote period
+type TallyResult struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// vote_period is the index of the vote period, i.e. the block height divided
+	// by the vote period.
+	VotePeriod  uint64 `protobuf:"varint,2,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	// exchange_rate is the rate the ballot was aggregated into by the
+	// aggregation strategy of the denom.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// median is the weighted median of the ballot.
+	Median            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=median,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median" yaml:"median"`
+	StandardDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=standard_deviation,json=standardDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"standard_deviation" yaml:"standard_deviation"`
+	// reward_spread is the distance from the exchange rate within which votes
+	// were rewarded.
+	RewardSpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=reward_spread,json=rewardSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_spread" yaml:"reward_spread"`
+	// participating_power is the voting power of the ballot.
+	ParticipatingPower int64 `protobuf:"varint,8,opt,name=participating_power,json=participatingPower,proto3" json:"participating_power,omitempty" yaml:"participating_power"`
+	// support_ratio is the share of the bonded voting power that participated.
+	SupportRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=support_ratio,json=supportRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"support_ratio" yaml:"support_ratio"`
+	VoterCount   uint64                                 `protobuf:"varint,10,opt,name=voter_count,json=voterCount,proto3" json:"voter_count,omitempty" yaml:"voter_count"`
+	WinnerCount  uint64                                 `protobuf:"varint,11,opt,name=winner_count,json=winnerCount,proto3" json:"winner_count,omitempty" yaml:"winner_count"`
+}
+
+func (m *TallyResult) Reset()      { *m = TallyResult{} }
+func (*TallyResult) ProtoMessage() {}
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{9}
+}
+func (m *TallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyResult.Merge(m, src)
+}
+func (m *TallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyResult proto.InternalMessageInfo
+
+// ExchangeRateMetadata - struct to store the freshness of an exchange rate, as
+// of its last successful tally
+type ExchangeRateMetadata struct {
+	Denom            string    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	LastUpdateHeight uint64    `protobuf:"varint,2,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty" yaml:"last_update_height"`
+	LastUpdateTime   time.Time `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time" yaml:"last_update_time"`
+	// voter_count is the number of validators in the tallied ballot.
+	VoterCount uint64 `protobuf:"varint,4,opt,name=voter_count,json=voterCount,proto3" json:"voter_count,omitempty" yaml:"voter_count"`
+	// power_share is the share of the bonded voting power in the tallied ballot.
+	PowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=power_share,json=powerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_share" yaml:"power_share"`
+}
+
+func (m *ExchangeRateMetadata) Reset()      { *m = ExchangeRateMetadata{} }
+func (*ExchangeRateMetadata) ProtoMessage() {}
+func (*ExchangeRateMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{10}
+}
+func (m *ExchangeRateMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateMetadata.Merge(m, src)
+}
+func (m *ExchangeRateMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateMetadata proto.InternalMessageInfo
+
+// HaltedDenom - struct to store a denom halted by the circuit breaker, whose
+// exchange rate is frozen at the last good rate until it expires
+type HaltedDenom struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	HaltHeight uint64 `protobuf:"varint,2,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty" yaml:"halt_height"`
+	// exchange_rate is the last good exchange rate the denom is frozen at.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// rejected_exchange_rate is the tallied exchange rate that tripped the
+	// circuit breaker.
+	RejectedExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=rejected_exchange_rate,json=rejectedExchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rejected_exchange_rate" yaml:"rejected_exchange_rate"`
+	// reset_requested is set by governance to accept the next tallied exchange rate
+	// without deviation check, which lifts the halt.
+	ResetRequested bool `protobuf:"varint,5,opt,name=reset_requested,json=resetRequested,proto3" json:"reset_requested,omitempty" yaml:"reset_requested"`
+}
+
+func (m *HaltedDenom) Reset()      { *m = HaltedDenom{} }
+func (*HaltedDenom) ProtoMessage() {}
+func (*HaltedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{11}
+}
+func (m *HaltedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HaltedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HaltedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HaltedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaltedDenom.Merge(m, src)
+}
+func (m *HaltedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *HaltedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaltedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaltedDenom proto.InternalMessageInfo
+
+// ValidatorPerformance - struct to store the vote statistics of a validator
+// over the vote periods of a slash window
+type ValidatorPerformance struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	// window is the index of the slash window, i.e. the block height divided by
+	// the slash window.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty" yaml:"window"`
+	// voted is the number of vote periods the validator submitted a vote in.
+	Voted uint64 `protobuf:"varint,3,opt,name=voted,proto3" json:"voted,omitempty" yaml:"voted"`
+	// won is the number of vote periods all the rates of the validator were
+	// inside the reward band.
+	Won uint64 `protobuf:"varint,4,opt,name=won,proto3" json:"won,omitempty" yaml:"won"`
+	// missed is the number of vote periods counted as a miss.
+	Missed uint64 `protobuf:"varint,5,opt,name=missed,proto3" json:"missed,omitempty" yaml:"missed"`
+	// abstained is the number of vote periods the validator voted a non-positive
+	// rate for at least one denom.
+	Abstained uint64 `protobuf:"varint,6,opt,name=abstained,proto3" json:"abstained,omitempty" yaml:"abstained"`
+}
+
+func (m *ValidatorPerformance) Reset()      { *m = ValidatorPerformance{} }
+func (*ValidatorPerformance) ProtoMessage() {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{12}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+// OracleJail - struct to store why a validator was jailed for missing oracle
+// votes and until when
+type OracleJail struct {
+	Validator   string    `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	JailHeight  uint64    `protobuf:"varint,2,opt,name=jail_height,json=jailHeight,proto3" json:"jail_height,omitempty" yaml:"jail_height"`
+	JailedUntil time.Time `protobuf:"bytes,3,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until" yaml:"jailed_until"`
+	// miss_counter is the number of vote periods missed in the slash window.
+	MissCounter uint64 `protobuf:"varint,4,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty" yaml:"miss_counter"`
+	// valid_vote_rate is the share of the vote periods of the slash window that
+	// were not missed.
+	ValidVoteRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate" yaml:"valid_vote_rate"`
+	// slashed_tokens is the amount of tokens burned by the slash.
+	SlashedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=slashed_tokens,json=slashedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slashed_tokens" yaml:"slashed_tokens"`
+}
+
+func (m *OracleJail) Reset()      { *m = OracleJail{} }
+func (*OracleJail) ProtoMessage() {}
+func (*OracleJail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{13}
+}
+func (m *OracleJail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleJail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleJail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleJail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleJail.Merge(m, src)
+}
+func (m *OracleJail) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleJail) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleJail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleJail proto.InternalMessageInfo
+
+// RewardSchedule - struct to store funds of the reward pool reserved to be
+// paid out to ballot winners at a fixed amount per vote period
+type RewardSchedule struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// funder is the account that funded the schedule, the governance account for
+	// schedules reserving the unscheduled balance of the reward pool.
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty" yaml:"funder"`
+	// start_height is the first block height rewards are paid out at.
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// end_height is the last block height rewards are paid out at. Funds left
+	// afterwards are released to the unscheduled balance of the reward pool.
+	EndHeight uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// amount_per_period is paid out to ballot winners every vote period.
+	AmountPerPeriod github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount_per_period,json=amountPerPeriod,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_period" yaml:"amount_per_period"`
+	// remaining_funds are the funds of the schedule not paid out yet.
+	RemainingFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=remaining_funds,json=remainingFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_funds" yaml:"remaining_funds"`
+}
+
+func (m *RewardSchedule) Reset()      { *m = RewardSchedule{} }
+func (*RewardSchedule) ProtoMessage() {}
+func (*RewardSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8322d7a861841e, []int{14}
+}
+func (m *RewardSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardSchedule.Merge(m, src)
+}
+func (m *RewardSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardSchedule proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "persistence.oracle.v1beta1.Params")
 	proto.RegisterType((*Denom)(nil), "persistence.oracle.v1beta1.Denom")
+	proto.RegisterType((*RemotePriceSource)(nil), "persistence.oracle.v1beta1.RemotePriceSource")
+	proto.RegisterType((*RemotePrice)(nil), "persistence.oracle.v1beta1.RemotePrice")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "persistence.oracle.v1beta1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "persistence.oracle.v1beta1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "persistence.oracle.v1beta1.ExchangeRateTuple")
+	proto.RegisterType((*HistoricExchangeRate)(nil), "persistence.oracle.v1beta1.HistoricExchangeRate")
+	proto.RegisterType((*Feeder)(nil), "persistence.oracle.v1beta1.Feeder")
+	proto.RegisterType((*TallyResult)(nil), "persistence.oracle.v1beta1.TallyResult")
+	proto.RegisterType((*ExchangeRateMetadata)(nil), "persistence.oracle.v1beta1.ExchangeRateMetadata")
+	proto.RegisterType((*HaltedDenom)(nil), "persistence.oracle.v1beta1.HaltedDenom")
+	proto.RegisterType((*ValidatorPerformance)(nil), "persistence.oracle.v1beta1.ValidatorPerformance")
+	proto.RegisterType((*OracleJail)(nil), "persistence.oracle.v1beta1.OracleJail")
+	proto.RegisterType((*RewardSchedule)(nil), "persistence.oracle.v1beta1.RewardSchedule")
 }
 
 func init() {
@@ -270,58 +868,169 @@ func init() {
 }
 
 var fileDescriptor_dc8322d7a861841e = []byte{
-	// 815 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0x8e, 0xdb, 0xdd, 0x65, 0x77, 0x92, 0x50, 0xd6, 0x4d, 0xc1, 0x4d, 0x51, 0xbc, 0x1d, 0x44,
-	0x9b, 0xcb, 0x26, 0x6a, 0x41, 0x42, 0x04, 0x09, 0xa9, 0x56, 0x28, 0x17, 0x10, 0x91, 0x29, 0x45,
-	0xe2, 0x62, 0x8d, 0xed, 0xc1, 0xb1, 0x62, 0x7b, 0xa2, 0x99, 0x49, 0x76, 0xf7, 0xc2, 0x11, 0x71,
-	0xe4, 0x88, 0x38, 0xe5, 0xcc, 0x19, 0x21, 0x7e, 0x42, 0x2f, 0x48, 0x15, 0x27, 0xc4, 0xc1, 0xa0,
-	0xdd, 0x0b, 0x67, 0xff, 0x02, 0x34, 0x1f, 0x69, 0x26, 0x4d, 0x40, 0x5a, 0xed, 0xc9, 0x7e, 0xde,
-	0xe7, 0xfd, 0x7c, 0x66, 0x5e, 0x1b, 0xdc, 0x9f, 0x62, 0xca, 0x52, 0xc6, 0x71, 0x11, 0xe1, 0x3e,
-	0xa1, 0x28, 0xca, 0x70, 0x7f, 0xfe, 0x20, 0xc4, 0x1c, 0x3d, 0xd0, 0xb0, 0x37, 0xa5, 0x84, 0x13,
-	0xbb, 0x6d, 0x38, 0xf6, 0x34, 0xa3, 0x1d, 0xdb, 0xad, 0x84, 0x24, 0x44, 0xba, 0xf5, 0xc5, 0x9b,
-	0x8a, 0x68, 0xdf, 0x8e, 0x08, 0xcb, 0x09, 0x0b, 0x14, 0xa1, 0x80, 0xa2, 0xe0, 0x62, 0x0f, 0xec,
-	0x8d, 0x10, 0x45, 0x39, 0xb3, 0xdf, 0x03, 0xf5, 0x39, 0xe1, 0x38, 0x98, 0x62, 0x9a, 0x92, 0xd8,
-	0xb1, 0x8e, 0xac, 0xee, 0x8e, 0xf7, 0x7a, 0x55, 0xba, 0xf6, 0x19, 0xca, 0xb3, 0x01, 0x34, 0x48,
-	0xe8, 0x03, 0x81, 0x46, 0x12, 0xd8, 0x05, 0x78, 0x55, 0x72, 0x7c, 0x4c, 0x31, 0x1b, 0x93, 0x2c,
-	0x76, 0xae, 0x1d, 0x59, 0xdd, 0x03, 0xef, 0xe3, 0x67, 0xa5, 0x5b, 0xfb, 0xb3, 0x74, 0xef, 0x25,
-	0x29, 0x1f, 0xcf, 0xc2, 0x5e, 0x44, 0x72, 0x5d, 0x5c, 0x3f, 0x8e, 0x59, 0x3c, 0xe9, 0xf3, 0xb3,
-	0x29, 0x66, 0xbd, 0x21, 0x8e, 0xaa, 0xd2, 0xbd, 0x65, 0x54, 0x7a, 0x91, 0x0d, 0xfa, 0x4d, 0x61,
-	0x78, 0xb2, 0xc4, 0x36, 0x06, 0x75, 0x8a, 0x4f, 0x10, 0x8d, 0x83, 0x10, 0x15, 0xb1, 0x73, 0x5d,
-	0x16, 0x1b, 0x5e, 0xba, 0x98, 0x1e, 0xcb, 0x48, 0x05, 0x7d, 0xa0, 0x90, 0x87, 0x8a, 0xd8, 0x8e,
-	0x40, 0x5b, 0x73, 0x71, 0xca, 0x38, 0x4d, 0xc3, 0x19, 0x4f, 0x49, 0x11, 0x9c, 0xa4, 0x45, 0x4c,
-	0x4e, 0x9c, 0x1d, 0x29, 0xcf, 0xdb, 0x55, 0xe9, 0xde, 0x5d, 0xcb, 0xb3, 0xc5, 0x17, 0xfa, 0x8e,
-	0x22, 0x87, 0x06, 0xf7, 0xa5, 0xa4, 0xec, 0x09, 0xa8, 0xa3, 0x28, 0xc2, 0x53, 0x1e, 0x64, 0x29,
-	0xe3, 0xce, 0xee, 0xd1, 0xf5, 0x6e, 0xfd, 0xe1, 0xdd, 0xde, 0x7f, 0x1f, 0x71, 0x6f, 0x88, 0x0b,
-	0x92, 0x7b, 0xf7, 0xc5, 0xb8, 0xab, 0x21, 0x8c, 0x1c, 0xf0, 0xa7, 0xbf, 0xdc, 0x03, 0xe9, 0xf4,
-	0x49, 0xca, 0xb8, 0x0f, 0x14, 0x25, 0xde, 0xc5, 0x41, 0xb1, 0x0c, 0xb1, 0x71, 0xf0, 0x35, 0x45,
-	0x91, 0x68, 0xc2, 0xd9, 0xbb, 0xda, 0x41, 0xad, 0x67, 0x83, 0x7e, 0x53, 0x1a, 0x1e, 0x6b, 0x6c,
-	0x0f, 0x40, 0x43, 0x79, 0x68, 0xcd, 0x5e, 0x91, 0x9a, 0xbd, 0x51, 0x95, 0xee, 0x4d, 0x33, 0x7e,
-	0xa9, 0x52, 0x5d, 0x42, 0x2d, 0xcc, 0x37, 0xa0, 0x95, 0xa7, 0x45, 0x30, 0x47, 0x59, 0x1a, 0x8b,
-	0x5b, 0xb7, 0xcc, 0xb1, 0x2f, 0x3b, 0xfe, 0xf4, 0xd2, 0x1d, 0xdf, 0x51, 0x15, 0xb7, 0xe5, 0x84,
-	0xfe, 0x61, 0x9e, 0x16, 0x4f, 0x85, 0x75, 0x84, 0xa9, 0xaa, 0x3f, 0xd8, 0xff, 0x61, 0xe1, 0xd6,
-	0xfe, 0x59, 0xb8, 0x16, 0xfc, 0xd5, 0x02, 0xbb, 0x52, 0x4f, 0xfb, 0x5d, 0x00, 0x42, 0xc4, 0x70,
-	0x10, 0x0b, 0x24, 0x17, 0xe4, 0xc0, 0xbb, 0x55, 0x95, 0xee, 0xa1, 0xca, 0xbd, 0xe2, 0xa0, 0x7f,
-	0x20, 0x80, 0x8a, 0x12, 0x2a, 0x9c, 0xe5, 0x21, 0xc9, 0x74, 0x9c, 0x5a, 0x0e, 0x53, 0x05, 0x83,
-	0x15, 0x2a, 0x48, 0xa8, 0x62, 0xfb, 0x60, 0x1f, 0x9f, 0x4e, 0x49, 0x81, 0x0b, 0x2e, 0xef, 0x79,
-	0xd3, 0xbb, 0x59, 0x95, 0xee, 0x0d, 0x15, 0xb7, 0x64, 0xa0, 0xff, 0xc2, 0x69, 0xd0, 0xf8, 0x6e,
-	0xe1, 0xd6, 0x74, 0xeb, 0x35, 0xf8, 0x9b, 0x05, 0xde, 0x7c, 0x94, 0x24, 0x14, 0x27, 0x88, 0xe3,
-	0x8f, 0x4e, 0xa3, 0x31, 0x2a, 0x12, 0xec, 0x23, 0x8e, 0x47, 0x14, 0x8b, 0x9d, 0xb2, 0xdf, 0x02,
-	0x3b, 0x63, 0xc4, 0xc6, 0x7a, 0x96, 0x1b, 0x55, 0xe9, 0xd6, 0x55, 0x6e, 0x61, 0x85, 0xbe, 0x24,
-	0xed, 0x0f, 0xc1, 0xae, 0x70, 0xa6, 0xba, 0xf3, 0x6e, 0x55, 0xba, 0x8d, 0xd5, 0xa2, 0x52, 0xf8,
-	0xfb, 0xcf, 0xc7, 0x2d, 0xfd, 0x51, 0x79, 0x14, 0xc7, 0x14, 0x33, 0xf6, 0x39, 0xa7, 0x69, 0x91,
-	0xf8, 0x2a, 0x4c, 0x0a, 0x30, 0x0b, 0xf3, 0x94, 0x07, 0x61, 0x46, 0xa2, 0x89, 0x1c, 0x64, 0xfd,
-	0x1a, 0x18, 0xac, 0x10, 0x40, 0x42, 0x4f, 0xa0, 0x97, 0xe6, 0xf9, 0xf6, 0x1a, 0xb8, 0xbd, 0x75,
-	0x9e, 0xa7, 0x62, 0x98, 0x1f, 0x2d, 0xd0, 0xc2, 0xda, 0x18, 0x50, 0x24, 0xbe, 0x21, 0xb3, 0x69,
-	0x86, 0x99, 0x63, 0xc9, 0xad, 0x3a, 0xfe, 0xbf, 0xad, 0x32, 0x93, 0x3d, 0x11, 0x51, 0xde, 0xfb,
-	0x7a, 0xc3, 0xee, 0x2c, 0xc5, 0xde, 0x4c, 0x2c, 0x56, 0xcd, 0xde, 0x88, 0x64, 0xbe, 0x8d, 0x37,
-	0x6c, 0x57, 0x15, 0xf1, 0x25, 0x21, 0x7e, 0xb1, 0xc0, 0xe1, 0x46, 0x61, 0xfb, 0x1e, 0xd8, 0x35,
-	0xaf, 0xe6, 0x6b, 0xab, 0x1a, 0xfa, 0x6e, 0x29, 0xda, 0x9e, 0x80, 0xe6, 0xda, 0x38, 0xba, 0xa7,
-	0xc7, 0x97, 0x5e, 0xaa, 0xd6, 0x16, 0x6d, 0xa0, 0xdf, 0x30, 0xc7, 0x5f, 0x6f, 0xdc, 0xfb, 0xe2,
-	0xd9, 0x79, 0xc7, 0x7a, 0x7e, 0xde, 0xb1, 0xfe, 0x3e, 0xef, 0x58, 0xdf, 0x5f, 0x74, 0x6a, 0xcf,
-	0x2f, 0x3a, 0xb5, 0x3f, 0x2e, 0x3a, 0xb5, 0xaf, 0x3e, 0x30, 0xaa, 0x1a, 0x07, 0xf5, 0x59, 0x81,
-	0x4d, 0x28, 0x5b, 0x98, 0x3f, 0xec, 0x9f, 0x2e, 0x7f, 0x91, 0xb2, 0x9d, 0x70, 0x4f, 0xfe, 0xcd,
-	0xde, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xf9, 0xa8, 0x4e, 0x45, 0x07, 0x00, 0x00,
+	// 2589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x17, 0x45, 0x8a, 0x12, 0x67, 0x49, 0xfd, 0x58, 0x31, 0x36, 0xa5, 0xc4, 0x5a, 0x79, 0xf2,
+	0x4d, 0x22, 0x7f, 0x1b, 0x53, 0xb0, 0x12, 0xc0, 0xad, 0x8b, 0xb8, 0x35, 0xad, 0x38, 0xfe, 0x15,
+	0x58, 0x18, 0x29, 0x71, 0x51, 0x14, 0x5d, 0x0c, 0x77, 0x47, 0xe4, 0x46, 0xfb, 0x83, 0xde, 0x1d,
+	0x5a, 0x52, 0x0b, 0xf4, 0xd6, 0x1f, 0xa7, 0x22, 0x40, 0x7b, 0x48, 0x03, 0x14, 0xf0, 0xa1, 0xe8,
+	0xa1, 0xe7, 0xa2, 0xff, 0x40, 0x7b, 0xc8, 0xa5, 0x68, 0xd0, 0x53, 0xd1, 0xc3, 0xa6, 0xb0, 0x2f,
+	0x45, 0x6f, 0xe5, 0xad, 0xb7, 0x62, 0x7e, 0x2c, 0x77, 0x76, 0x49, 0xd5, 0x62, 0x15, 0x03, 0x3d,
+	0x91, 0xef, 0xbd, 0x79, 0xef, 0xcd, 0xbc, 0x79, 0xef, 0x33, 0x6f, 0x66, 0xc1, 0x1b, 0x3d, 0x12,
+	0x46, 0x4e, 0x44, 0x89, 0x6f, 0x91, 0xcd, 0x20, 0xc4, 0x96, 0x4b, 0x36, 0x1f, 0x5f, 0x69, 0x13,
+	0x8a, 0xaf, 0x48, 0xb2, 0xd9, 0x0b, 0x03, 0x1a, 0xe8, 0xab, 0xca, 0xc0, 0xa6, 0x94, 0xc8, 0x81,
+	0xab, 0xf5, 0x4e, 0xd0, 0x09, 0xf8, 0xb0, 0x4d, 0xf6, 0x4f, 0x68, 0xac, 0xae, 0x75, 0x82, 0xa0,
+	0xe3, 0x92, 0x4d, 0x4e, 0xb5, 0xfb, 0xfb, 0x9b, 0x76, 0x3f, 0xc4, 0xd4, 0x09, 0x7c, 0x29, 0x37,
+	0xf2, 0x72, 0xea, 0x78, 0x24, 0xa2, 0xd8, 0xeb, 0x25, 0x06, 0xac, 0x20, 0xf2, 0x82, 0x68, 0xb3,
+	0x8d, 0xa3, 0x74, 0x52, 0x56, 0xe0, 0x24, 0x06, 0x56, 0x84, 0xdc, 0x14, 0x9e, 0x05, 0x21, 0x44,
+	0xf0, 0x9f, 0x00, 0x94, 0x77, 0x70, 0x88, 0xbd, 0x48, 0xbf, 0x0a, 0xb4, 0xc7, 0x01, 0x25, 0x66,
+	0x8f, 0x84, 0x4e, 0x60, 0x37, 0x0a, 0xeb, 0x85, 0x8d, 0x52, 0xeb, 0xdc, 0x20, 0x36, 0xf4, 0x63,
+	0xec, 0xb9, 0xd7, 0xa0, 0x22, 0x84, 0x08, 0x30, 0x6a, 0x87, 0x13, 0xba, 0x0f, 0xe6, 0xb9, 0x8c,
+	0x76, 0x43, 0x12, 0x75, 0x03, 0xd7, 0x6e, 0x4c, 0xaf, 0x17, 0x36, 0x2a, 0xad, 0xf7, 0x3e, 0x8b,
+	0x8d, 0xa9, 0xbf, 0xc6, 0xc6, 0xeb, 0x1d, 0x87, 0x76, 0xfb, 0xed, 0xa6, 0x15, 0x78, 0xd2, 0xb9,
+	0xfc, 0xb9, 0x1c, 0xd9, 0x07, 0x9b, 0xf4, 0xb8, 0x47, 0xa2, 0xe6, 0x36, 0xb1, 0x06, 0xb1, 0xf1,
+	0x92, 0xe2, 0x69, 0x68, 0x0d, 0xa2, 0x1a, 0x63, 0xec, 0x25, 0xb4, 0x4e, 0x80, 0x16, 0x92, 0x43,
+	0x1c, 0xda, 0x66, 0x1b, 0xfb, 0x76, 0xa3, 0xc8, 0x9d, 0x6d, 0x4f, 0xec, 0x4c, 0x2e, 0x4b, 0x31,
+	0x05, 0x11, 0x10, 0x54, 0x0b, 0xfb, 0xb6, 0x6e, 0x81, 0x55, 0x29, 0xb3, 0x9d, 0x88, 0x86, 0x4e,
+	0xbb, 0xcf, 0xf6, 0xc4, 0x3c, 0x74, 0x7c, 0x3b, 0x38, 0x6c, 0x94, 0x78, 0x78, 0x5e, 0x1b, 0xc4,
+	0xc6, 0xc5, 0x8c, 0x9d, 0x31, 0x63, 0x21, 0x6a, 0x08, 0xe1, 0xb6, 0x22, 0x7b, 0xc8, 0x45, 0xfa,
+	0x01, 0xd0, 0xb0, 0x65, 0x91, 0x1e, 0x35, 0x5d, 0x27, 0xa2, 0x8d, 0x99, 0xf5, 0xe2, 0x86, 0xb6,
+	0x75, 0xb1, 0x79, 0x72, 0x0e, 0x35, 0xb7, 0x89, 0x1f, 0x78, 0xad, 0x37, 0xd8, 0x72, 0xd3, 0x45,
+	0x28, 0x36, 0xe0, 0x6f, 0xbe, 0x30, 0x2a, 0x7c, 0xd0, 0x7d, 0x27, 0xa2, 0x08, 0x08, 0x11, 0xfb,
+	0xcf, 0x36, 0x2a, 0x72, 0x71, 0xd4, 0x35, 0xf7, 0x43, 0x6c, 0xb1, 0x49, 0x34, 0xca, 0x67, 0xdb,
+	0xa8, 0xac, 0x35, 0x88, 0x6a, 0x9c, 0x71, 0x4b, 0xd2, 0xfa, 0x35, 0x50, 0x15, 0x23, 0x64, 0xcc,
+	0x66, 0x79, 0xcc, 0xce, 0x0f, 0x62, 0x63, 0x59, 0xd5, 0x4f, 0xa2, 0xa4, 0x71, 0x52, 0x06, 0xe6,
+	0x07, 0xa0, 0xee, 0x39, 0xbe, 0xf9, 0x18, 0xbb, 0x8e, 0xcd, 0xb2, 0x2e, 0xb1, 0x31, 0xc7, 0x67,
+	0xfc, 0xfe, 0xc4, 0x33, 0x7e, 0x59, 0x78, 0x1c, 0x67, 0x13, 0xa2, 0x25, 0xcf, 0xf1, 0x3f, 0x64,
+	0xdc, 0x1d, 0x12, 0x4a, 0xff, 0x77, 0xc0, 0x52, 0xd7, 0x89, 0x68, 0x10, 0x1e, 0x9b, 0x21, 0xa1,
+	0xc4, 0xe7, 0xe1, 0xaa, 0xf0, 0x05, 0xbc, 0x32, 0x88, 0x8d, 0x86, 0x30, 0x37, 0x32, 0x04, 0xa2,
+	0x45, 0xc9, 0x43, 0x09, 0x4b, 0x7f, 0x07, 0xd4, 0x3c, 0x7c, 0x64, 0x46, 0x14, 0xbb, 0xc4, 0x27,
+	0x51, 0xd4, 0x00, 0xdc, 0x4c, 0x63, 0x10, 0x1b, 0x75, 0x39, 0x2b, 0x55, 0x0c, 0x51, 0xd5, 0xc3,
+	0x47, 0xbb, 0x09, 0xa9, 0x3f, 0x00, 0xcb, 0x3d, 0x12, 0xee, 0x07, 0xa1, 0x87, 0x7d, 0x8b, 0xc8,
+	0x39, 0x47, 0x0d, 0x8d, 0x1b, 0x59, 0x1b, 0xc4, 0xc6, 0xaa, 0x30, 0x32, 0x66, 0x10, 0x44, 0xba,
+	0xc2, 0x15, 0x2b, 0x8b, 0xf4, 0xef, 0x83, 0xda, 0x47, 0xd8, 0x71, 0xcd, 0x04, 0x66, 0x1a, 0xd5,
+	0xf5, 0xc2, 0x86, 0xb6, 0xb5, 0xd2, 0x14, 0x38, 0xd3, 0x4c, 0x70, 0xa6, 0xb9, 0x2d, 0x07, 0xb4,
+	0xbe, 0xce, 0xc2, 0xfd, 0x8f, 0xd8, 0x38, 0x9f, 0xd1, 0x7b, 0x33, 0xf0, 0x1c, 0x4a, 0xbc, 0x1e,
+	0x3d, 0x4e, 0x57, 0x92, 0x19, 0x00, 0x3f, 0xf9, 0xc2, 0x28, 0xa0, 0x2a, 0xe3, 0x25, 0xa6, 0x58,
+	0x30, 0x92, 0x4a, 0x61, 0x39, 0x1a, 0x35, 0x6a, 0xeb, 0xc5, 0x8d, 0x8a, 0x1a, 0x8c, 0x8c, 0x18,
+	0xa2, 0xaa, 0xac, 0x1d, 0x4e, 0xea, 0x26, 0xa8, 0xec, 0x13, 0x62, 0x46, 0x5d, 0x1c, 0x92, 0xc6,
+	0x3c, 0xcf, 0x85, 0xd6, 0xc4, 0xb9, 0xb0, 0x28, 0x1c, 0x0d, 0x0d, 0x41, 0x34, 0xb7, 0x4f, 0xc8,
+	0x2e, 0xfb, 0xab, 0x3f, 0x04, 0xe7, 0x28, 0x76, 0x5d, 0xb6, 0xa5, 0x51, 0xdf, 0xa5, 0xca, 0xe6,
+	0x2f, 0xf0, 0x80, 0x5f, 0x1c, 0xc4, 0xc6, 0x05, 0xa1, 0x3f, 0x7e, 0x1c, 0x44, 0x75, 0x2e, 0x40,
+	0x9c, 0x3f, 0xcc, 0x82, 0x6b, 0x73, 0x9f, 0x3c, 0x31, 0xa6, 0xfe, 0xfe, 0xc4, 0x28, 0xc0, 0x7f,
+	0x95, 0xc1, 0x0c, 0x5f, 0x8e, 0xfe, 0x36, 0x00, 0x0c, 0xb3, 0xc5, 0x5a, 0x39, 0xe2, 0x56, 0x5a,
+	0x2f, 0x0d, 0x62, 0x63, 0x49, 0x38, 0x48, 0x65, 0x10, 0x55, 0x18, 0x21, 0xb4, 0x58, 0x59, 0x1d,
+	0x7b, 0xed, 0xc0, 0x95, 0x7a, 0x02, 0x6d, 0xd5, 0xb2, 0x52, 0xa4, 0xac, 0xac, 0x38, 0x29, 0x74,
+	0x37, 0xc1, 0x1c, 0x39, 0xea, 0x05, 0x3e, 0xf1, 0x29, 0x07, 0xce, 0x5a, 0x6b, 0x79, 0x10, 0x1b,
+	0x0b, 0x42, 0x2f, 0x91, 0x40, 0x34, 0x1c, 0xa4, 0xd3, 0x11, 0x70, 0x2f, 0x89, 0x0a, 0x9c, 0x28,
+	0xe2, 0xc6, 0x38, 0x60, 0x4f, 0xf3, 0x67, 0x04, 0xe2, 0x0f, 0xb2, 0x10, 0x3f, 0xc3, 0x5d, 0xde,
+	0x9d, 0xc8, 0xe5, 0x2b, 0x23, 0xf0, 0xae, 0xfa, 0x53, 0x81, 0xfe, 0x3a, 0x00, 0x1c, 0x16, 0x02,
+	0x4a, 0xc2, 0x88, 0x43, 0x62, 0xa9, 0x65, 0xe4, 0x20, 0x83, 0xcb, 0x54, 0x03, 0x15, 0x06, 0x19,
+	0x9c, 0xab, 0x3f, 0x12, 0xf5, 0x6d, 0x93, 0xc7, 0x8e, 0xa8, 0xa7, 0x59, 0x3e, 0xdd, 0xfb, 0x13,
+	0x4d, 0x77, 0x2d, 0x45, 0x82, 0xa1, 0x21, 0xd5, 0x1f, 0xc3, 0x84, 0xed, 0x44, 0xa0, 0xdf, 0x00,
+	0xda, 0xa3, 0x3e, 0x0b, 0xa6, 0xc8, 0x00, 0x01, 0x8a, 0xeb, 0xe9, 0xaa, 0x15, 0x61, 0x66, 0xd5,
+	0x9c, 0x2f, 0x32, 0xe1, 0x3b, 0xa0, 0x8e, 0x3b, 0x9d, 0x90, 0x74, 0xb8, 0x45, 0x33, 0xa2, 0x21,
+	0xa6, 0xa4, 0x73, 0xcc, 0x31, 0xae, 0xd2, 0xba, 0x34, 0x88, 0x8d, 0xd7, 0xe4, 0xd9, 0x32, 0x66,
+	0x94, 0x6a, 0x74, 0x59, 0x19, 0xb0, 0x2b, 0xe5, 0xfa, 0x8f, 0x0b, 0x60, 0x39, 0x24, 0x1e, 0x6f,
+	0x19, 0x42, 0xc7, 0x22, 0x66, 0x14, 0xf4, 0x43, 0x8b, 0x70, 0xe8, 0xd3, 0xb6, 0x2e, 0xff, 0xa7,
+	0x03, 0x0e, 0x71, 0xb5, 0x1d, 0xa6, 0xb5, 0xcb, 0x95, 0x5a, 0x1b, 0x83, 0xd8, 0xf8, 0xbf, 0x64,
+	0x3b, 0x47, 0x6c, 0xaa, 0x73, 0x59, 0x0a, 0xf3, 0xca, 0xd7, 0xaa, 0x3f, 0x79, 0x62, 0x4c, 0xc9,
+	0xda, 0x9b, 0x82, 0xbf, 0x2f, 0x83, 0xa5, 0x11, 0x07, 0x0c, 0x94, 0xac, 0xc0, 0xf7, 0x09, 0x3f,
+	0xb6, 0x4c, 0xc7, 0x96, 0xa5, 0xa8, 0x80, 0x52, 0x46, 0x0c, 0x51, 0x35, 0xa5, 0xef, 0xd8, 0x7a,
+	0x13, 0xcc, 0x59, 0x5d, 0xec, 0x70, 0x4d, 0x51, 0x8c, 0x4a, 0x51, 0x25, 0x12, 0x88, 0x66, 0xf9,
+	0xdf, 0x3b, 0x36, 0x2b, 0xe0, 0x5e, 0x10, 0xb8, 0x26, 0xb6, 0xed, 0x90, 0x9d, 0x07, 0xc5, 0x7c,
+	0x01, 0xab, 0x52, 0x88, 0x34, 0x46, 0xde, 0x10, 0x54, 0x0e, 0x32, 0x4a, 0xa7, 0x84, 0x8c, 0xab,
+	0xd9, 0x7c, 0x11, 0xf5, 0xa4, 0xf4, 0x76, 0x8a, 0x30, 0x9b, 0x25, 0xef, 0x80, 0x1a, 0x37, 0x39,
+	0x04, 0x8d, 0x32, 0x07, 0x0d, 0x25, 0x32, 0x19, 0x31, 0x44, 0x55, 0x46, 0xbf, 0x2b, 0x49, 0xfd,
+	0x9b, 0x60, 0x5e, 0x98, 0x1e, 0xea, 0xcf, 0x72, 0xfd, 0x95, 0xb4, 0x87, 0xc8, 0xca, 0x21, 0xaa,
+	0x71, 0xc6, 0xd0, 0xc2, 0x25, 0x50, 0xee, 0x05, 0xae, 0x63, 0x1d, 0xcb, 0x24, 0x5f, 0x1a, 0xc4,
+	0x46, 0x2d, 0x89, 0x12, 0xe3, 0x43, 0x24, 0x07, 0xb0, 0xb0, 0x3e, 0xea, 0x93, 0xf0, 0x38, 0xe9,
+	0x60, 0x2b, 0xf9, 0x76, 0x43, 0x95, 0x42, 0xa4, 0x71, 0x52, 0xf6, 0xb0, 0x5f, 0x01, 0xb3, 0xac,
+	0xf4, 0x70, 0x87, 0xc8, 0xd3, 0x59, 0x1f, 0xc4, 0xc6, 0x7c, 0x5a, 0x93, 0xb8, 0x43, 0x20, 0x2a,
+	0x7b, 0xf8, 0xe8, 0x46, 0x87, 0x8c, 0x16, 0xbc, 0xf6, 0xc2, 0x0b, 0xfe, 0x1e, 0xd0, 0x45, 0xa0,
+	0x32, 0xc8, 0x5f, 0xe5, 0x7e, 0x2f, 0x0c, 0x62, 0x63, 0x45, 0x0d, 0x66, 0x16, 0xff, 0x17, 0x39,
+	0x73, 0x57, 0x39, 0x04, 0xae, 0x80, 0x8a, 0x08, 0x05, 0xa5, 0x6e, 0xa3, 0xc6, 0x97, 0x5b, 0x4f,
+	0x8f, 0xc5, 0xa1, 0x08, 0xa2, 0x39, 0xfe, 0x7f, 0x8f, 0xba, 0xb9, 0x2a, 0xfa, 0xf9, 0x34, 0xd0,
+	0x94, 0x2a, 0xd2, 0x5f, 0x07, 0x33, 0xea, 0x11, 0xb6, 0x38, 0x88, 0x8d, 0xaa, 0x30, 0x26, 0xe7,
+	0x20, 0xc4, 0xfa, 0x1e, 0x98, 0xe1, 0x95, 0x2b, 0xab, 0xe4, 0xfa, 0xc4, 0x27, 0xb7, 0xb4, 0xca,
+	0x8d, 0x40, 0x24, 0x8c, 0x89, 0x96, 0x82, 0xc3, 0x42, 0x97, 0x38, 0x9d, 0xae, 0x38, 0xd8, 0x4a,
+	0xd9, 0x96, 0x42, 0x11, 0xf3, 0x96, 0x82, 0xd1, 0xb7, 0x39, 0xc9, 0xd2, 0xc6, 0x0d, 0x2c, 0xec,
+	0x26, 0xda, 0xa5, 0x7c, 0xda, 0xa8, 0x52, 0x88, 0x34, 0x4e, 0x0a, 0xdd, 0x5c, 0x58, 0xfe, 0x58,
+	0x00, 0xaf, 0xdc, 0x90, 0x60, 0x48, 0xde, 0x3d, 0xb2, 0xba, 0xd8, 0xef, 0x10, 0x84, 0x59, 0x94,
+	0x08, 0x3b, 0x41, 0xf4, 0x57, 0x41, 0xa9, 0x8b, 0xa3, 0xae, 0x0c, 0xd3, 0xc2, 0x20, 0x36, 0x34,
+	0xd9, 0x47, 0xe2, 0xa8, 0x0b, 0x11, 0x17, 0xea, 0xd7, 0xc1, 0x0c, 0x1b, 0x1c, 0xca, 0x20, 0x6d,
+	0xa4, 0xcb, 0xe6, 0x6c, 0xf8, 0xe7, 0xdf, 0x5e, 0xae, 0xcb, 0x3b, 0x9c, 0x04, 0x85, 0x5d, 0x1a,
+	0x3a, 0x7e, 0x07, 0x09, 0x35, 0xde, 0x1e, 0xf4, 0xdb, 0x9e, 0x43, 0xcd, 0xb6, 0x1b, 0x58, 0x07,
+	0x8d, 0x62, 0x7e, 0x3d, 0xaa, 0x94, 0xb5, 0x07, 0x9c, 0x6c, 0x31, 0x2a, 0xb7, 0x9e, 0x1f, 0x4d,
+	0x83, 0x95, 0xb1, 0xeb, 0x61, 0x07, 0x9f, 0xfe, 0x69, 0x01, 0xd4, 0x89, 0x64, 0x9a, 0x0c, 0xf6,
+	0x4d, 0xda, 0xef, 0xb9, 0x24, 0x6a, 0x14, 0xd6, 0x8b, 0xcf, 0xc3, 0x78, 0xd5, 0xd8, 0x1e, 0xd3,
+	0x6a, 0x7d, 0x4d, 0x5e, 0x68, 0x5e, 0x4e, 0x5a, 0x91, 0x51, 0xc3, 0xec, 0x66, 0xa3, 0x8f, 0x68,
+	0x46, 0x48, 0x27, 0x23, 0xbc, 0xb3, 0x06, 0x31, 0x17, 0x88, 0xdf, 0x15, 0xc0, 0xd2, 0x88, 0xe3,
+	0x53, 0x67, 0xfd, 0x01, 0xa8, 0x65, 0x96, 0x23, 0xe7, 0x74, 0x6b, 0xe2, 0xec, 0xaf, 0x8f, 0x89,
+	0x0d, 0x44, 0x55, 0x75, 0xf9, 0xb9, 0x89, 0xff, 0x69, 0x1a, 0xd4, 0x6f, 0xf3, 0xfb, 0x88, 0x63,
+	0xa9, 0x0b, 0xf8, 0x9f, 0x9c, 0x3b, 0xcb, 0x5c, 0x9e, 0x94, 0xd9, 0x3a, 0x56, 0x32, 0x57, 0x95,
+	0x42, 0xa4, 0x71, 0x52, 0x56, 0xf1, 0xb7, 0x00, 0x10, 0x52, 0xea, 0x78, 0x84, 0xd7, 0xb0, 0xb6,
+	0xb5, 0x3a, 0x72, 0xa3, 0xd9, 0x4b, 0x5e, 0x4e, 0x5a, 0x17, 0x64, 0xbe, 0x2d, 0xa9, 0x96, 0x99,
+	0x2e, 0xfc, 0x98, 0x5d, 0x5a, 0x2a, 0x9c, 0xc1, 0x86, 0xe7, 0x22, 0xfa, 0xeb, 0x69, 0x50, 0xbe,
+	0x45, 0x88, 0x4d, 0x42, 0xfd, 0x2e, 0xa8, 0xf0, 0xab, 0x24, 0xa6, 0x41, 0x28, 0xe3, 0xf8, 0x66,
+	0x0a, 0xa3, 0x43, 0xd1, 0xc9, 0xb9, 0x96, 0xaa, 0xeb, 0xdb, 0x60, 0x36, 0xe9, 0x06, 0x44, 0x84,
+	0xff, 0x3f, 0x3d, 0x7f, 0xa4, 0xe0, 0x64, 0x3b, 0x89, 0xaa, 0xfe, 0x1e, 0xdb, 0xad, 0x9e, 0x13,
+	0x1e, 0x67, 0x23, 0x08, 0xd3, 0xe3, 0x26, 0x23, 0xce, 0x1c, 0x37, 0x42, 0x22, 0xa3, 0xf9, 0x16,
+	0x28, 0xcb, 0xeb, 0x59, 0x89, 0x5f, 0xcf, 0x5e, 0x1e, 0xc4, 0xc6, 0x79, 0x25, 0x3f, 0x32, 0xad,
+	0xb0, 0x1c, 0x9a, 0x0b, 0xd4, 0xa7, 0xb3, 0x40, 0xdb, 0x4b, 0x2f, 0x42, 0xa7, 0xce, 0xb8, 0xdc,
+	0x33, 0xd4, 0xf4, 0xa9, 0x9f, 0xa1, 0xce, 0x92, 0x3d, 0x23, 0x69, 0x5e, 0x7a, 0x81, 0x69, 0xfe,
+	0x10, 0x94, 0x3d, 0x62, 0x3b, 0xd8, 0x97, 0x7d, 0xd8, 0x37, 0x26, 0xf6, 0x22, 0x1b, 0x20, 0x61,
+	0x85, 0xf5, 0x25, 0xfc, 0x8f, 0xfe, 0x3d, 0xa0, 0x47, 0x14, 0xfb, 0xb6, 0xb8, 0x3e, 0x27, 0xcd,
+	0x89, 0x78, 0xe3, 0xb9, 0x37, 0xb1, 0x13, 0xd9, 0x52, 0x8c, 0x5a, 0x84, 0x68, 0x29, 0x61, 0xa6,
+	0x0d, 0xca, 0xc1, 0xf0, 0x5e, 0x1f, 0xf5, 0x42, 0x82, 0xed, 0xc6, 0xec, 0xd9, 0x22, 0x98, 0x31,
+	0x36, 0x7c, 0x05, 0xd8, 0xe5, 0x24, 0x7f, 0x12, 0xc1, 0x21, 0x75, 0x2c, 0xa7, 0x87, 0xa9, 0xe3,
+	0x77, 0xcc, 0x5e, 0x70, 0x48, 0x42, 0xde, 0x21, 0x16, 0x33, 0x4f, 0x22, 0xa3, 0x83, 0xd8, 0x93,
+	0x88, 0xca, 0xdd, 0x61, 0x4c, 0x36, 0xfb, 0xa8, 0xdf, 0xeb, 0x05, 0x21, 0x35, 0xf9, 0x3b, 0x45,
+	0xa3, 0x72, 0xb6, 0xd9, 0x67, 0x8c, 0x41, 0x54, 0x95, 0x34, 0x62, 0x64, 0x92, 0xe1, 0xa1, 0x69,
+	0x05, 0x7d, 0x9f, 0x36, 0xc0, 0xb8, 0x0c, 0x97, 0x42, 0x99, 0xe1, 0xe1, 0x4d, 0x46, 0xb0, 0x0c,
+	0x3f, 0x74, 0x7c, 0x7f, 0xa8, 0xa9, 0xe5, 0x33, 0x5c, 0x95, 0x42, 0xa4, 0x09, 0x92, 0xeb, 0xe6,
+	0x8a, 0xf3, 0x57, 0x45, 0x50, 0x57, 0xcf, 0x83, 0xf7, 0x09, 0xc5, 0x36, 0xa6, 0xf8, 0xd4, 0x55,
+	0x7a, 0x0f, 0xe8, 0x2e, 0x8e, 0xa8, 0xd9, 0xef, 0xd9, 0x38, 0x6d, 0xbc, 0x44, 0xb1, 0x2a, 0xfd,
+	0xe8, 0xe8, 0x18, 0x88, 0x16, 0x19, 0xf3, 0x03, 0xce, 0x93, 0xd5, 0xe7, 0x80, 0x45, 0x75, 0x20,
+	0x47, 0xf0, 0xe2, 0x73, 0x11, 0xfc, 0x55, 0x89, 0xe0, 0xe7, 0x47, 0x5d, 0xa5, 0x38, 0x3e, 0x9f,
+	0x3a, 0x63, 0x9a, 0xf9, 0xd8, 0x97, 0x4e, 0x1d, 0x7b, 0x02, 0x34, 0x9e, 0x3f, 0xf2, 0xe9, 0x69,
+	0xe6, 0x6c, 0x8f, 0xce, 0x8a, 0x29, 0x88, 0x00, 0xa7, 0xf8, 0xf3, 0x53, 0xbe, 0xef, 0x28, 0x02,
+	0xed, 0x36, 0x76, 0x29, 0x11, 0xcf, 0x5f, 0x93, 0x60, 0x68, 0x17, 0xbb, 0x34, 0xbb, 0x2d, 0xca,
+	0x2a, 0x15, 0x21, 0x44, 0x80, 0x51, 0x27, 0xe1, 0x60, 0xf1, 0x05, 0xe2, 0xe0, 0x0f, 0x0b, 0xe0,
+	0x5c, 0x48, 0x3e, 0x22, 0x16, 0x25, 0xb6, 0x39, 0x0e, 0x7e, 0x1f, 0x4c, 0xec, 0xf6, 0x42, 0x02,
+	0x1e, 0xe3, 0xac, 0x42, 0x54, 0x4f, 0x04, 0x99, 0x5e, 0xe8, 0x26, 0x58, 0x08, 0x49, 0x44, 0xd8,
+	0x1b, 0xde, 0xa3, 0x3e, 0x89, 0x28, 0x11, 0x0f, 0x4e, 0x73, 0xad, 0xd5, 0x41, 0x6c, 0x9c, 0x4b,
+	0x2c, 0x66, 0x06, 0x40, 0x34, 0xcf, 0x39, 0x28, 0x61, 0xe4, 0x36, 0xee, 0x0f, 0xd3, 0xa0, 0xfe,
+	0x61, 0x72, 0xb8, 0xef, 0xa4, 0x4f, 0xb0, 0x5f, 0x6a, 0xcf, 0x70, 0x09, 0x94, 0xe5, 0xa3, 0xb8,
+	0xd8, 0x60, 0xe5, 0x6a, 0x9c, 0x3c, 0x6c, 0xcb, 0x01, 0x2c, 0x71, 0x58, 0x2e, 0xdb, 0xf2, 0x50,
+	0x5c, 0xcc, 0xb6, 0xc3, 0x36, 0x14, 0x6d, 0xaf, 0xad, 0xaf, 0x83, 0xe2, 0x61, 0xe0, 0xcb, 0xb2,
+	0x98, 0x1f, 0xc4, 0x06, 0x90, 0xf6, 0x18, 0xea, 0x33, 0x11, 0x73, 0xea, 0x39, 0x51, 0x24, 0x63,
+	0x94, 0x71, 0x2a, 0xf8, 0xec, 0x38, 0xe2, 0x7f, 0xf4, 0x2d, 0x50, 0xc1, 0xed, 0x88, 0x62, 0xc7,
+	0x27, 0x76, 0xa3, 0x9c, 0xbf, 0x66, 0x0e, 0x45, 0x10, 0xa5, 0xc3, 0x72, 0x61, 0xfc, 0x65, 0x09,
+	0x80, 0x07, 0xfc, 0xe6, 0x70, 0x17, 0x3b, 0xee, 0x97, 0x1a, 0xbc, 0xab, 0x40, 0xe3, 0x6f, 0xd5,
+	0x27, 0x95, 0x88, 0x22, 0x84, 0x08, 0x30, 0x4a, 0x96, 0xc8, 0x77, 0x01, 0x7f, 0xd0, 0x26, 0xb6,
+	0xd9, 0xf7, 0xa9, 0xe3, 0x9e, 0x02, 0xa8, 0x0c, 0x09, 0x54, 0xcb, 0xa9, 0xe5, 0x44, 0x5b, 0x80,
+	0x94, 0x26, 0x58, 0x1f, 0x30, 0x0e, 0x03, 0x79, 0x16, 0x3f, 0x81, 0x41, 0x24, 0x1c, 0xbd, 0x8e,
+	0xaa, 0x52, 0x88, 0x34, 0x46, 0xde, 0x14, 0x94, 0xde, 0x03, 0x0b, 0xe2, 0xe3, 0x06, 0x6f, 0x92,
+	0x78, 0x25, 0x09, 0xa0, 0xba, 0x3d, 0x71, 0x25, 0x9d, 0x53, 0x82, 0x9a, 0x9a, 0x63, 0x0f, 0xb5,
+	0x8c, 0xc3, 0x2e, 0x80, 0xbc, 0x76, 0x92, 0x4f, 0x4a, 0xc4, 0x36, 0x69, 0x70, 0x40, 0xfc, 0xe8,
+	0xbf, 0xf8, 0xa4, 0x74, 0xc7, 0xa7, 0xb9, 0x4f, 0x4a, 0x43, 0x6b, 0xc9, 0x27, 0x25, 0x62, 0xef,
+	0x71, 0x3a, 0x97, 0x1f, 0xbf, 0x28, 0x81, 0x79, 0x24, 0x1a, 0x03, 0xab, 0x4b, 0xec, 0xbe, 0x4b,
+	0xf4, 0x0b, 0x60, 0xda, 0x49, 0x3e, 0x5e, 0xd6, 0x06, 0xb1, 0x51, 0x11, 0x66, 0xd9, 0xfb, 0xdb,
+	0xb4, 0x63, 0xeb, 0x37, 0x40, 0x79, 0xbf, 0xef, 0xdb, 0xc3, 0x8b, 0xe1, 0xa5, 0x34, 0x7d, 0x05,
+	0xff, 0xe4, 0xe4, 0x91, 0x8a, 0xfc, 0x7e, 0x4d, 0x71, 0x48, 0x4f, 0xec, 0x33, 0x55, 0x29, 0xbb,
+	0x5f, 0x33, 0x52, 0x26, 0xcf, 0xdb, 0x00, 0x10, 0xdf, 0xce, 0xbe, 0x34, 0x28, 0xaf, 0x77, 0xa9,
+	0x0c, 0xa2, 0x0a, 0xf1, 0x6d, 0xa9, 0xf5, 0xb3, 0x02, 0x58, 0xc2, 0x1e, 0xdb, 0x63, 0xfe, 0xd5,
+	0x4a, 0x76, 0xc6, 0xe2, 0x5b, 0xe1, 0x4a, 0x53, 0x4e, 0x96, 0xbd, 0xbb, 0x0d, 0xef, 0xd7, 0x37,
+	0x03, 0xc7, 0x6f, 0xdd, 0x97, 0x79, 0x27, 0xbf, 0x55, 0x8d, 0x58, 0x60, 0xf7, 0xe9, 0x8d, 0x53,
+	0xec, 0x0f, 0x33, 0x16, 0xa1, 0x05, 0xa1, 0xbf, 0x43, 0x42, 0xd9, 0x6f, 0xff, 0xb4, 0xc0, 0x70,
+	0xd3, 0xc3, 0x8e, 0xcf, 0x9a, 0x2b, 0x16, 0x1c, 0xb6, 0xf9, 0xcf, 0x99, 0xd3, 0x5d, 0x39, 0xa7,
+	0x21, 0xac, 0x66, 0xf4, 0x27, 0x9b, 0xd1, 0xfc, 0x50, 0xfb, 0x16, 0x53, 0xce, 0xe6, 0x46, 0x0b,
+	0x7d, 0xf6, 0x74, 0xad, 0xf0, 0xf9, 0xd3, 0xb5, 0xc2, 0xdf, 0x9e, 0xae, 0x15, 0x3e, 0x7e, 0xb6,
+	0x36, 0xf5, 0xf9, 0xb3, 0xb5, 0xa9, 0xbf, 0x3c, 0x5b, 0x9b, 0xfa, 0xf6, 0x57, 0x15, 0x0f, 0x8e,
+	0x6f, 0xf5, 0xdb, 0xfd, 0xe8, 0xb2, 0x4f, 0xe8, 0x61, 0x10, 0x1e, 0x6c, 0xee, 0x63, 0x7f, 0xbf,
+	0x1f, 0x1e, 0x73, 0x5f, 0x8f, 0xb7, 0x36, 0x8f, 0x92, 0x4f, 0xfd, 0xdc, 0x6f, 0xbb, 0xcc, 0xab,
+	0xfb, 0xad, 0x7f, 0x0f, 0x00, 0x41, 0x5c, 0x44, 0xd5, 0x0d, 0x20, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -372,14 +1081,40 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.HistoryRetention != that1.HistoryRetention {
+		return false
+	}
+	if this.MaxStaleness != that1.MaxStaleness {
+		return false
+	}
+	if this.PerformanceWindows != that1.PerformanceWindows {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if len(this.RewardDenoms) != len(that1.RewardDenoms) {
+		return false
+	}
+	for i := range this.RewardDenoms {
+		if this.RewardDenoms[i] != that1.RewardDenoms[i] {
+			return false
+		}
+	}
+	if !this.FeeShare.Equal(that1.FeeShare) {
+		return false
+	}
+	if this.TallyResultRetention != that1.TallyResultRetention {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -394,6 +1129,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TallyResultRetention != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TallyResultRetention))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.FeeShare.Size()
+		i -= size
+		if _, err := m.FeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.RewardDenoms) > 0 {
+		for iNdEx := len(m.RewardDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardDenoms[iNdEx])
+			copy(dAtA[i:], m.RewardDenoms[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.RewardDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if m.PerformanceWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PerformanceWindows))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxStaleness != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxStaleness))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HistoryRetention != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -486,6 +1268,73 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RemotePriceSource != nil {
+		{
+			size, err := m.RemotePriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.AggregationStrategy) > 0 {
+		i -= len(m.AggregationStrategy)
+		copy(dAtA[i:], m.AggregationStrategy)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AggregationStrategy)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxDeviation != nil {
+		{
+			size := m.MaxDeviation.Size()
+			i -= size
+			if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Exponent != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.Exponent))
 		i--
@@ -508,6 +1357,165 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RemotePriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemotePriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemotePriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueryTtl != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.QueryTtl))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.QuoteSymbolDenom) > 0 {
+		i -= len(m.QuoteSymbolDenom)
+		copy(dAtA[i:], m.QuoteSymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteSymbolDenom)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxDeviation != nil {
+		{
+			size := m.MaxDeviation.Size()
+			i -= size
+			if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxAge != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.QueryPeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.QueryPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.QuoteExponent != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.QuoteExponent))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BaseExponent != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BaseExponent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemotePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemotePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemotePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LocalHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LocalHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemoteHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RemoteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -634,163 +1642,3534 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HistoricExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *HistoricExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.AcceptList) > 0 {
-		for _, e := range m.AcceptList {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Feeder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Feeder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Feeder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.SymbolDenom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Exponent != 0 {
-		n += 1 + sovOracle(uint64(m.Exponent))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.WinnerCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WinnerCount))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.VoterCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VoterCount))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.SubmitBlock != 0 {
-		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	{
+		size := m.SupportRatio.Size()
+		i -= size
+		if _, err := m.SupportRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x4a
+	if m.ParticipatingPower != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ParticipatingPower))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.RewardSpread.Size()
+		i -= size
+		if _, err := m.RewardSpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.StandardDeviation.Size()
+		i -= size
+		if _, err := m.StandardDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Median.Size()
+		i -= size
+		if _, err := m.Median.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExchangeRateMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.PowerShare.Size()
+		i -= size
+		if _, err := m.PowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.VoterCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VoterCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HaltedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HaltedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HaltedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.ResetRequested {
+		i--
+		if m.ResetRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	{
+		size := m.RejectedExchangeRate.Size()
+		i -= size
+		if _, err := m.RejectedExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.HaltHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Abstained != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Abstained))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Missed != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Won != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Won))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Voted != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Voted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleJail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleJail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleJail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashedTokens.Size()
+		i -= size
+		if _, err := m.SlashedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MissCounter != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.JailHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.JailHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingFunds) > 0 {
+		for iNdEx := len(m.RemainingFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for iNdEx := len(m.AmountPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if len(m.AcceptList) > 0 {
+		for _, e := range m.AcceptList {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.HistoryRetention != 0 {
+		n += 1 + sovOracle(uint64(m.HistoryRetention))
+	}
+	if m.MaxStaleness != 0 {
+		n += 1 + sovOracle(uint64(m.MaxStaleness))
+	}
+	if m.PerformanceWindows != 0 {
+		n += 1 + sovOracle(uint64(m.PerformanceWindows))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.RewardDenoms) > 0 {
+		for _, s := range m.RewardDenoms {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.FeeShare.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.TallyResultRetention != 0 {
+		n += 1 + sovOracle(uint64(m.TallyResultRetention))
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovOracle(uint64(m.Exponent))
+	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	if m.MaxDeviation != nil {
+		l = m.MaxDeviation.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.AggregationStrategy)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RemotePriceSource != nil {
+		l = m.RemotePriceSource.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *RemotePriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.BaseExponent != 0 {
+		n += 1 + sovOracle(uint64(m.BaseExponent))
+	}
+	if m.QuoteExponent != 0 {
+		n += 1 + sovOracle(uint64(m.QuoteExponent))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.QueryPeriod != 0 {
+		n += 1 + sovOracle(uint64(m.QueryPeriod))
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovOracle(uint64(m.MaxAge))
+	}
+	if m.MaxDeviation != nil {
+		l = m.MaxDeviation.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.QuoteSymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.QueryTtl != 0 {
+		n += 1 + sovOracle(uint64(m.QueryTtl))
+	}
+	return n
+}
+
+func (m *RemotePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RemoteHeight != 0 {
+		n += 1 + sovOracle(uint64(m.RemoteHeight))
+	}
+	if m.LocalHeight != 0 {
+		n += 1 + sovOracle(uint64(m.LocalHeight))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *HistoricExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovOracle(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *Feeder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovOracle(uint64(m.ExpiryHeight))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovOracle(uint64(m.BlockHeight))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Median.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.StandardDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardSpread.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ParticipatingPower != 0 {
+		n += 1 + sovOracle(uint64(m.ParticipatingPower))
+	}
+	l = m.SupportRatio.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.VoterCount != 0 {
+		n += 1 + sovOracle(uint64(m.VoterCount))
+	}
+	if m.WinnerCount != 0 {
+		n += 1 + sovOracle(uint64(m.WinnerCount))
+	}
+	return n
+}
+
+func (m *ExchangeRateMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovOracle(uint64(m.LastUpdateHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.VoterCount != 0 {
+		n += 1 + sovOracle(uint64(m.VoterCount))
+	}
+	l = m.PowerShare.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *HaltedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.HaltHeight != 0 {
+		n += 1 + sovOracle(uint64(m.HaltHeight))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RejectedExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ResetRequested {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovOracle(uint64(m.Window))
+	}
+	if m.Voted != 0 {
+		n += 1 + sovOracle(uint64(m.Voted))
+	}
+	if m.Won != 0 {
+		n += 1 + sovOracle(uint64(m.Won))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovOracle(uint64(m.Missed))
+	}
+	if m.Abstained != 0 {
+		n += 1 + sovOracle(uint64(m.Abstained))
+	}
+	return n
+}
+
+func (m *OracleJail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.JailHeight != 0 {
+		n += 1 + sovOracle(uint64(m.JailHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MissCounter != 0 {
+		n += 1 + sovOracle(uint64(m.MissCounter))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.SlashedTokens.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *RewardSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOracle(uint64(m.Id))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovOracle(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovOracle(uint64(m.EndHeight))
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for _, e := range m.AmountPerPeriod {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.RemainingFunds) > 0 {
+		for _, e := range m.RemainingFunds {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptList = append(m.AcceptList, Denom{})
+			if err := m.AcceptList[len(m.AcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			m.MaxStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStaleness |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindows", wireType)
+			}
+			m.PerformanceWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenoms = append(m.RewardDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResultRetention", wireType)
+			}
+			m.TallyResultRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyResultRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxDeviation = &v
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregationStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemotePriceSource == nil {
+				m.RemotePriceSource = &RemotePriceSource{}
+			}
+			if err := m.RemotePriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemotePriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemotePriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemotePriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseExponent", wireType)
+			}
+			m.BaseExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteExponent", wireType)
+			}
+			m.QuoteExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryPeriod", wireType)
+			}
+			m.QueryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxDeviation = &v
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteSymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteSymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryTtl", wireType)
+			}
+			m.QueryTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryTtl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemotePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemotePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemotePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteHeight", wireType)
+			}
+			m.RemoteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalHeight", wireType)
+			}
+			m.LocalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Feeder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Feeder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Feeder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StandardDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatingPower", wireType)
+			}
+			m.ParticipatingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipatingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupportRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterCount", wireType)
+			}
+			m.VoterCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoterCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerCount", wireType)
+			}
+			m.WinnerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinnerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterCount", wireType)
 			}
-			m.VotePeriod = 0
+			m.VoterCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -800,14 +5179,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				m.VoterCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -835,13 +5214,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HaltedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HaltedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HaltedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -869,15 +5298,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			m.HaltHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -887,16 +5314,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				m.HaltHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -906,29 +5333,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptList = append(m.AcceptList, Denom{})
-			if err := m.AcceptList[len(m.AcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -956,15 +5383,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RejectedExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequested", wireType)
 			}
-			m.SlashWindow = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -974,14 +5401,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			m.ResetRequested = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1009,10 +5487,103 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			m.Voted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Won", wireType)
+			}
+			m.Won = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Won |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstained", wireType)
+			}
+			m.Abstained = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstained |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1034,7 +5605,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *OracleJail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,15 +5628,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleJail: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleJail: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1093,13 +5664,32 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailHeight", wireType)
+			}
+			m.JailHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1109,29 +5699,30 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
 			}
-			m.Exponent = 0
+			m.MissCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1141,64 +5732,14 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				m.MissCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1226,11 +5767,13 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1258,27 +5801,10 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
-			}
-			m.SubmitBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.SlashedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1300,7 +5826,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *RewardSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1323,17 +5849,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1343,29 +5869,14 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1393,63 +5904,51 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1459,29 +5958,31 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.AmountPerPeriod = append(m.AmountPerPeriod, types.Coin{})
+			if err := m.AmountPerPeriod[len(m.AmountPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingFunds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1491,23 +5992,23 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemainingFunds = append(m.RemainingFunds, types.Coin{})
+			if err := m.RemainingFunds[len(m.RemainingFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
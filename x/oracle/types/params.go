@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -19,13 +20,25 @@ var (
 	KeySlashFraction            = []byte("SlashFraction")
 	KeySlashWindow              = []byte("SlashWindow")
 	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyHistoryRetention         = []byte("HistoryRetention")
+	KeyMaxStaleness             = []byte("MaxStaleness")
+	KeyPerformanceWindows       = []byte("PerformanceWindows")
+	KeyJailDuration             = []byte("JailDuration")
+	KeyRewardDenoms             = []byte("RewardDenoms")
+	KeyFeeShare                 = []byte("FeeShare")
+	KeyTallyResultRetention     = []byte("TallyResultRetention")
 )
 
 // Default parameter values
 const (
-	DefaultVotePeriod               = BlocksPerMinute * 1 // 1 minute
-	DefaultSlashWindow              = BlocksPerWeek       // window for a week
-	DefaultRewardDistributionWindow = BlocksPerYear       // window for a year
+	DefaultVotePeriod               = BlocksPerMinute * 1              // 1 minute
+	DefaultSlashWindow              = BlocksPerWeek                    // window for a week
+	DefaultRewardDistributionWindow = BlocksPerYear                    // window for a year
+	DefaultHistoryRetention         = BlocksPerWeek                    // keep a week of rates
+	DefaultMaxStaleness             = BlocksPerHour                    // rates expire after an hour
+	DefaultPerformanceWindows       = uint64(4)                        // keep four slash windows of statistics
+	DefaultJailDuration             = 10 * time.Minute                 // same as the default downtime jail duration
+	DefaultTallyResultRetention     = BlocksPerDay / DefaultVotePeriod // keep a day of tally statistics
 
 	// maximum number of decimals allowed for VoteThreshold
 	MaxVoteThresholdPrecision  = 2
@@ -45,6 +58,8 @@ var (
 	}
 	DefaultSlashFraction     = sdk.NewDec(0)            // 0%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultRewardDenoms      = []string{PersistenceDenom}
+	DefaultFeeShare          = sdk.ZeroDec() // 0%
 
 	oneDec           = sdk.OneDec()
 	minVoteThreshold = sdk.NewDecWithPrec(33, 2) // 0.33
@@ -63,6 +78,13 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		HistoryRetention:         DefaultHistoryRetention,
+		MaxStaleness:             DefaultMaxStaleness,
+		PerformanceWindows:       DefaultPerformanceWindows,
+		JailDuration:             DefaultJailDuration,
+		RewardDenoms:             DefaultRewardDenoms,
+		FeeShare:                 DefaultFeeShare,
+		TallyResultRetention:     DefaultTallyResultRetention,
 	}
 }
 
@@ -115,6 +137,41 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 			&p.MinValidPerWindow,
 			validateMinValidPerWindow,
 		),
+		paramstypes.NewParamSetPair(
+			KeyHistoryRetention,
+			&p.HistoryRetention,
+			validateHistoryRetention,
+		),
+		paramstypes.NewParamSetPair(
+			KeyMaxStaleness,
+			&p.MaxStaleness,
+			validateMaxStaleness,
+		),
+		paramstypes.NewParamSetPair(
+			KeyPerformanceWindows,
+			&p.PerformanceWindows,
+			validatePerformanceWindows,
+		),
+		paramstypes.NewParamSetPair(
+			KeyJailDuration,
+			&p.JailDuration,
+			validateJailDuration,
+		),
+		paramstypes.NewParamSetPair(
+			KeyRewardDenoms,
+			&p.RewardDenoms,
+			validateRewardDenoms,
+		),
+		paramstypes.NewParamSetPair(
+			KeyFeeShare,
+			&p.FeeShare,
+			validateFeeShare,
+		),
+		paramstypes.NewParamSetPair(
+			KeyTallyResultRetention,
+			&p.TallyResultRetention,
+			validateTallyResultRetention,
+		),
 	}
 }
 
@@ -154,19 +211,89 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.HistoryRetention != 0 && p.HistoryRetention < p.VotePeriod {
+		return fmt.Errorf("oracle parameter HistoryRetention must be zero or greater than or equal with VotePeriod")
+	}
+
+	if p.MaxStaleness != 0 && p.MaxStaleness < p.VotePeriod {
+		return fmt.Errorf("oracle parameter MaxStaleness must be zero or greater than or equal with VotePeriod")
+	}
+
+	if p.JailDuration < 0 {
+		return fmt.Errorf("oracle parameter JailDuration must not be negative: %s", p.JailDuration)
+	}
+
+	if p.FeeShare.GT(sdk.OneDec()) || p.FeeShare.IsNegative() {
+		return fmt.Errorf("oracle parameter FeeShare must be between [0, 1]")
+	}
+
+	if err := validateRewardDenoms(p.RewardDenoms); err != nil {
+		return err
+	}
+
 	for _, denom := range p.AcceptList {
-		if len(denom.BaseDenom) == 0 {
-			return fmt.Errorf("oracle parameter AcceptList Denom must have BaseDenom")
+		if err := denom.Validate(); err != nil {
+			return err
 		}
+	}
 
-		if len(denom.SymbolDenom) == 0 {
-			return fmt.Errorf("oracle parameter AcceptList Denom must have SymbolDenom")
-		}
+	return p.AcceptList.ValidateQuoteDenoms()
+}
+
+// DenomVoteThreshold returns the vote threshold of a denom of the accept list,
+// falling back to the global VoteThreshold when it has no override.
+func (p Params) DenomVoteThreshold(symbolDenom string) sdk.Dec {
+	if denom, found := p.AcceptList.Find(symbolDenom); found && denom.VoteThreshold != nil {
+		return *denom.VoteThreshold
+	}
+
+	return p.VoteThreshold
+}
+
+// DenomRewardBand returns the reward band of a denom of the accept list,
+// falling back to the global RewardBand when it has no override.
+func (p Params) DenomRewardBand(symbolDenom string) sdk.Dec {
+	if denom, found := p.AcceptList.Find(symbolDenom); found && denom.RewardBand != nil {
+		return *denom.RewardBand
+	}
+
+	return p.RewardBand
+}
+
+// DenomMaxDeviation returns the maximum relative change per vote period of the
+// exchange rate of a denom of the accept list, nil if its circuit breaker is
+// disabled.
+func (p Params) DenomMaxDeviation(symbolDenom string) *sdk.Dec {
+	if denom, found := p.AcceptList.Find(symbolDenom); found {
+		return denom.MaxDeviation
 	}
 
 	return nil
 }
 
+// DenomAggregationStrategy returns the strategy aggregating the ballot of a
+// denom of the accept list, falling back to the weighted median when it has
+// none or it is unknown.
+func (p Params) DenomAggregationStrategy(symbolDenom string) AggregationStrategy {
+	if denom, found := p.AcceptList.Find(symbolDenom); found {
+		if strategy, err := GetAggregationStrategy(denom.AggregationStrategy); err == nil {
+			return strategy
+		}
+	}
+
+	return WeightedMedianStrategy{}
+}
+
+// DenomMinVoters returns the minimum number of voters required to tally the
+// ballot of a denom of the accept list, zero if there is none.
+func (p Params) DenomMinVoters(symbolDenom string) uint64 {
+	if denom, found := p.AcceptList.Find(symbolDenom); found {
+		return denom.MinVoters
+	}
+
+	return 0
+}
+
 func validateVotePeriod(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -241,16 +368,12 @@ func validateAcceptList(i interface{}) error {
 	}
 
 	for _, d := range v {
-		if len(d.BaseDenom) == 0 {
-			return fmt.Errorf("oracle parameter AcceptList Denom must have BaseDenom")
-		}
-
-		if len(d.SymbolDenom) == 0 {
-			return fmt.Errorf("oracle parameter AcceptList Denom must have SymbolDenom")
+		if err := d.Validate(); err != nil {
+			return err
 		}
 	}
 
-	return nil
+	return v.ValidateQuoteDenoms()
 }
 
 func validateSlashFraction(i interface{}) error {
@@ -299,3 +422,87 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateHistoryRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxStaleness(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePerformanceWindows(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("jail duration must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateRewardDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid reward denom: %w", err)
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate reward denom: %s", denom)
+		}
+
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("fee share must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee share is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateTallyResultRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -95,12 +96,29 @@ func TestValidateAcceptList(t *testing.T) {
 	})
 	require.ErrorContains(t, err, "oracle parameter AcceptList Denom must have SymbolDenom")
 
+	err = validateAcceptList(DenomList{
+		{BaseDenom: DenomPersistence.BaseDenom, SymbolDenom: DenomPersistence.SymbolDenom, QuoteDenom: AtomSymbol},
+		{BaseDenom: DenomAtom.BaseDenom, SymbolDenom: DenomAtom.SymbolDenom, QuoteDenom: PersistenceSymbol},
+	})
+	require.ErrorContains(t, err, "has cyclic QuoteDenom")
+
 	err = validateAcceptList(DenomList{
 		{BaseDenom: DenomPersistence.BaseDenom, SymbolDenom: DenomPersistence.SymbolDenom},
 	})
 	require.Nil(t, err)
 }
 
+func TestValidateJailDuration(t *testing.T) {
+	err := validateJailDuration("invalidDuration")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateJailDuration(-time.Minute)
+	require.ErrorContains(t, err, "jail duration must not be negative: -1m0s")
+
+	err = validateJailDuration(time.Duration(0))
+	require.Nil(t, err)
+}
+
 func TestValidateSlashFraction(t *testing.T) {
 	err := validateSlashFraction("invalidSdkType")
 	require.ErrorContains(t, err, "invalid parameter type: string")
@@ -115,6 +133,37 @@ func TestValidateSlashFraction(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestValidateRewardDenoms(t *testing.T) {
+	err := validateRewardDenoms("invalidSlice")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateRewardDenoms([]string{"1nvalid"})
+	require.ErrorContains(t, err, "invalid reward denom")
+
+	err = validateRewardDenoms([]string{PersistenceDenom, PersistenceDenom})
+	require.ErrorContains(t, err, "duplicate reward denom: uxprt")
+
+	err = validateRewardDenoms([]string{PersistenceDenom, AtomDenom})
+	require.Nil(t, err)
+
+	err = validateRewardDenoms([]string{})
+	require.Nil(t, err)
+}
+
+func TestValidateFeeShare(t *testing.T) {
+	err := validateFeeShare("invalidSdkType")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateFeeShare(sdk.MustNewDecFromStr("-0.1"))
+	require.ErrorContains(t, err, "fee share must be positive: -0.100000000000000000")
+
+	err = validateFeeShare(sdk.MustNewDecFromStr("1.1"))
+	require.ErrorContains(t, err, "fee share is too large: 1.100000000000000000")
+
+	err = validateFeeShare(sdk.NewDecWithPrec(25, 2))
+	require.Nil(t, err)
+}
+
 func TestParamsEqual(t *testing.T) {
 	p1 := DefaultParams()
 	err := p1.Validate()
@@ -175,7 +224,61 @@ func TestParamsEqual(t *testing.T) {
 	err = p11.Validate()
 	require.Error(t, err)
 
+	// max staleness shorter than the vote period
+	p14 := DefaultParams()
+	p14.MaxStaleness = p14.VotePeriod - 1
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// history retention shorter than the vote period
+	p12 := DefaultParams()
+	p12.HistoryRetention = p12.VotePeriod - 1
+	err = p12.Validate()
+	require.Error(t, err)
+
 	p13 := DefaultParams()
 	require.NotNil(t, p13.ParamSetPairs())
 	require.NotNil(t, p13.String())
 }
+
+func TestParamsDenomOverrides(t *testing.T) {
+	threshold := sdk.NewDecWithPrec(40, 2)
+	rewardBand := sdk.NewDecWithPrec(10, 2)
+
+	params := DefaultParams()
+	params.AcceptList = DenomList{
+		{
+			BaseDenom:           AtomDenom,
+			SymbolDenom:         AtomSymbol,
+			VoteThreshold:       &threshold,
+			RewardBand:          &rewardBand,
+			MinVoters:           3,
+			AggregationStrategy: AggregationTrimmedMean,
+		},
+		{BaseDenom: PersistenceDenom, SymbolDenom: PersistenceSymbol},
+	}
+	require.NoError(t, params.Validate())
+
+	require.Equal(t, threshold, params.DenomVoteThreshold("atom"))
+	require.Equal(t, rewardBand, params.DenomRewardBand(AtomSymbol))
+	require.Equal(t, uint64(3), params.DenomMinVoters(AtomSymbol))
+	require.Equal(t, AggregationTrimmedMean, params.DenomAggregationStrategy(AtomSymbol).Name())
+
+	require.Equal(t, params.VoteThreshold, params.DenomVoteThreshold(PersistenceSymbol))
+	require.Equal(t, params.RewardBand, params.DenomRewardBand(PersistenceSymbol))
+	require.Zero(t, params.DenomMinVoters(PersistenceSymbol))
+	require.Equal(t, AggregationWeightedMedian, params.DenomAggregationStrategy(PersistenceSymbol).Name())
+
+	invalidThreshold := sdk.NewDecWithPrec(20, 2)
+	params.AcceptList[1].VoteThreshold = &invalidThreshold
+	require.ErrorContains(t, params.Validate(), "XPRT has invalid VoteThreshold")
+
+	invalidRewardBand := sdk.NewDec(2)
+	params.AcceptList[1].VoteThreshold = nil
+	params.AcceptList[1].RewardBand = &invalidRewardBand
+	require.ErrorContains(t, params.Validate(), "XPRT has invalid RewardBand")
+
+	params.AcceptList[1].RewardBand = nil
+	params.AcceptList[1].AggregationStrategy = "mean"
+	require.ErrorContains(t, params.Validate(), "XPRT has invalid AggregationStrategy")
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// MissWarningRatio is the share of the misses allowed in a slash window after
+// which a validator is warned that it approaches MinValidPerWindow.
+var MissWarningRatio = sdk.NewDecWithPrec(80, 2)
+
+// NewValidatorPerformance creates a ValidatorPerformance instance
+func NewValidatorPerformance(validator sdk.ValAddress, window uint64) ValidatorPerformance {
+	return ValidatorPerformance{
+		Validator: validator.String(),
+		Window:    window,
+	}
+}
+
+// String implement stringify
+func (p ValidatorPerformance) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// NewValidatorPerformanceSummary sums the statistics of the slash windows of
+// a validator.
+func NewValidatorPerformanceSummary(validator string, windows []ValidatorPerformance) ValidatorPerformanceSummary {
+	summary := ValidatorPerformanceSummary{
+		Validator: validator,
+		Windows:   uint64(len(windows)),
+		Uptime:    sdk.ZeroDec(),
+	}
+
+	for _, w := range windows {
+		summary.Voted += w.Voted
+		summary.Won += w.Won
+		summary.Missed += w.Missed
+		summary.Abstained += w.Abstained
+	}
+
+	if periods := summary.Won + summary.Missed; periods > 0 {
+		summary.Uptime = sdk.NewDecFromInt(sdk.NewIntFromUint64(summary.Won)).QuoInt64(int64(periods))
+	}
+
+	return summary
+}
+
+// MaxMissesPerWindow returns the number of vote periods a validator may miss
+// in a slash window without its valid vote rate falling below the minimum.
+func MaxMissesPerWindow(votePeriodsPerWindow int64, minValidPerWindow sdk.Dec) int64 {
+	return sdk.OneDec().Sub(minValidPerWindow).MulInt64(votePeriodsPerWindow).TruncateInt64()
+}
+
+// ApproachesMinValidPerWindow returns true if the misses of a validator have
+// reached the MissWarningRatio of the misses allowed in the slash window.
+func ApproachesMinValidPerWindow(missCounter uint64, maxMisses int64) bool {
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(missCounter)).GTE(MissWarningRatio.MulInt64(maxMisses))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxMissesPerWindow(t *testing.T) {
+	require.Equal(t, int64(5), MaxMissesPerWindow(10, sdk.NewDecWithPrec(50, 2)))
+	require.Equal(t, int64(9), MaxMissesPerWindow(10, sdk.NewDecWithPrec(5, 2)))
+	require.Equal(t, int64(0), MaxMissesPerWindow(10, sdk.OneDec()))
+}
+
+func TestApproachesMinValidPerWindow(t *testing.T) {
+	require.False(t, ApproachesMinValidPerWindow(3, 5))
+	require.True(t, ApproachesMinValidPerWindow(4, 5))
+	require.True(t, ApproachesMinValidPerWindow(6, 5))
+	require.True(t, ApproachesMinValidPerWindow(1, 0))
+}
+
+func TestNewValidatorPerformanceSummary(t *testing.T) {
+	summary := NewValidatorPerformanceSummary("validator", nil)
+	require.True(t, summary.Uptime.IsZero())
+
+	summary = NewValidatorPerformanceSummary("validator", []ValidatorPerformance{
+		{Window: 1, Voted: 2, Won: 2, Missed: 2, Abstained: 1},
+		{Window: 2, Voted: 4, Won: 4, Missed: 0},
+	})
+	require.Equal(t, uint64(2), summary.Windows)
+	require.Equal(t, uint64(6), summary.Voted)
+	require.Equal(t, uint64(1), summary.Abstained)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), summary.Uptime)
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,23 +113,25 @@ func (m *QueryExchangeRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExchangeRateResponse proto.InternalMessageInfo
 
-// QueryAllExchangeRatesRequest is the request type for the Query/ExchangeRate RPC
-// method.
-type QueryAllExchangeRatesRequest struct {
+// QueryExchangeRateMetadataRequest is the request type for the
+// Query/ExchangeRateMetadata RPC method.
+type QueryExchangeRateMetadataRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryAllExchangeRatesRequest) Reset()         { *m = QueryAllExchangeRatesRequest{} }
-func (m *QueryAllExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllExchangeRatesRequest) ProtoMessage()    {}
-func (*QueryAllExchangeRatesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryExchangeRateMetadataRequest) Reset()         { *m = QueryExchangeRateMetadataRequest{} }
+func (m *QueryExchangeRateMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateMetadataRequest) ProtoMessage()    {}
+func (*QueryExchangeRateMetadataRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{2}
 }
-func (m *QueryAllExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryExchangeRateMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryExchangeRateMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllExchangeRatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryExchangeRateMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -138,38 +141,41 @@ func (m *QueryAllExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllExchangeRatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllExchangeRatesRequest.Merge(m, src)
+func (m *QueryExchangeRateMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateMetadataRequest.Merge(m, src)
 }
-func (m *QueryAllExchangeRatesRequest) XXX_Size() int {
+func (m *QueryExchangeRateMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllExchangeRatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllExchangeRatesRequest.DiscardUnknown(m)
+func (m *QueryExchangeRateMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllExchangeRatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryExchangeRateMetadataRequest proto.InternalMessageInfo
 
-// QueryAllExchangeRatesResponse is response type for the
-// Query/ExchangeRates RPC method.
-type QueryAllExchangeRatesResponse struct {
-	// exchange_rates defines a list of the exchange rate for all whitelisted
-	// denoms.
-	ExchangeRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"exchange_rates"`
+// QueryExchangeRateMetadataResponse is the response type for the
+// Query/ExchangeRateMetadata RPC method.
+type QueryExchangeRateMetadataResponse struct {
+	// exchange_rate defines the last tallied exchange rate of the denom.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// metadata defines the freshness of the exchange rate.
+	Metadata ExchangeRateMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+	// expired is true if the exchange rate is older than the max staleness.
+	Expired bool `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
-func (m *QueryAllExchangeRatesResponse) Reset()         { *m = QueryAllExchangeRatesResponse{} }
-func (m *QueryAllExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllExchangeRatesResponse) ProtoMessage()    {}
-func (*QueryAllExchangeRatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryExchangeRateMetadataResponse) Reset()         { *m = QueryExchangeRateMetadataResponse{} }
+func (m *QueryExchangeRateMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateMetadataResponse) ProtoMessage()    {}
+func (*QueryExchangeRateMetadataResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{3}
 }
-func (m *QueryAllExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryExchangeRateMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryExchangeRateMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllExchangeRatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryExchangeRateMetadataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -179,41 +185,49 @@ func (m *QueryAllExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAllExchangeRatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllExchangeRatesResponse.Merge(m, src)
+func (m *QueryExchangeRateMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateMetadataResponse.Merge(m, src)
 }
-func (m *QueryAllExchangeRatesResponse) XXX_Size() int {
+func (m *QueryExchangeRateMetadataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllExchangeRatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllExchangeRatesResponse.DiscardUnknown(m)
+func (m *QueryExchangeRateMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateMetadataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllExchangeRatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryExchangeRateMetadataResponse proto.InternalMessageInfo
 
-func (m *QueryAllExchangeRatesResponse) GetExchangeRates() github_com_cosmos_cosmos_sdk_types.DecCoins {
+func (m *QueryExchangeRateMetadataResponse) GetMetadata() ExchangeRateMetadata {
 	if m != nil {
-		return m.ExchangeRates
+		return m.Metadata
 	}
-	return nil
+	return ExchangeRateMetadata{}
 }
 
-// QueryActiveExchangeRatesRequest is the request type for the Query/ActiveExchangeRates RPC method.
-type QueryActiveExchangeRatesRequest struct {
+func (m *QueryExchangeRateMetadataResponse) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
 }
 
-func (m *QueryActiveExchangeRatesRequest) Reset()         { *m = QueryActiveExchangeRatesRequest{} }
-func (m *QueryActiveExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryActiveExchangeRatesRequest) ProtoMessage()    {}
-func (*QueryActiveExchangeRatesRequest) Descriptor() ([]byte, []int) {
+// QueryAllExchangeRateMetadataRequest is the request type for the
+// Query/AllExchangeRateMetadata RPC method.
+type QueryAllExchangeRateMetadataRequest struct {
+}
+
+func (m *QueryAllExchangeRateMetadataRequest) Reset()         { *m = QueryAllExchangeRateMetadataRequest{} }
+func (m *QueryAllExchangeRateMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllExchangeRateMetadataRequest) ProtoMessage()    {}
+func (*QueryAllExchangeRateMetadataRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{4}
 }
-func (m *QueryActiveExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllExchangeRateMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryActiveExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllExchangeRateMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryActiveExchangeRatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllExchangeRateMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -223,38 +237,38 @@ func (m *QueryActiveExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryActiveExchangeRatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryActiveExchangeRatesRequest.Merge(m, src)
+func (m *QueryAllExchangeRateMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllExchangeRateMetadataRequest.Merge(m, src)
 }
-func (m *QueryActiveExchangeRatesRequest) XXX_Size() int {
+func (m *QueryAllExchangeRateMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryActiveExchangeRatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryActiveExchangeRatesRequest.DiscardUnknown(m)
+func (m *QueryAllExchangeRateMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllExchangeRateMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryActiveExchangeRatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllExchangeRateMetadataRequest proto.InternalMessageInfo
 
-// QueryActiveExchangeRatesResponse is response type for the
-// Query/ActiveExchangeRates RPC method.
-type QueryActiveExchangeRatesResponse struct {
-	// activeRates defines a list of the denomination which oracle prices aggreed
-	// upon.
-	ActiveRates []string `protobuf:"bytes,1,rep,name=active_rates,json=activeRates,proto3" json:"active_rates,omitempty"`
+// QueryAllExchangeRateMetadataResponse is the response type for the
+// Query/AllExchangeRateMetadata RPC method.
+type QueryAllExchangeRateMetadataResponse struct {
+	// exchange_rate_metadata defines the metadata of the exchange rates of all
+	// denoms.
+	ExchangeRateMetadata []ExchangeRateMetadata `protobuf:"bytes,1,rep,name=exchange_rate_metadata,json=exchangeRateMetadata,proto3" json:"exchange_rate_metadata"`
 }
 
-func (m *QueryActiveExchangeRatesResponse) Reset()         { *m = QueryActiveExchangeRatesResponse{} }
-func (m *QueryActiveExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryActiveExchangeRatesResponse) ProtoMessage()    {}
-func (*QueryActiveExchangeRatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllExchangeRateMetadataResponse) Reset()         { *m = QueryAllExchangeRateMetadataResponse{} }
+func (m *QueryAllExchangeRateMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllExchangeRateMetadataResponse) ProtoMessage()    {}
+func (*QueryAllExchangeRateMetadataResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{5}
 }
-func (m *QueryActiveExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllExchangeRateMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryActiveExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllExchangeRateMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryActiveExchangeRatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllExchangeRateMetadataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -264,44 +278,42 @@ func (m *QueryActiveExchangeRatesResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryActiveExchangeRatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryActiveExchangeRatesResponse.Merge(m, src)
+func (m *QueryAllExchangeRateMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllExchangeRateMetadataResponse.Merge(m, src)
 }
-func (m *QueryActiveExchangeRatesResponse) XXX_Size() int {
+func (m *QueryAllExchangeRateMetadataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryActiveExchangeRatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryActiveExchangeRatesResponse.DiscardUnknown(m)
+func (m *QueryAllExchangeRateMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllExchangeRateMetadataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryActiveExchangeRatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllExchangeRateMetadataResponse proto.InternalMessageInfo
 
-func (m *QueryActiveExchangeRatesResponse) GetActiveRates() []string {
+func (m *QueryAllExchangeRateMetadataResponse) GetExchangeRateMetadata() []ExchangeRateMetadata {
 	if m != nil {
-		return m.ActiveRates
+		return m.ExchangeRateMetadata
 	}
 	return nil
 }
 
-// QueryFeederDelegationRequest is the request type for the
-// Query/FeederDelegation RPC method.
-type QueryFeederDelegationRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+// QueryHaltedDenomsRequest is the request type for the Query/HaltedDenoms RPC
+// method.
+type QueryHaltedDenomsRequest struct {
 }
 
-func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegationRequest{} }
-func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFeederDelegationRequest) ProtoMessage()    {}
-func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryHaltedDenomsRequest) Reset()         { *m = QueryHaltedDenomsRequest{} }
+func (m *QueryHaltedDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHaltedDenomsRequest) ProtoMessage()    {}
+func (*QueryHaltedDenomsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{6}
 }
-func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryHaltedDenomsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHaltedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeederDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHaltedDenomsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -311,37 +323,37 @@ func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryFeederDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeederDelegationRequest.Merge(m, src)
+func (m *QueryHaltedDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHaltedDenomsRequest.Merge(m, src)
 }
-func (m *QueryFeederDelegationRequest) XXX_Size() int {
+func (m *QueryHaltedDenomsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeederDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeederDelegationRequest.DiscardUnknown(m)
+func (m *QueryHaltedDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHaltedDenomsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeederDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryHaltedDenomsRequest proto.InternalMessageInfo
 
-// QueryFeederDelegationResponse is response type for the
-// Query/FeederDelegation RPC method.
-type QueryFeederDelegationResponse struct {
-	// feeder_addr defines the feeder delegation of a validator
-	FeederAddr string `protobuf:"bytes,1,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
+// QueryHaltedDenomsResponse is the response type for the Query/HaltedDenoms
+// RPC method.
+type QueryHaltedDenomsResponse struct {
+	// halted_denoms defines the denoms halted by the circuit breaker.
+	HaltedDenoms []HaltedDenom `protobuf:"bytes,1,rep,name=halted_denoms,json=haltedDenoms,proto3" json:"halted_denoms"`
 }
 
-func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelegationResponse{} }
-func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFeederDelegationResponse) ProtoMessage()    {}
-func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryHaltedDenomsResponse) Reset()         { *m = QueryHaltedDenomsResponse{} }
+func (m *QueryHaltedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHaltedDenomsResponse) ProtoMessage()    {}
+func (*QueryHaltedDenomsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{7}
 }
-func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHaltedDenomsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHaltedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeederDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHaltedDenomsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -351,44 +363,42 @@ func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryFeederDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeederDelegationResponse.Merge(m, src)
+func (m *QueryHaltedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHaltedDenomsResponse.Merge(m, src)
 }
-func (m *QueryFeederDelegationResponse) XXX_Size() int {
+func (m *QueryHaltedDenomsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeederDelegationResponse.DiscardUnknown(m)
+func (m *QueryHaltedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHaltedDenomsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHaltedDenomsResponse proto.InternalMessageInfo
 
-func (m *QueryFeederDelegationResponse) GetFeederAddr() string {
+func (m *QueryHaltedDenomsResponse) GetHaltedDenoms() []HaltedDenom {
 	if m != nil {
-		return m.FeederAddr
+		return m.HaltedDenoms
 	}
-	return ""
+	return nil
 }
 
-// QueryMissCounterRequest is the request type for the Query/MissCounter RPC
+// QueryAllExchangeRatesRequest is the request type for the Query/ExchangeRate RPC
 // method.
-type QueryMissCounterRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+type QueryAllExchangeRatesRequest struct {
 }
 
-func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest{} }
-func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryMissCounterRequest) ProtoMessage()    {}
-func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllExchangeRatesRequest) Reset()         { *m = QueryAllExchangeRatesRequest{} }
+func (m *QueryAllExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryAllExchangeRatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{8}
 }
-func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMissCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMissCounterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllExchangeRatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -398,37 +408,38 @@ func (m *QueryMissCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryMissCounterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMissCounterRequest.Merge(m, src)
+func (m *QueryAllExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllExchangeRatesRequest.Merge(m, src)
 }
-func (m *QueryMissCounterRequest) XXX_Size() int {
+func (m *QueryAllExchangeRatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMissCounterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMissCounterRequest.DiscardUnknown(m)
+func (m *QueryAllExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllExchangeRatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMissCounterRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllExchangeRatesRequest proto.InternalMessageInfo
 
-// QueryMissCounterResponse is response type for the
-// Query/MissCounter RPC method.
-type QueryMissCounterResponse struct {
-	// miss_counter defines the oracle miss counter of a validator
-	MissCounter uint64 `protobuf:"varint,1,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+// QueryAllExchangeRatesResponse is response type for the
+// Query/ExchangeRates RPC method.
+type QueryAllExchangeRatesResponse struct {
+	// exchange_rates defines a list of the exchange rate for all whitelisted
+	// denoms.
+	ExchangeRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"exchange_rates"`
 }
 
-func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterResponse{} }
-func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMissCounterResponse) ProtoMessage()    {}
-func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllExchangeRatesResponse) Reset()         { *m = QueryAllExchangeRatesResponse{} }
+func (m *QueryAllExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryAllExchangeRatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{9}
 }
-func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMissCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMissCounterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllExchangeRatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -438,44 +449,50 @@ func (m *QueryMissCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryMissCounterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMissCounterResponse.Merge(m, src)
+func (m *QueryAllExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllExchangeRatesResponse.Merge(m, src)
 }
-func (m *QueryMissCounterResponse) XXX_Size() int {
+func (m *QueryAllExchangeRatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMissCounterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMissCounterResponse.DiscardUnknown(m)
+func (m *QueryAllExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllExchangeRatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMissCounterResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllExchangeRatesResponse proto.InternalMessageInfo
 
-func (m *QueryMissCounterResponse) GetMissCounter() uint64 {
+func (m *QueryAllExchangeRatesResponse) GetExchangeRates() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	if m != nil {
-		return m.MissCounter
+		return m.ExchangeRates
 	}
-	return 0
-}
-
-// QueryAggregatePrevoteRequest is the request type for the
-// Query/AggregatePrevote RPC method.
-type QueryAggregatePrevoteRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	return nil
 }
 
-func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
-func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
-func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
+// QueryHistoricExchangeRatesRequest is the request type for the
+// Query/HistoricExchangeRates RPC method.
+type QueryHistoricExchangeRatesRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_height is the first block height to include, zero means unbounded.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last block height to include, zero means unbounded.
+	EndHeight uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoricExchangeRatesRequest) Reset()         { *m = QueryHistoricExchangeRatesRequest{} }
+func (m *QueryHistoricExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryHistoricExchangeRatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{10}
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryHistoricExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHistoricExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHistoricExchangeRatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -485,38 +502,40 @@ func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
+func (m *QueryHistoricExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricExchangeRatesRequest.Merge(m, src)
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
+func (m *QueryHistoricExchangeRatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
+func (m *QueryHistoricExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricExchangeRatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryHistoricExchangeRatesRequest proto.InternalMessageInfo
 
-// QueryAggregatePrevoteResponse is response type for the
-// Query/AggregatePrevote RPC method.
-type QueryAggregatePrevoteResponse struct {
-	// aggregate_prevote defines oracle aggregate prevote submitted by a validator
-	// in the current vote period
-	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
+// QueryHistoricExchangeRatesResponse is response type for the
+// Query/HistoricExchangeRates RPC method.
+type QueryHistoricExchangeRatesResponse struct {
+	// historic_exchange_rates defines the tallied exchange rates ordered by
+	// block height.
+	HistoricExchangeRates []HistoricExchangeRate `protobuf:"bytes,1,rep,name=historic_exchange_rates,json=historicExchangeRates,proto3" json:"historic_exchange_rates"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
-func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
-func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
+func (m *QueryHistoricExchangeRatesResponse) Reset()         { *m = QueryHistoricExchangeRatesResponse{} }
+func (m *QueryHistoricExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryHistoricExchangeRatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{11}
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHistoricExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHistoricExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHistoricExchangeRatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -526,42 +545,55 @@ func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
+func (m *QueryHistoricExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricExchangeRatesResponse.Merge(m, src)
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
+func (m *QueryHistoricExchangeRatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
+func (m *QueryHistoricExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricExchangeRatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHistoricExchangeRatesResponse proto.InternalMessageInfo
 
-func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
+func (m *QueryHistoricExchangeRatesResponse) GetHistoricExchangeRates() []HistoricExchangeRate {
 	if m != nil {
-		return m.AggregatePrevote
+		return m.HistoricExchangeRates
 	}
-	return AggregateExchangeRatePrevote{}
+	return nil
 }
 
-// QueryAggregatePrevotesRequest is the request type for the
-// Query/AggregatePrevotes RPC method.
-type QueryAggregatePrevotesRequest struct {
+func (m *QueryHistoricExchangeRatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePrevotesRequest{} }
-func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
-func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_45ea8e3c157a904c, []int{12}
-}
-func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
+// QueryTallyResultsRequest is the request type for the Query/TallyResults RPC
+// method.
+type QueryTallyResultsRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_period is the first vote period to include, zero means unbounded.
+	StartPeriod uint64 `protobuf:"varint,2,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	// end_period is the last vote period to include, zero means unbounded.
+	EndPeriod uint64 `protobuf:"varint,3,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+}
+
+func (m *QueryTallyResultsRequest) Reset()         { *m = QueryTallyResultsRequest{} }
+func (m *QueryTallyResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultsRequest) ProtoMessage()    {}
+func (*QueryTallyResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{12}
+}
+func (m *QueryTallyResultsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTallyResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevotesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTallyResultsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -571,38 +603,38 @@ func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevotesRequest.Merge(m, src)
+func (m *QueryTallyResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyResultsRequest.Merge(m, src)
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Size() int {
+func (m *QueryTallyResultsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevotesRequest.DiscardUnknown(m)
+func (m *QueryTallyResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyResultsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevotesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTallyResultsRequest proto.InternalMessageInfo
 
-// QueryAggregatePrevotesResponse is response type for the
-// Query/AggregatePrevotes RPC method.
-type QueryAggregatePrevotesResponse struct {
-	// aggregate_prevotes defines all oracle aggregate prevotes submitted in the
-	// current vote period
-	AggregatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,1,rep,name=aggregate_prevotes,json=aggregatePrevotes,proto3" json:"aggregate_prevotes"`
+// QueryTallyResultsResponse is the response type for the Query/TallyResults
+// RPC method.
+type QueryTallyResultsResponse struct {
+	// tally_results defines the statistics of the tallies ordered by vote
+	// period.
+	TallyResults []TallyResult `protobuf:"bytes,1,rep,name=tally_results,json=tallyResults,proto3" json:"tally_results"`
 }
 
-func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePrevotesResponse{} }
-func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
-func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTallyResultsResponse) Reset()         { *m = QueryTallyResultsResponse{} }
+func (m *QueryTallyResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultsResponse) ProtoMessage()    {}
+func (*QueryTallyResultsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{13}
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTallyResultsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTallyResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevotesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTallyResultsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -612,44 +644,42 @@ func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevotesResponse.Merge(m, src)
+func (m *QueryTallyResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyResultsResponse.Merge(m, src)
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Size() int {
+func (m *QueryTallyResultsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevotesResponse.DiscardUnknown(m)
+func (m *QueryTallyResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyResultsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTallyResultsResponse proto.InternalMessageInfo
 
-func (m *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []AggregateExchangeRatePrevote {
+func (m *QueryTallyResultsResponse) GetTallyResults() []TallyResult {
 	if m != nil {
-		return m.AggregatePrevotes
+		return m.TallyResults
 	}
 	return nil
 }
 
-// QueryAggregateVoteRequest is the request type for the Query/AggregateVote RPC
-// method.
-type QueryAggregateVoteRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+// QueryLatestTallyResultsRequest is the request type for the
+// Query/LatestTallyResults RPC method.
+type QueryLatestTallyResultsRequest struct {
 }
 
-func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteRequest{} }
-func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVoteRequest) ProtoMessage()    {}
-func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLatestTallyResultsRequest) Reset()         { *m = QueryLatestTallyResultsRequest{} }
+func (m *QueryLatestTallyResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestTallyResultsRequest) ProtoMessage()    {}
+func (*QueryLatestTallyResultsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{14}
 }
-func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestTallyResultsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestTallyResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestTallyResultsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -659,38 +689,37 @@ func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVoteRequest.Merge(m, src)
+func (m *QueryLatestTallyResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestTallyResultsRequest.Merge(m, src)
 }
-func (m *QueryAggregateVoteRequest) XXX_Size() int {
+func (m *QueryLatestTallyResultsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVoteRequest.DiscardUnknown(m)
+func (m *QueryLatestTallyResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestTallyResultsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestTallyResultsRequest proto.InternalMessageInfo
 
-// QueryAggregateVoteResponse is response type for the
-// Query/AggregateVote RPC method.
-type QueryAggregateVoteResponse struct {
-	// aggregate_vote defines oracle aggregate vote submitted by a validator in
-	// the current vote period
-	AggregateVote AggregateExchangeRateVote `protobuf:"bytes,1,opt,name=aggregate_vote,json=aggregateVote,proto3" json:"aggregate_vote"`
+// QueryLatestTallyResultsResponse is the response type for the
+// Query/LatestTallyResults RPC method.
+type QueryLatestTallyResultsResponse struct {
+	// tally_results defines the statistics of the latest tally of every denom.
+	TallyResults []TallyResult `protobuf:"bytes,1,rep,name=tally_results,json=tallyResults,proto3" json:"tally_results"`
 }
 
-func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteResponse{} }
-func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVoteResponse) ProtoMessage()    {}
-func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLatestTallyResultsResponse) Reset()         { *m = QueryLatestTallyResultsResponse{} }
+func (m *QueryLatestTallyResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestTallyResultsResponse) ProtoMessage()    {}
+func (*QueryLatestTallyResultsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{15}
 }
-func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestTallyResultsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestTallyResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestTallyResultsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -700,42 +729,44 @@ func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVoteResponse.Merge(m, src)
+func (m *QueryLatestTallyResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestTallyResultsResponse.Merge(m, src)
 }
-func (m *QueryAggregateVoteResponse) XXX_Size() int {
+func (m *QueryLatestTallyResultsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVoteResponse.DiscardUnknown(m)
+func (m *QueryLatestTallyResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestTallyResultsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestTallyResultsResponse proto.InternalMessageInfo
 
-func (m *QueryAggregateVoteResponse) GetAggregateVote() AggregateExchangeRateVote {
+func (m *QueryLatestTallyResultsResponse) GetTallyResults() []TallyResult {
 	if m != nil {
-		return m.AggregateVote
+		return m.TallyResults
 	}
-	return AggregateExchangeRateVote{}
+	return nil
 }
 
-// QueryAggregateVotesRequest is the request type for the Query/AggregateVotes
-// RPC method.
-type QueryAggregateVotesRequest struct {
+// QueryRemotePriceRequest is the request type for the Query/RemotePrice RPC
+// method.
+type QueryRemotePriceRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesRequest{} }
-func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVotesRequest) ProtoMessage()    {}
-func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryRemotePriceRequest) Reset()         { *m = QueryRemotePriceRequest{} }
+func (m *QueryRemotePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemotePriceRequest) ProtoMessage()    {}
+func (*QueryRemotePriceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{16}
 }
-func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRemotePriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRemotePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVotesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRemotePriceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -745,38 +776,37 @@ func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVotesRequest.Merge(m, src)
+func (m *QueryRemotePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemotePriceRequest.Merge(m, src)
 }
-func (m *QueryAggregateVotesRequest) XXX_Size() int {
+func (m *QueryRemotePriceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVotesRequest.DiscardUnknown(m)
+func (m *QueryRemotePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemotePriceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVotesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRemotePriceRequest proto.InternalMessageInfo
 
-// QueryAggregateVotesResponse is response type for the
-// Query/AggregateVotes RPC method.
-type QueryAggregateVotesResponse struct {
-	// aggregate_votes defines all oracle aggregate votes submitted in the current
-	// vote period
-	AggregateVotes []AggregateExchangeRateVote `protobuf:"bytes,1,rep,name=aggregate_votes,json=aggregateVotes,proto3" json:"aggregate_votes"`
+// QueryRemotePriceResponse is the response type for the Query/RemotePrice RPC
+// method.
+type QueryRemotePriceResponse struct {
+	// remote_price defines the price of the denom read from its remote DEX pool.
+	RemotePrice RemotePrice `protobuf:"bytes,1,opt,name=remote_price,json=remotePrice,proto3" json:"remote_price"`
 }
 
-func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotesResponse{} }
-func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVotesResponse) ProtoMessage()    {}
-func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRemotePriceResponse) Reset()         { *m = QueryRemotePriceResponse{} }
+func (m *QueryRemotePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemotePriceResponse) ProtoMessage()    {}
+func (*QueryRemotePriceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{17}
 }
-func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRemotePriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRemotePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVotesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRemotePriceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -786,41 +816,47 @@ func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVotesResponse.Merge(m, src)
+func (m *QueryRemotePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemotePriceResponse.Merge(m, src)
 }
-func (m *QueryAggregateVotesResponse) XXX_Size() int {
+func (m *QueryRemotePriceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVotesResponse.DiscardUnknown(m)
+func (m *QueryRemotePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemotePriceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRemotePriceResponse proto.InternalMessageInfo
 
-func (m *QueryAggregateVotesResponse) GetAggregateVotes() []AggregateExchangeRateVote {
+func (m *QueryRemotePriceResponse) GetRemotePrice() RemotePrice {
 	if m != nil {
-		return m.AggregateVotes
+		return m.RemotePrice
 	}
-	return nil
+	return RemotePrice{}
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryCrossExchangeRateRequest is the request type for the
+// Query/CrossExchangeRate RPC method.
+type QueryCrossExchangeRateRequest struct {
+	// base defines the symbol or base denom of the priced asset.
+	Base string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// quote defines the symbol or base denom of the asset the price is quoted
+	// in, or USD.
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCrossExchangeRateRequest) Reset()         { *m = QueryCrossExchangeRateRequest{} }
+func (m *QueryCrossExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossExchangeRateRequest) ProtoMessage()    {}
+func (*QueryCrossExchangeRateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{18}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCrossExchangeRateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCrossExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCrossExchangeRateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -830,36 +866,38 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryCrossExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossExchangeRateRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryCrossExchangeRateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryCrossExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossExchangeRateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCrossExchangeRateRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+// QueryCrossExchangeRateResponse is the response type for the
+// Query/CrossExchangeRate RPC method.
+type QueryCrossExchangeRateResponse struct {
+	// exchange_rate defines the amount of quote asset per unit of base asset.
+	// Base denoms are priced per base unit, symbols per display unit.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCrossExchangeRateResponse) Reset()         { *m = QueryCrossExchangeRateResponse{} }
+func (m *QueryCrossExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossExchangeRateResponse) ProtoMessage()    {}
+func (*QueryCrossExchangeRateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_45ea8e3c157a904c, []int{19}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCrossExchangeRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCrossExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCrossExchangeRateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -869,40 +907,77 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryCrossExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossExchangeRateResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryCrossExchangeRateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryCrossExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossExchangeRateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCrossExchangeRateResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
-	if m != nil {
-		return m.Params
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window_seconds is the length of the averaging window in seconds.
+	WindowSeconds uint64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{20}
+}
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Params{}
+}
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
+}
+func (m *QueryTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
 }
 
-type QueryRewardPoolBalanceRequest struct {
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
+
+// QueryTWAPResponse is response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	// twap defines the time-weighted average exchange rate over the window.
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
 }
 
-func (m *QueryRewardPoolBalanceRequest) Reset()         { *m = QueryRewardPoolBalanceRequest{} }
-func (m *QueryRewardPoolBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardPoolBalanceRequest) ProtoMessage()    {}
-func (*QueryRewardPoolBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_45ea8e3c157a904c, []int{20}
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{21}
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardPoolBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -912,35 +987,44 @@ func (m *QueryRewardPoolBalanceRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardPoolBalanceRequest.Merge(m, src)
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_Size() int {
+func (m *QueryTWAPResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardPoolBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardPoolBalanceRequest.DiscardUnknown(m)
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardPoolBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
 
-type QueryRewardPoolBalanceResponse struct {
-	// funds left in the reward pool
-	RemainingFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=remaining_funds,json=remainingFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_funds"`
+// ValidatorPerformanceSummary defines the vote statistics of a validator
+// summed over the kept slash windows.
+type ValidatorPerformanceSummary struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// windows is the number of slash windows with statistics.
+	Windows   uint64 `protobuf:"varint,2,opt,name=windows,proto3" json:"windows,omitempty"`
+	Voted     uint64 `protobuf:"varint,3,opt,name=voted,proto3" json:"voted,omitempty"`
+	Won       uint64 `protobuf:"varint,4,opt,name=won,proto3" json:"won,omitempty"`
+	Missed    uint64 `protobuf:"varint,5,opt,name=missed,proto3" json:"missed,omitempty"`
+	Abstained uint64 `protobuf:"varint,6,opt,name=abstained,proto3" json:"abstained,omitempty"`
+	// uptime is the share of the vote periods that were not a miss.
+	Uptime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
 }
 
-func (m *QueryRewardPoolBalanceResponse) Reset()         { *m = QueryRewardPoolBalanceResponse{} }
-func (m *QueryRewardPoolBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardPoolBalanceResponse) ProtoMessage()    {}
-func (*QueryRewardPoolBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_45ea8e3c157a904c, []int{21}
+func (m *ValidatorPerformanceSummary) Reset()         { *m = ValidatorPerformanceSummary{} }
+func (m *ValidatorPerformanceSummary) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceSummary) ProtoMessage()    {}
+func (*ValidatorPerformanceSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{22}
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorPerformanceSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorPerformanceSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardPoolBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorPerformanceSummary.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -950,1513 +1034,5236 @@ func (m *QueryRewardPoolBalanceResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardPoolBalanceResponse.Merge(m, src)
+func (m *ValidatorPerformanceSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceSummary.Merge(m, src)
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_Size() int {
+func (m *ValidatorPerformanceSummary) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardPoolBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardPoolBalanceResponse.DiscardUnknown(m)
+func (m *ValidatorPerformanceSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceSummary.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardPoolBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorPerformanceSummary proto.InternalMessageInfo
 
-func (m *QueryRewardPoolBalanceResponse) GetRemainingFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *ValidatorPerformanceSummary) GetValidator() string {
 	if m != nil {
-		return m.RemainingFunds
+		return m.Validator
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "persistence.oracle.v1beta1.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "persistence.oracle.v1beta1.QueryExchangeRateResponse")
-	proto.RegisterType((*QueryAllExchangeRatesRequest)(nil), "persistence.oracle.v1beta1.QueryAllExchangeRatesRequest")
-	proto.RegisterType((*QueryAllExchangeRatesResponse)(nil), "persistence.oracle.v1beta1.QueryAllExchangeRatesResponse")
-	proto.RegisterType((*QueryActiveExchangeRatesRequest)(nil), "persistence.oracle.v1beta1.QueryActiveExchangeRatesRequest")
-	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "persistence.oracle.v1beta1.QueryActiveExchangeRatesResponse")
-	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "persistence.oracle.v1beta1.QueryFeederDelegationRequest")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "persistence.oracle.v1beta1.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryMissCounterRequest)(nil), "persistence.oracle.v1beta1.QueryMissCounterRequest")
-	proto.RegisterType((*QueryMissCounterResponse)(nil), "persistence.oracle.v1beta1.QueryMissCounterResponse")
-	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "persistence.oracle.v1beta1.QueryAggregatePrevoteRequest")
-	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "persistence.oracle.v1beta1.QueryAggregatePrevoteResponse")
-	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "persistence.oracle.v1beta1.QueryAggregatePrevotesRequest")
-	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "persistence.oracle.v1beta1.QueryAggregatePrevotesResponse")
-	proto.RegisterType((*QueryAggregateVoteRequest)(nil), "persistence.oracle.v1beta1.QueryAggregateVoteRequest")
-	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "persistence.oracle.v1beta1.QueryAggregateVoteResponse")
-	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "persistence.oracle.v1beta1.QueryAggregateVotesRequest")
-	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "persistence.oracle.v1beta1.QueryAggregateVotesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "persistence.oracle.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "persistence.oracle.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryRewardPoolBalanceRequest)(nil), "persistence.oracle.v1beta1.QueryRewardPoolBalanceRequest")
-	proto.RegisterType((*QueryRewardPoolBalanceResponse)(nil), "persistence.oracle.v1beta1.QueryRewardPoolBalanceResponse")
+func (m *ValidatorPerformanceSummary) GetWindows() uint64 {
+	if m != nil {
+		return m.Windows
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("persistence/oracle/v1beta1/query.proto", fileDescriptor_45ea8e3c157a904c)
+func (m *ValidatorPerformanceSummary) GetVoted() uint64 {
+	if m != nil {
+		return m.Voted
+	}
+	return 0
 }
 
-var fileDescriptor_45ea8e3c157a904c = []byte{
-	// 1164 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
-	0x14, 0xdf, 0x81, 0xb6, 0xa2, 0xb3, 0xc9, 0x36, 0x99, 0x46, 0x90, 0x98, 0xb0, 0x9b, 0x1a, 0x04,
-	0x11, 0x50, 0x9b, 0xe6, 0xa3, 0x4d, 0x93, 0x36, 0x24, 0x69, 0x1a, 0xf1, 0x21, 0x20, 0x35, 0xa2,
-	0x48, 0x5c, 0x56, 0x13, 0x7b, 0xe2, 0x5a, 0xf5, 0x7a, 0xb6, 0x1e, 0x67, 0x49, 0x55, 0x55, 0x42,
-	0x70, 0xe1, 0x80, 0x04, 0x12, 0x02, 0x09, 0x4e, 0x3d, 0x73, 0xe6, 0x0a, 0x12, 0x07, 0x50, 0x2f,
-	0x40, 0x55, 0x2e, 0x88, 0x03, 0xa0, 0x84, 0x03, 0x7f, 0x06, 0xf2, 0xcc, 0xd8, 0x6b, 0xef, 0xda,
-	0xbb, 0xde, 0x2d, 0x3d, 0xb5, 0x3b, 0xf3, 0x3e, 0x7e, 0xbf, 0xf7, 0xde, 0xf8, 0xfd, 0x14, 0xf8,
-	0x6c, 0x93, 0xf8, 0xcc, 0x61, 0x01, 0xf1, 0x4c, 0xa2, 0x53, 0x1f, 0x9b, 0x2e, 0xd1, 0x5b, 0x67,
-	0x76, 0x48, 0x80, 0xcf, 0xe8, 0x37, 0xf6, 0x88, 0x7f, 0x53, 0x6b, 0xfa, 0x34, 0xa0, 0x48, 0x49,
-	0xd8, 0x69, 0xc2, 0x4e, 0x93, 0x76, 0xca, 0x84, 0x4d, 0x6d, 0xca, 0xcd, 0xf4, 0xf0, 0x7f, 0xc2,
-	0x43, 0x99, 0xb6, 0x29, 0xb5, 0x5d, 0xa2, 0xe3, 0xa6, 0xa3, 0x63, 0xcf, 0xa3, 0x01, 0x0e, 0x1c,
-	0xea, 0x31, 0x79, 0xfb, 0x5c, 0x8f, 0xbc, 0x32, 0xbc, 0x30, 0xac, 0x9a, 0x94, 0x35, 0x28, 0xd3,
-	0x77, 0x30, 0x6b, 0x5b, 0x98, 0xd4, 0xf1, 0xe4, 0xfd, 0x94, 0xb8, 0xaf, 0x8b, 0xfc, 0xe2, 0x87,
-	0xb8, 0x52, 0x97, 0xe1, 0xe4, 0x95, 0x90, 0xc2, 0xe5, 0x7d, 0xf3, 0x1a, 0xf6, 0x6c, 0x62, 0xe0,
-	0x80, 0x18, 0xe4, 0xc6, 0x1e, 0x61, 0x01, 0x9a, 0x80, 0x47, 0x2d, 0xe2, 0xd1, 0xc6, 0x24, 0x98,
-	0x01, 0xb3, 0xc7, 0x0d, 0xf1, 0x63, 0xf9, 0xb1, 0x8f, 0xef, 0xd4, 0x4a, 0xff, 0xde, 0xa9, 0x95,
-	0xd4, 0xd7, 0xe0, 0x54, 0x86, 0x2f, 0x6b, 0x52, 0x8f, 0x11, 0xf4, 0x34, 0x1c, 0x25, 0xf2, 0xbc,
-	0xee, 0xe3, 0x80, 0xc8, 0x20, 0x23, 0x24, 0x61, 0x9c, 0x88, 0x55, 0x85, 0xd3, 0x3c, 0xd6, 0xba,
-	0xeb, 0x26, 0xc3, 0x31, 0x89, 0x45, 0xfd, 0x0a, 0xc0, 0xa7, 0x72, 0x0c, 0x64, 0xc2, 0x7d, 0x58,
-	0x49, 0x25, 0x64, 0x93, 0x60, 0xe6, 0xd1, 0xd9, 0xf2, 0xdc, 0xb4, 0x26, 0x09, 0x87, 0xd5, 0x89,
-	0xfa, 0xa1, 0x6d, 0x12, 0xf3, 0x12, 0x75, 0xbc, 0x8d, 0xf9, 0xbb, 0x7f, 0xd6, 0x4a, 0xdf, 0xfc,
-	0x55, 0x7b, 0xc1, 0x76, 0x82, 0x6b, 0x7b, 0x3b, 0x9a, 0x49, 0x1b, 0xb2, 0x40, 0xf2, 0x9f, 0xd3,
-	0xcc, 0xba, 0xae, 0x07, 0x37, 0x9b, 0x84, 0x45, 0x3e, 0xcc, 0x18, 0x4d, 0x92, 0x60, 0xea, 0x29,
-	0x58, 0x13, 0xd0, 0xcc, 0xc0, 0x69, 0x91, 0x4c, 0xf8, 0x97, 0xe1, 0x4c, 0xbe, 0x89, 0x24, 0x70,
-	0x0a, 0x8e, 0x60, 0x7e, 0x9d, 0x80, 0x7f, 0xdc, 0x28, 0x8b, 0x33, 0x91, 0xc9, 0x91, 0x55, 0xda,
-	0x22, 0xc4, 0x22, 0xfe, 0x26, 0x71, 0x89, 0xcd, 0x27, 0x26, 0xea, 0xd8, 0xcb, 0xb0, 0xd2, 0xc2,
-	0xae, 0x63, 0xe1, 0x80, 0xfa, 0x75, 0x6c, 0x59, 0xbe, 0xa8, 0xfa, 0xc6, 0xe4, 0xfd, 0x6f, 0x4f,
-	0x4f, 0xc8, 0x32, 0xac, 0x5b, 0x96, 0x4f, 0x18, 0x7b, 0x3b, 0xf0, 0x1d, 0xcf, 0x36, 0x46, 0x63,
-	0xfb, 0xf0, 0x3c, 0xd1, 0x90, 0x35, 0x59, 0xef, 0xee, 0x54, 0x12, 0x6e, 0x0d, 0x96, 0x77, 0xf9,
-	0x5d, 0x22, 0x91, 0x01, 0xc5, 0x51, 0x18, 0x4b, 0xb5, 0xe0, 0x13, 0x3c, 0xc2, 0x1b, 0x0e, 0x63,
-	0x97, 0xe8, 0x9e, 0x17, 0x10, 0xff, 0x21, 0xe0, 0xbc, 0x28, 0x07, 0x38, 0x95, 0xa5, 0x5d, 0xd1,
-	0x86, 0xc3, 0x58, 0xdd, 0x14, 0xe7, 0x3c, 0xc9, 0x11, 0xa3, 0xdc, 0x68, 0x9b, 0xc6, 0x15, 0x5d,
-	0xb7, 0x6d, 0x3f, 0x64, 0x48, 0xb6, 0x7d, 0xd2, 0xa2, 0xed, 0x37, 0xf0, 0x3f, 0x22, 0xfd, 0x24,
-	0x1e, 0xe1, 0xae, 0x5c, 0x12, 0xef, 0x75, 0x38, 0x8e, 0xa3, 0xbb, 0x7a, 0x53, 0x5c, 0xf2, 0x7c,
-	0xe5, 0xb9, 0x25, 0x2d, 0xff, 0xe3, 0xa2, 0xc5, 0x01, 0x93, 0x83, 0x25, 0x83, 0x6f, 0x1c, 0x09,
-	0x27, 0xdc, 0x18, 0xc3, 0x1d, 0x49, 0xd5, 0x5a, 0x0e, 0x9a, 0x78, 0x66, 0x3f, 0x05, 0xb0, 0x9a,
-	0x67, 0x21, 0x01, 0x37, 0x20, 0xea, 0x02, 0x1c, 0xbd, 0xbb, 0x07, 0x45, 0x3c, 0xde, 0x89, 0x98,
-	0xa9, 0xbb, 0xf2, 0x83, 0x13, 0x7b, 0x5f, 0x7d, 0x38, 0x9d, 0xfa, 0x00, 0x40, 0x25, 0x2b, 0x91,
-	0x64, 0xbd, 0x03, 0x2b, 0x6d, 0xd6, 0x89, 0x1e, 0x2d, 0x0e, 0xcc, 0xf8, 0x6a, 0x9b, 0xee, 0x28,
-	0x4e, 0xe6, 0x52, 0xa7, 0xb3, 0x10, 0xc4, 0xad, 0xf9, 0x08, 0xc0, 0x27, 0x33, 0xaf, 0x25, 0x42,
-	0x0b, 0x9e, 0x48, 0x23, 0x8c, 0x9a, 0xf2, 0x40, 0x10, 0x2b, 0x29, 0x88, 0x4c, 0x9d, 0x80, 0x88,
-	0x83, 0xd8, 0xc6, 0x3e, 0x6e, 0xc4, 0xd8, 0xde, 0x85, 0x27, 0x53, 0xa7, 0x12, 0xd2, 0x1a, 0x3c,
-	0xd6, 0xe4, 0x27, 0xb2, 0x58, 0x6a, 0x2f, 0x24, 0xc2, 0x57, 0xa6, 0x95, 0x7e, 0xf1, 0xc0, 0x1a,
-	0xe4, 0x7d, 0xec, 0x5b, 0xdb, 0x94, 0xba, 0x1b, 0xd8, 0xc5, 0x9e, 0x19, 0x4d, 0x80, 0xfa, 0x65,
-	0x34, 0xb0, 0x19, 0x16, 0x12, 0x45, 0x00, 0x4f, 0xf8, 0xa4, 0x81, 0x1d, 0xcf, 0xf1, 0xec, 0xfa,
-	0xee, 0x9e, 0x67, 0x45, 0x85, 0x99, 0xca, 0xdc, 0x12, 0x7c, 0x45, 0xbc, 0x24, 0x57, 0xc4, 0x6c,
-	0x81, 0x15, 0x21, 0xf6, 0x43, 0x25, 0xce, 0xb1, 0x15, 0xa6, 0x98, 0xfb, 0x7a, 0x1c, 0x1e, 0xe5,
-	0xc0, 0xd0, 0x4f, 0x00, 0x8e, 0x75, 0x6e, 0x30, 0xd4, 0xf3, 0xa5, 0xf4, 0xda, 0x8a, 0xca, 0xf9,
-	0x21, 0x3c, 0x45, 0x25, 0xd4, 0x8b, 0x1f, 0xfe, 0xf6, 0xcf, 0xe7, 0x8f, 0x9c, 0x43, 0x8b, 0x7a,
-	0x0f, 0x95, 0xc1, 0x37, 0x3e, 0xd3, 0xb1, 0xeb, 0xd6, 0xd3, 0xbb, 0x15, 0x7d, 0x07, 0xe0, 0x48,
-	0x32, 0x30, 0x5a, 0xe8, 0x0b, 0x25, 0x43, 0x62, 0x28, 0x8b, 0x03, 0x7a, 0x49, 0xf0, 0x6b, 0x1c,
-	0xfc, 0x32, 0x5a, 0x2a, 0x00, 0x3e, 0x05, 0x5c, 0xbf, 0xc5, 0x4f, 0x6f, 0xa3, 0xfb, 0x00, 0x9e,
-	0xcc, 0x58, 0xc6, 0x68, 0xa5, 0x7f, 0x45, 0x73, 0xb7, 0xbc, 0x72, 0x61, 0x38, 0xe7, 0x21, 0x48,
-	0x49, 0xa1, 0xd0, 0xd1, 0x94, 0x5f, 0x01, 0x1c, 0xeb, 0xdc, 0xd7, 0x05, 0xa6, 0x2b, 0x47, 0x4d,
-	0x14, 0x98, 0xae, 0x3c, 0x71, 0xa0, 0x6e, 0x71, 0x2e, 0x6b, 0x68, 0xb5, 0x17, 0x97, 0xf8, 0xf3,
-	0xcb, 0xf4, 0x5b, 0xe9, 0x4f, 0xf7, 0x6d, 0x5d, 0xe8, 0x08, 0xf4, 0x3d, 0x80, 0xe5, 0xc4, 0x66,
-	0x47, 0xf3, 0x7d, 0x21, 0x75, 0xab, 0x0d, 0x65, 0x61, 0x30, 0x27, 0x49, 0x61, 0x93, 0x53, 0x58,
-	0x45, 0x17, 0x86, 0xa5, 0x10, 0xca, 0x0c, 0xf4, 0x47, 0xf8, 0xe0, 0x3b, 0x16, 0x59, 0x91, 0x07,
-	0x9f, 0x2d, 0x47, 0x8a, 0x3c, 0xf8, 0x1c, 0x71, 0xa1, 0x5e, 0xe1, 0x7c, 0x5e, 0x47, 0xaf, 0x0e,
-	0xcb, 0xa7, 0x6b, 0xd3, 0xa3, 0x9f, 0x01, 0x1c, 0xef, 0x12, 0x07, 0x68, 0x70, 0x8c, 0xf1, 0x03,
-	0x5a, 0x1e, 0xc6, 0x55, 0xf2, 0x5b, 0xe7, 0xfc, 0x56, 0xd0, 0xf9, 0x82, 0xfc, 0xba, 0x85, 0x0b,
-	0xfa, 0x05, 0xc0, 0xd1, 0xd4, 0x46, 0x45, 0x8b, 0xc5, 0x01, 0x25, 0xb4, 0x88, 0x72, 0x76, 0x50,
-	0x37, 0xc9, 0xe1, 0x4d, 0xce, 0xe1, 0x15, 0xb4, 0xd5, 0x87, 0x83, 0xe5, 0xf4, 0xed, 0x11, 0x6f,
-	0xd0, 0x0f, 0x00, 0x56, 0xd2, 0x12, 0x01, 0x0d, 0x08, 0x2d, 0x6e, 0xcd, 0xb9, 0x81, 0xfd, 0x24,
-	0xa7, 0x55, 0xce, 0x69, 0x09, 0x9d, 0x1d, 0xb8, 0x2f, 0xa2, 0x29, 0x5f, 0x00, 0x78, 0x4c, 0xe8,
-	0x01, 0xa4, 0xf5, 0xc5, 0x90, 0x92, 0x22, 0x8a, 0x5e, 0xd8, 0x5e, 0x62, 0x7d, 0x9e, 0x63, 0x7d,
-	0x06, 0xa9, 0xbd, 0xb0, 0x0a, 0x39, 0x82, 0x7e, 0x04, 0xf0, 0xf1, 0x6c, 0xb5, 0x51, 0xe0, 0x05,
-	0xe4, 0x69, 0x98, 0x02, 0x2f, 0x20, 0x57, 0xdc, 0xa8, 0x0b, 0x1c, 0xbd, 0x86, 0x5e, 0xcc, 0x47,
-	0xaf, 0xfb, 0xdc, 0xbb, 0xde, 0xa4, 0xd4, 0x15, 0xfa, 0x67, 0xe3, 0x9d, 0xbb, 0x07, 0x55, 0x70,
-	0xef, 0xa0, 0x0a, 0xfe, 0x3e, 0xa8, 0x82, 0xcf, 0x0e, 0xab, 0xa5, 0x7b, 0x87, 0xd5, 0xd2, 0xef,
-	0x87, 0xd5, 0xd2, 0x7b, 0x2b, 0x09, 0xc1, 0x93, 0x88, 0xf8, 0x96, 0x47, 0x92, 0x3f, 0xb9, 0xfa,
-	0x69, 0xcd, 0xe9, 0xfb, 0x51, 0x26, 0xae, 0x84, 0x76, 0x8e, 0xf1, 0xbf, 0x2f, 0xcc, 0xff, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0x87, 0xe2, 0x6a, 0x20, 0x3d, 0x11, 0x00, 0x00,
+func (m *ValidatorPerformanceSummary) GetWon() uint64 {
+	if m != nil {
+		return m.Won
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ValidatorPerformanceSummary) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRates returns exchange rates of all denoms.
-	AllExchangeRates(ctx context.Context, in *QueryAllExchangeRatesRequest, opts ...grpc.CallOption) (*QueryAllExchangeRatesResponse, error)
-	// ExchangeRate returns exchange rates of a specified denom.
-	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// ActiveExchangeRates returns all active denoms
-	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRatesRequest, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryRewardPoolBalance queries funds left in the reward pool.
-	QueryRewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error)
+func (m *ValidatorPerformanceSummary) GetAbstained() uint64 {
+	if m != nil {
+		return m.Abstained
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryValidatorPerformanceRequest is the request type for the
+// Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryValidatorPerformanceRequest) Reset()         { *m = QueryValidatorPerformanceRequest{} }
+func (m *QueryValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceRequest) ProtoMessage()    {}
+func (*QueryValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{23}
 }
-
-func (c *queryClient) AllExchangeRates(ctx context.Context, in *QueryAllExchangeRatesRequest, opts ...grpc.CallOption) (*QueryAllExchangeRatesResponse, error) {
-	out := new(QueryAllExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AllExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
-	out := new(QueryExchangeRateResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/ExchangeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRatesRequest, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error) {
-	out := new(QueryActiveExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/ActiveExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryValidatorPerformanceRequest proto.InternalMessageInfo
+
+// QueryValidatorPerformanceResponse is the response type for the
+// Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceResponse struct {
+	Summary ValidatorPerformanceSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary"`
+	// windows defines the statistics of each kept slash window.
+	Windows []ValidatorPerformance `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows"`
 }
 
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformanceResponse) Reset()         { *m = QueryValidatorPerformanceResponse{} }
+func (m *QueryValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceResponse) ProtoMessage()    {}
+func (*QueryValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{24}
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
-	out := new(QueryMissCounterResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/MissCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPerformanceResponse) GetSummary() ValidatorPerformanceSummary {
+	if m != nil {
+		return m.Summary
 	}
-	return out, nil
+	return ValidatorPerformanceSummary{}
 }
 
-func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
-	out := new(QueryAggregatePrevoteResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AggregatePrevote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformanceResponse) GetWindows() []ValidatorPerformance {
+	if m != nil {
+		return m.Windows
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
-	out := new(QueryAggregatePrevotesResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AggregatePrevotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryValidatorPerformancesRequest is the request type for the
+// Query/ValidatorPerformances RPC method.
+type QueryValidatorPerformancesRequest struct {
 }
 
-func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
-	out := new(QueryAggregateVoteResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AggregateVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformancesRequest) Reset()         { *m = QueryValidatorPerformancesRequest{} }
+func (m *QueryValidatorPerformancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformancesRequest) ProtoMessage()    {}
+func (*QueryValidatorPerformancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{25}
+}
+func (m *QueryValidatorPerformancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorPerformancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformancesRequest.Merge(m, src)
+}
+func (m *QueryValidatorPerformancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformancesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
-	out := new(QueryAggregateVotesResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AggregateVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryValidatorPerformancesRequest proto.InternalMessageInfo
+
+// QueryValidatorPerformancesResponse is the response type for the
+// Query/ValidatorPerformances RPC method.
+type QueryValidatorPerformancesResponse struct {
+	// summaries defines the statistics of the validators ranked by uptime.
+	Summaries []ValidatorPerformanceSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformancesResponse) Reset()         { *m = QueryValidatorPerformancesResponse{} }
+func (m *QueryValidatorPerformancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformancesResponse) ProtoMessage()    {}
+func (*QueryValidatorPerformancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{26}
+}
+func (m *QueryValidatorPerformancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorPerformancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformancesResponse.Merge(m, src)
+}
+func (m *QueryValidatorPerformancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformancesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryRewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error) {
-	out := new(QueryRewardPoolBalanceResponse)
-	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/QueryRewardPoolBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorPerformancesResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPerformancesResponse) GetSummaries() []ValidatorPerformanceSummary {
+	if m != nil {
+		return m.Summaries
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRates returns exchange rates of all denoms.
-	AllExchangeRates(context.Context, *QueryAllExchangeRatesRequest) (*QueryAllExchangeRatesResponse, error)
-	// ExchangeRate returns exchange rates of a specified denom.
-	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// ActiveExchangeRates returns all active denoms
-	ActiveExchangeRates(context.Context, *QueryActiveExchangeRatesRequest) (*QueryActiveExchangeRatesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryRewardPoolBalance queries funds left in the reward pool.
-	QueryRewardPoolBalance(context.Context, *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error)
+// QueryOracleJailRequest is the request type for the Query/OracleJail RPC
+// method.
+type QueryOracleJailRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryOracleJailRequest) Reset()         { *m = QueryOracleJailRequest{} }
+func (m *QueryOracleJailRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleJailRequest) ProtoMessage()    {}
+func (*QueryOracleJailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{27}
 }
-
-func (*UnimplementedQueryServer) AllExchangeRates(ctx context.Context, req *QueryAllExchangeRatesRequest) (*QueryAllExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllExchangeRates not implemented")
+func (m *QueryOracleJailRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+func (m *QueryOracleJailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleJailRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ActiveExchangeRates(ctx context.Context, req *QueryActiveExchangeRatesRequest) (*QueryActiveExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveExchangeRates not implemented")
+func (m *QueryOracleJailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleJailRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+func (m *QueryOracleJailRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+func (m *QueryOracleJailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleJailRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+
+var xxx_messageInfo_QueryOracleJailRequest proto.InternalMessageInfo
+
+// QueryOracleJailResponse is the response type for the Query/OracleJail RPC
+// method.
+type QueryOracleJailResponse struct {
+	OracleJail OracleJail `protobuf:"bytes,1,opt,name=oracle_jail,json=oracleJail,proto3" json:"oracle_jail"`
 }
-func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+
+func (m *QueryOracleJailResponse) Reset()         { *m = QueryOracleJailResponse{} }
+func (m *QueryOracleJailResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleJailResponse) ProtoMessage()    {}
+func (*QueryOracleJailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{28}
 }
-func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+func (m *QueryOracleJailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+func (m *QueryOracleJailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleJailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryOracleJailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleJailResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryRewardPoolBalance(ctx context.Context, req *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRewardPoolBalance not implemented")
+func (m *QueryOracleJailResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryOracleJailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleJailResponse.DiscardUnknown(m)
 }
 
-func _Query_AllExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllExchangeRatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/AllExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllExchangeRates(ctx, req.(*QueryAllExchangeRatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryOracleJailResponse proto.InternalMessageInfo
 
-func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/ExchangeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+func (m *QueryOracleJailResponse) GetOracleJail() OracleJail {
+	if m != nil {
+		return m.OracleJail
 	}
-	return interceptor(ctx, in, info, handler)
+	return OracleJail{}
 }
 
-func _Query_ActiveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveExchangeRatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/ActiveExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveExchangeRates(ctx, req.(*QueryActiveExchangeRatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryOracleJailsRequest is the request type for the Query/OracleJails RPC
+// method.
+type QueryOracleJailsRequest struct {
 }
 
-func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeederDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeederDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/FeederDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryOracleJailsRequest) Reset()         { *m = QueryOracleJailsRequest{} }
+func (m *QueryOracleJailsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleJailsRequest) ProtoMessage()    {}
+func (*QueryOracleJailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{29}
 }
-
-func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMissCounterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MissCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/MissCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounterRequest))
+func (m *QueryOracleJailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleJailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleJailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOracleJailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleJailsRequest.Merge(m, src)
+}
+func (m *QueryOracleJailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleJailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleJailsRequest.DiscardUnknown(m)
 }
 
-func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/AggregatePrevote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryOracleJailsRequest proto.InternalMessageInfo
+
+// QueryOracleJailsResponse is the response type for the Query/OracleJails RPC
+// method.
+type QueryOracleJailsResponse struct {
+	OracleJails []OracleJail `protobuf:"bytes,1,rep,name=oracle_jails,json=oracleJails,proto3" json:"oracle_jails"`
 }
 
-func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/AggregatePrevotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
+func (m *QueryOracleJailsResponse) Reset()         { *m = QueryOracleJailsResponse{} }
+func (m *QueryOracleJailsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleJailsResponse) ProtoMessage()    {}
+func (*QueryOracleJailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{30}
+}
+func (m *QueryOracleJailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleJailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleJailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOracleJailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleJailsResponse.Merge(m, src)
+}
+func (m *QueryOracleJailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleJailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleJailsResponse.DiscardUnknown(m)
 }
 
-func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/AggregateVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVoteRequest))
+var xxx_messageInfo_QueryOracleJailsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleJailsResponse) GetOracleJails() []OracleJail {
+	if m != nil {
+		return m.OracleJails
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/AggregateVotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryActiveExchangeRatesRequest is the request type for the Query/ActiveExchangeRates RPC method.
+type QueryActiveExchangeRatesRequest struct {
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryActiveExchangeRatesRequest) Reset()         { *m = QueryActiveExchangeRatesRequest{} }
+func (m *QueryActiveExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryActiveExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{31}
+}
+func (m *QueryActiveExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveExchangeRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryActiveExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveExchangeRatesRequest.Merge(m, src)
+}
+func (m *QueryActiveExchangeRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveExchangeRatesRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryRewardPoolBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardPoolBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRewardPoolBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/persistence.oracle.v1beta1.Query/QueryRewardPoolBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRewardPoolBalance(ctx, req.(*QueryRewardPoolBalanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryActiveExchangeRatesRequest proto.InternalMessageInfo
+
+// QueryActiveExchangeRatesResponse is response type for the
+// Query/ActiveExchangeRates RPC method.
+type QueryActiveExchangeRatesResponse struct {
+	// activeRates defines a list of the denomination which oracle prices aggreed
+	// upon.
+	ActiveRates []string `protobuf:"bytes,1,rep,name=active_rates,json=activeRates,proto3" json:"active_rates,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "persistence.oracle.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AllExchangeRates",
-			Handler:    _Query_AllExchangeRates_Handler,
-		},
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-		{
-			MethodName: "ActiveExchangeRates",
-			Handler:    _Query_ActiveExchangeRates_Handler,
-		},
-		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
-		},
-		{
-			MethodName: "MissCounter",
-			Handler:    _Query_MissCounter_Handler,
-		},
-		{
-			MethodName: "AggregatePrevote",
-			Handler:    _Query_AggregatePrevote_Handler,
-		},
-		{
-			MethodName: "AggregatePrevotes",
-			Handler:    _Query_AggregatePrevotes_Handler,
-		},
-		{
-			MethodName: "AggregateVote",
-			Handler:    _Query_AggregateVote_Handler,
-		},
-		{
-			MethodName: "AggregateVotes",
-			Handler:    _Query_AggregateVotes_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryRewardPoolBalance",
-			Handler:    _Query_QueryRewardPoolBalance_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "persistence/oracle/v1beta1/query.proto",
+func (m *QueryActiveExchangeRatesResponse) Reset()         { *m = QueryActiveExchangeRatesResponse{} }
+func (m *QueryActiveExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryActiveExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{32}
 }
-
-func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryActiveExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryActiveExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveExchangeRatesResponse.Merge(m, src)
 }
-
-func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryActiveExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryActiveExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveExchangeRatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryActiveExchangeRatesResponse proto.InternalMessageInfo
 
-func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExchangeRate) > 0 {
-		i -= len(m.ExchangeRate)
-		copy(dAtA[i:], m.ExchangeRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExchangeRate)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryActiveExchangeRatesResponse) GetActiveRates() []string {
+	if m != nil {
+		return m.ActiveRates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryFeederDelegationRequest is the request type for the
+// Query/FeederDelegation RPC method.
+type QueryFeederDelegationRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryAllExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegationRequest{} }
+func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederDelegationRequest) ProtoMessage()    {}
+func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{33}
 }
-
-func (m *QueryAllExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAllExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeederDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederDelegationRequest.Merge(m, src)
+}
+func (m *QueryFeederDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederDelegationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeederDelegationRequest proto.InternalMessageInfo
+
+// QueryFeederDelegationResponse is response type for the
+// Query/FeederDelegation RPC method.
+type QueryFeederDelegationResponse struct {
+	// feeder_addr defines the feeder delegation of a validator
+	FeederAddr string `protobuf:"bytes,1,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
 }
 
-func (m *QueryAllExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelegationResponse{} }
+func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederDelegationResponse) ProtoMessage()    {}
+func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{34}
+}
+func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeederDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederDelegationResponse.Merge(m, src)
+}
+func (m *QueryFeederDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederDelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryActiveExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryFeederDelegationResponse) GetFeederAddr() string {
+	if m != nil {
+		return m.FeederAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryActiveExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeedersRequest is the request type for the Query/Feeders RPC method.
+type QueryFeedersRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryActiveExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryActiveExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeedersRequest) Reset()         { *m = QueryFeedersRequest{} }
+func (m *QueryFeedersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedersRequest) ProtoMessage()    {}
+func (*QueryFeedersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{35}
 }
-
-func (m *QueryActiveExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeedersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryActiveExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActiveRates) > 0 {
-		for iNdEx := len(m.ActiveRates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ActiveRates[iNdEx])
-			copy(dAtA[i:], m.ActiveRates[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ActiveRates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFeedersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeedersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedersRequest.Merge(m, src)
 }
-
-func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeedersRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFeedersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryFeedersRequest proto.InternalMessageInfo
 
-func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeedersResponse is response type for the Query/Feeders RPC method.
+type QueryFeedersResponse struct {
+	// feeder_addr defines the feeder delegation of a validator
+	FeederAddr string `protobuf:"bytes,1,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
+	// feeders defines the registered feeders of a validator, including the
+	// expired ones not pruned yet.
+	Feeders []Feeder `protobuf:"bytes,2,rep,name=feeders,proto3" json:"feeders"`
 }
 
-func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeederAddr) > 0 {
-		i -= len(m.FeederAddr)
-		copy(dAtA[i:], m.FeederAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFeedersResponse) Reset()         { *m = QueryFeedersResponse{} }
+func (m *QueryFeedersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedersResponse) ProtoMessage()    {}
+func (*QueryFeedersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{36}
 }
-
-func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeedersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryMissCounterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeedersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedersResponse.Merge(m, src)
 }
-
-func (m *QueryMissCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFeedersResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeedersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryFeedersResponse proto.InternalMessageInfo
 
-func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryFeedersResponse) GetFeederAddr() string {
+	if m != nil {
+		return m.FeederAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeedersResponse) GetFeeders() []Feeder {
+	if m != nil {
+		return m.Feeders
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryMissCounterRequest is the request type for the Query/MissCounter RPC
+// method.
+type QueryMissCounterRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest{} }
+func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissCounterRequest) ProtoMessage()    {}
+func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{37}
 }
-
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMissCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissCounterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMissCounterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissCounterRequest.Merge(m, src)
 }
-
-func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMissCounterRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMissCounterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissCounterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryMissCounterRequest proto.InternalMessageInfo
 
-func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMissCounterResponse is response type for the
+// Query/MissCounter RPC method.
+type QueryMissCounterResponse struct {
+	// miss_counter defines the oracle miss counter of a validator
+	MissCounter uint64 `protobuf:"varint,1,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterResponse{} }
+func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissCounterResponse) ProtoMessage()    {}
+func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{38}
+}
+func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissCounterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMissCounterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissCounterResponse.Merge(m, src)
 }
-
-func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMissCounterResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryMissCounterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissCounterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMissCounterResponse proto.InternalMessageInfo
+
+func (m *QueryMissCounterResponse) GetMissCounter() uint64 {
+	if m != nil {
+		return m.MissCounter
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregatePrevoteRequest is the request type for the
+// Query/AggregatePrevote RPC method.
+type QueryAggregatePrevoteRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
+func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{39}
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
 }
-
-func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
 
-func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregatePrevoteResponse is response type for the
+// Query/AggregatePrevote RPC method.
+type QueryAggregatePrevoteResponse struct {
+	// aggregate_prevote defines oracle aggregate prevote submitted by a validator
+	// in the current vote period
+	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
 }
 
-func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
+func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{40}
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevote
 	}
-	return dAtA[:n], nil
+	return AggregateExchangeRatePrevote{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// QueryAggregatePrevotesRequest is the request type for the
+// Query/AggregatePrevotes RPC method.
+type QueryAggregatePrevotesRequest struct {
+}
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePrevotesRequest{} }
+func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{41}
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRewardPoolBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregatePrevotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.Merge(m, src)
 }
-
-func (m *QueryRewardPoolBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregatePrevotesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryRewardPoolBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAggregatePrevotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardPoolBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryAggregatePrevotesRequest proto.InternalMessageInfo
 
-func (m *QueryRewardPoolBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregatePrevotesResponse is response type for the
+// Query/AggregatePrevotes RPC method.
+type QueryAggregatePrevotesResponse struct {
+	// aggregate_prevotes defines all oracle aggregate prevotes submitted in the
+	// current vote period
+	AggregatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,1,rep,name=aggregate_prevotes,json=aggregatePrevotes,proto3" json:"aggregate_prevotes"`
 }
 
-func (m *QueryRewardPoolBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemainingFunds) > 0 {
-		for iNdEx := len(m.RemainingFunds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemainingFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePrevotesResponse{} }
+func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{42}
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryAggregatePrevotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.Merge(m, src)
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAggregatePrevotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ExchangeRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAggregatePrevotesResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevotes
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryAggregateVoteRequest is the request type for the Query/AggregateVote RPC
+// method.
+type QueryAggregateVoteRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryAllExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteRequest{} }
+func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVoteRequest) ProtoMessage()    {}
+func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{43}
+}
+func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAggregateVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVoteRequest.Merge(m, src)
+}
+func (m *QueryAggregateVoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVoteRequest.DiscardUnknown(m)
 }
 
-func (m *QueryActiveExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_QueryAggregateVoteRequest proto.InternalMessageInfo
+
+// QueryAggregateVoteResponse is response type for the
+// Query/AggregateVote RPC method.
+type QueryAggregateVoteResponse struct {
+	// aggregate_vote defines oracle aggregate vote submitted by a validator in
+	// the current vote period
+	AggregateVote AggregateExchangeRateVote `protobuf:"bytes,1,opt,name=aggregate_vote,json=aggregateVote,proto3" json:"aggregate_vote"`
 }
 
-func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ActiveRates) > 0 {
-		for _, s := range m.ActiveRates {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteResponse{} }
+func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVoteResponse) ProtoMessage()    {}
+func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{44}
+}
+func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *QueryFeederDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAggregateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVoteResponse.Merge(m, src)
 }
-
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAggregateVoteResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryMissCounterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAggregateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVoteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMissCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+var xxx_messageInfo_QueryAggregateVoteResponse proto.InternalMessageInfo
+
+func (m *QueryAggregateVoteResponse) GetAggregateVote() AggregateExchangeRateVote {
+	if m != nil {
+		return m.AggregateVote
 	}
-	return n
+	return AggregateExchangeRateVote{}
 }
 
-func (m *QueryAggregatePrevoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryAggregateVotesRequest is the request type for the Query/AggregateVotes
+// RPC method.
+type QueryAggregateVotesRequest struct {
 }
 
-func (m *QueryAggregatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesRequest{} }
+func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVotesRequest) ProtoMessage()    {}
+func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{45}
+}
+func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.AggregatePrevote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryAggregateVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVotesRequest.Merge(m, src)
+}
+func (m *QueryAggregateVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVotesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAggregatePrevotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_QueryAggregateVotesRequest proto.InternalMessageInfo
+
+// QueryAggregateVotesResponse is response type for the
+// Query/AggregateVotes RPC method.
+type QueryAggregateVotesResponse struct {
+	// aggregate_votes defines all oracle aggregate votes submitted in the current
+	// vote period
+	AggregateVotes []AggregateExchangeRateVote `protobuf:"bytes,1,rep,name=aggregate_votes,json=aggregateVotes,proto3" json:"aggregate_votes"`
 }
 
-func (m *QueryAggregatePrevotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for _, e := range m.AggregatePrevotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotesResponse{} }
+func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVotesResponse) ProtoMessage()    {}
+func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{46}
+}
+func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *QueryAggregateVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAggregateVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVotesResponse.Merge(m, src)
+}
+func (m *QueryAggregateVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVotesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAggregateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAggregateVotesResponse proto.InternalMessageInfo
+
+func (m *QueryAggregateVotesResponse) GetAggregateVotes() []AggregateExchangeRateVote {
+	if m != nil {
+		return m.AggregateVotes
 	}
-	var l int
-	_ = l
-	l = m.AggregateVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAggregateVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryAggregateVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for _, e := range m.AggregateVotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{47}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardPoolBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryRewardPoolBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{48}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryRewardPoolBalanceRequest struct {
+}
+
+func (m *QueryRewardPoolBalanceRequest) Reset()         { *m = QueryRewardPoolBalanceRequest{} }
+func (m *QueryRewardPoolBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolBalanceRequest) ProtoMessage()    {}
+func (*QueryRewardPoolBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{49}
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolBalanceRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolBalanceRequest proto.InternalMessageInfo
+
+type QueryRewardPoolBalanceResponse struct {
+	// funds left in the reward pool
+	RemainingFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=remaining_funds,json=remainingFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_funds"`
+}
+
+func (m *QueryRewardPoolBalanceResponse) Reset()         { *m = QueryRewardPoolBalanceResponse{} }
+func (m *QueryRewardPoolBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolBalanceResponse) ProtoMessage()    {}
+func (*QueryRewardPoolBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{50}
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolBalanceResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolBalanceResponse) GetRemainingFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingFunds
+	}
+	return nil
+}
+
+// QueryRewardProjectionRequest is the request type for the
+// Query/RewardProjection RPC method.
+type QueryRewardProjectionRequest struct {
+}
+
+func (m *QueryRewardProjectionRequest) Reset()         { *m = QueryRewardProjectionRequest{} }
+func (m *QueryRewardProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardProjectionRequest) ProtoMessage()    {}
+func (*QueryRewardProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{51}
+}
+func (m *QueryRewardProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardProjectionRequest.Merge(m, src)
+}
+func (m *QueryRewardProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardProjectionRequest proto.InternalMessageInfo
+
+// QueryRewardProjectionResponse is the response type for the
+// Query/RewardProjection RPC method.
+type QueryRewardProjectionResponse struct {
+	// per_period_payout is the payout of the next vote period, provided there
+	// are ballot winners.
+	PerPeriodPayout github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=per_period_payout,json=perPeriodPayout,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"per_period_payout"`
+	// unscheduled_funds are the funds of the reward pool not reserved by a
+	// reward schedule.
+	UnscheduledFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unscheduled_funds,json=unscheduledFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unscheduled_funds"`
+	Schedules        []RewardScheduleProjection               `protobuf:"bytes,3,rep,name=schedules,proto3" json:"schedules"`
+}
+
+func (m *QueryRewardProjectionResponse) Reset()         { *m = QueryRewardProjectionResponse{} }
+func (m *QueryRewardProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardProjectionResponse) ProtoMessage()    {}
+func (*QueryRewardProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{52}
+}
+func (m *QueryRewardProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardProjectionResponse.Merge(m, src)
+}
+func (m *QueryRewardProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryRewardProjectionResponse) GetPerPeriodPayout() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PerPeriodPayout
+	}
+	return nil
+}
+
+func (m *QueryRewardProjectionResponse) GetUnscheduledFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnscheduledFunds
+	}
+	return nil
+}
+
+func (m *QueryRewardProjectionResponse) GetSchedules() []RewardScheduleProjection {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+// RewardScheduleProjection - struct to hold the remaining runway of a reward
+// schedule
+type RewardScheduleProjection struct {
+	Schedule RewardSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+	// remaining_periods is the number of vote periods the schedule pays out
+	// for, bounded by its funds and its end height.
+	RemainingPeriods uint64 `protobuf:"varint,2,opt,name=remaining_periods,json=remainingPeriods,proto3" json:"remaining_periods,omitempty"`
+	// runway_end_height is the block height of the last payout of the schedule.
+	RunwayEndHeight uint64 `protobuf:"varint,3,opt,name=runway_end_height,json=runwayEndHeight,proto3" json:"runway_end_height,omitempty"`
+}
+
+func (m *RewardScheduleProjection) Reset()         { *m = RewardScheduleProjection{} }
+func (m *RewardScheduleProjection) String() string { return proto.CompactTextString(m) }
+func (*RewardScheduleProjection) ProtoMessage()    {}
+func (*RewardScheduleProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ea8e3c157a904c, []int{53}
+}
+func (m *RewardScheduleProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardScheduleProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardScheduleProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardScheduleProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardScheduleProjection.Merge(m, src)
+}
+func (m *RewardScheduleProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardScheduleProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardScheduleProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardScheduleProjection proto.InternalMessageInfo
+
+func (m *RewardScheduleProjection) GetSchedule() RewardSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return RewardSchedule{}
+}
+
+func (m *RewardScheduleProjection) GetRemainingPeriods() uint64 {
+	if m != nil {
+		return m.RemainingPeriods
+	}
+	return 0
+}
+
+func (m *RewardScheduleProjection) GetRunwayEndHeight() uint64 {
+	if m != nil {
+		return m.RunwayEndHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "persistence.oracle.v1beta1.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "persistence.oracle.v1beta1.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRateMetadataRequest)(nil), "persistence.oracle.v1beta1.QueryExchangeRateMetadataRequest")
+	proto.RegisterType((*QueryExchangeRateMetadataResponse)(nil), "persistence.oracle.v1beta1.QueryExchangeRateMetadataResponse")
+	proto.RegisterType((*QueryAllExchangeRateMetadataRequest)(nil), "persistence.oracle.v1beta1.QueryAllExchangeRateMetadataRequest")
+	proto.RegisterType((*QueryAllExchangeRateMetadataResponse)(nil), "persistence.oracle.v1beta1.QueryAllExchangeRateMetadataResponse")
+	proto.RegisterType((*QueryHaltedDenomsRequest)(nil), "persistence.oracle.v1beta1.QueryHaltedDenomsRequest")
+	proto.RegisterType((*QueryHaltedDenomsResponse)(nil), "persistence.oracle.v1beta1.QueryHaltedDenomsResponse")
+	proto.RegisterType((*QueryAllExchangeRatesRequest)(nil), "persistence.oracle.v1beta1.QueryAllExchangeRatesRequest")
+	proto.RegisterType((*QueryAllExchangeRatesResponse)(nil), "persistence.oracle.v1beta1.QueryAllExchangeRatesResponse")
+	proto.RegisterType((*QueryHistoricExchangeRatesRequest)(nil), "persistence.oracle.v1beta1.QueryHistoricExchangeRatesRequest")
+	proto.RegisterType((*QueryHistoricExchangeRatesResponse)(nil), "persistence.oracle.v1beta1.QueryHistoricExchangeRatesResponse")
+	proto.RegisterType((*QueryTallyResultsRequest)(nil), "persistence.oracle.v1beta1.QueryTallyResultsRequest")
+	proto.RegisterType((*QueryTallyResultsResponse)(nil), "persistence.oracle.v1beta1.QueryTallyResultsResponse")
+	proto.RegisterType((*QueryLatestTallyResultsRequest)(nil), "persistence.oracle.v1beta1.QueryLatestTallyResultsRequest")
+	proto.RegisterType((*QueryLatestTallyResultsResponse)(nil), "persistence.oracle.v1beta1.QueryLatestTallyResultsResponse")
+	proto.RegisterType((*QueryRemotePriceRequest)(nil), "persistence.oracle.v1beta1.QueryRemotePriceRequest")
+	proto.RegisterType((*QueryRemotePriceResponse)(nil), "persistence.oracle.v1beta1.QueryRemotePriceResponse")
+	proto.RegisterType((*QueryCrossExchangeRateRequest)(nil), "persistence.oracle.v1beta1.QueryCrossExchangeRateRequest")
+	proto.RegisterType((*QueryCrossExchangeRateResponse)(nil), "persistence.oracle.v1beta1.QueryCrossExchangeRateResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "persistence.oracle.v1beta1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "persistence.oracle.v1beta1.QueryTWAPResponse")
+	proto.RegisterType((*ValidatorPerformanceSummary)(nil), "persistence.oracle.v1beta1.ValidatorPerformanceSummary")
+	proto.RegisterType((*QueryValidatorPerformanceRequest)(nil), "persistence.oracle.v1beta1.QueryValidatorPerformanceRequest")
+	proto.RegisterType((*QueryValidatorPerformanceResponse)(nil), "persistence.oracle.v1beta1.QueryValidatorPerformanceResponse")
+	proto.RegisterType((*QueryValidatorPerformancesRequest)(nil), "persistence.oracle.v1beta1.QueryValidatorPerformancesRequest")
+	proto.RegisterType((*QueryValidatorPerformancesResponse)(nil), "persistence.oracle.v1beta1.QueryValidatorPerformancesResponse")
+	proto.RegisterType((*QueryOracleJailRequest)(nil), "persistence.oracle.v1beta1.QueryOracleJailRequest")
+	proto.RegisterType((*QueryOracleJailResponse)(nil), "persistence.oracle.v1beta1.QueryOracleJailResponse")
+	proto.RegisterType((*QueryOracleJailsRequest)(nil), "persistence.oracle.v1beta1.QueryOracleJailsRequest")
+	proto.RegisterType((*QueryOracleJailsResponse)(nil), "persistence.oracle.v1beta1.QueryOracleJailsResponse")
+	proto.RegisterType((*QueryActiveExchangeRatesRequest)(nil), "persistence.oracle.v1beta1.QueryActiveExchangeRatesRequest")
+	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "persistence.oracle.v1beta1.QueryActiveExchangeRatesResponse")
+	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "persistence.oracle.v1beta1.QueryFeederDelegationRequest")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "persistence.oracle.v1beta1.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryFeedersRequest)(nil), "persistence.oracle.v1beta1.QueryFeedersRequest")
+	proto.RegisterType((*QueryFeedersResponse)(nil), "persistence.oracle.v1beta1.QueryFeedersResponse")
+	proto.RegisterType((*QueryMissCounterRequest)(nil), "persistence.oracle.v1beta1.QueryMissCounterRequest")
+	proto.RegisterType((*QueryMissCounterResponse)(nil), "persistence.oracle.v1beta1.QueryMissCounterResponse")
+	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "persistence.oracle.v1beta1.QueryAggregatePrevoteRequest")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "persistence.oracle.v1beta1.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "persistence.oracle.v1beta1.QueryAggregatePrevotesRequest")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "persistence.oracle.v1beta1.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryAggregateVoteRequest)(nil), "persistence.oracle.v1beta1.QueryAggregateVoteRequest")
+	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "persistence.oracle.v1beta1.QueryAggregateVoteResponse")
+	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "persistence.oracle.v1beta1.QueryAggregateVotesRequest")
+	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "persistence.oracle.v1beta1.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "persistence.oracle.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "persistence.oracle.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryRewardPoolBalanceRequest)(nil), "persistence.oracle.v1beta1.QueryRewardPoolBalanceRequest")
+	proto.RegisterType((*QueryRewardPoolBalanceResponse)(nil), "persistence.oracle.v1beta1.QueryRewardPoolBalanceResponse")
+	proto.RegisterType((*QueryRewardProjectionRequest)(nil), "persistence.oracle.v1beta1.QueryRewardProjectionRequest")
+	proto.RegisterType((*QueryRewardProjectionResponse)(nil), "persistence.oracle.v1beta1.QueryRewardProjectionResponse")
+	proto.RegisterType((*RewardScheduleProjection)(nil), "persistence.oracle.v1beta1.RewardScheduleProjection")
+}
+
+func init() {
+	proto.RegisterFile("persistence/oracle/v1beta1/query.proto", fileDescriptor_45ea8e3c157a904c)
+}
+
+var fileDescriptor_45ea8e3c157a904c = []byte{
+	// 2522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdd, 0x6f, 0x14, 0xd7,
+	0x15, 0xf7, 0x80, 0x31, 0xf8, 0xac, 0x6d, 0xec, 0x8b, 0x81, 0x65, 0x02, 0x6b, 0x33, 0x24, 0x40,
+	0x21, 0xec, 0x82, 0xb1, 0x01, 0x9b, 0x4f, 0x1b, 0xe3, 0x10, 0x12, 0x9a, 0xcd, 0xba, 0x82, 0x7e,
+	0x3c, 0x6c, 0xc7, 0x3b, 0xd7, 0xeb, 0x09, 0xbb, 0x33, 0xcb, 0xdc, 0x59, 0x8c, 0x45, 0x23, 0xf5,
+	0xe3, 0xa5, 0x0f, 0x95, 0x5a, 0xa9, 0x4d, 0xa5, 0x4a, 0x7d, 0xc8, 0x73, 0x1f, 0xfa, 0xd4, 0x3e,
+	0xf4, 0xa1, 0x95, 0x52, 0xa9, 0x6d, 0x54, 0x29, 0x69, 0x94, 0xbc, 0x54, 0x91, 0x4a, 0x22, 0xa8,
+	0xaa, 0x3e, 0xf4, 0x8f, 0xa8, 0xe6, 0xde, 0x33, 0x33, 0x77, 0x77, 0x67, 0x66, 0x67, 0x97, 0xf8,
+	0xc9, 0x3b, 0xf7, 0xde, 0x73, 0xce, 0xef, 0x77, 0xcf, 0xb9, 0x1f, 0xf3, 0x1b, 0xc3, 0xf1, 0x06,
+	0x75, 0x98, 0xc9, 0x5c, 0x6a, 0x55, 0x68, 0xc1, 0x76, 0xf4, 0x4a, 0x8d, 0x16, 0x1e, 0x9d, 0x5b,
+	0xa3, 0xae, 0x7e, 0xae, 0xf0, 0xb0, 0x49, 0x9d, 0xad, 0x7c, 0xc3, 0xb1, 0x5d, 0x9b, 0xa8, 0xd2,
+	0xb8, 0xbc, 0x18, 0x97, 0xc7, 0x71, 0xea, 0x64, 0xd5, 0xae, 0xda, 0x7c, 0x58, 0xc1, 0xfb, 0x25,
+	0x2c, 0xd4, 0xc3, 0x55, 0xdb, 0xae, 0xd6, 0x68, 0x41, 0x6f, 0x98, 0x05, 0xdd, 0xb2, 0x6c, 0x57,
+	0x77, 0x4d, 0xdb, 0x62, 0xd8, 0x7b, 0x22, 0x21, 0x2e, 0xba, 0x17, 0x03, 0x73, 0x15, 0x9b, 0xd5,
+	0x6d, 0x56, 0x58, 0xd3, 0x59, 0x38, 0xa2, 0x62, 0x9b, 0x16, 0xf6, 0x1f, 0x12, 0xfd, 0x65, 0x11,
+	0x5f, 0x3c, 0x60, 0xd7, 0x29, 0xd9, 0x94, 0x93, 0x09, 0x1c, 0x34, 0xf4, 0xaa, 0x69, 0x71, 0x40,
+	0x62, 0xac, 0xb6, 0x00, 0xd9, 0xb7, 0xbd, 0x11, 0xb7, 0x1e, 0x57, 0x36, 0x74, 0xab, 0x4a, 0x4b,
+	0xba, 0x4b, 0x4b, 0xf4, 0x61, 0x93, 0x32, 0x97, 0x4c, 0xc2, 0x2e, 0x83, 0x5a, 0x76, 0x3d, 0xab,
+	0x4c, 0x2b, 0x27, 0x87, 0x4b, 0xe2, 0x61, 0x61, 0xcf, 0x8f, 0xdf, 0x9f, 0x1a, 0xf8, 0xef, 0xfb,
+	0x53, 0x03, 0xda, 0x1d, 0x38, 0x14, 0x61, 0xcb, 0x1a, 0xb6, 0xc5, 0x28, 0x39, 0x06, 0xa3, 0x14,
+	0xdb, 0xcb, 0x8e, 0xee, 0x52, 0x74, 0x32, 0x42, 0xa5, 0xc1, 0x92, 0xaf, 0x25, 0x98, 0xee, 0xf0,
+	0x75, 0x97, 0xba, 0xba, 0xa1, 0xbb, 0x7a, 0x5a, 0x3c, 0xff, 0x51, 0xe0, 0x68, 0x82, 0x13, 0x04,
+	0xb6, 0x1a, 0x09, 0x6c, 0x29, 0xff, 0xe1, 0xd3, 0xa9, 0x81, 0xcf, 0x9f, 0x4e, 0x1d, 0xaf, 0x9a,
+	0xee, 0x46, 0x73, 0x2d, 0x5f, 0xb1, 0xeb, 0x38, 0xab, 0xf8, 0xe7, 0x0c, 0x33, 0x1e, 0x14, 0xdc,
+	0xad, 0x06, 0x65, 0xf9, 0x65, 0x5a, 0x69, 0x25, 0x42, 0x4a, 0xb0, 0xa7, 0x8e, 0x81, 0xb2, 0x3b,
+	0xa6, 0x95, 0x93, 0x99, 0x99, 0xb3, 0xf9, 0xf8, 0xca, 0xc9, 0x47, 0x01, 0x5c, 0x1a, 0xf4, 0x10,
+	0x94, 0x02, 0x3f, 0x24, 0x0b, 0xbb, 0xe9, 0xe3, 0x86, 0xe9, 0x50, 0x23, 0xbb, 0x73, 0x5a, 0x39,
+	0xb9, 0xa7, 0xe4, 0x3f, 0x6a, 0xaf, 0xc0, 0x31, 0xce, 0x73, 0xb1, 0x56, 0x4b, 0x98, 0x2f, 0xed,
+	0x17, 0x0a, 0xbc, 0x9c, 0x3c, 0x0e, 0xa7, 0xa4, 0x06, 0x07, 0x5a, 0xa6, 0xa4, 0x1c, 0x70, 0x51,
+	0xa6, 0x77, 0xbe, 0x00, 0x97, 0x49, 0x1a, 0xd1, 0xa7, 0xa9, 0x58, 0x72, 0xb7, 0xf5, 0x9a, 0x4b,
+	0x8d, 0x65, 0x2f, 0x89, 0xcc, 0x87, 0x6c, 0xc3, 0xa1, 0x88, 0x3e, 0x84, 0x59, 0x82, 0xd1, 0x0d,
+	0xde, 0x5e, 0xe6, 0x99, 0x67, 0x88, 0xee, 0x44, 0x12, 0x3a, 0xc9, 0x11, 0x82, 0x1a, 0xd9, 0x90,
+	0x7c, 0x6b, 0x39, 0x38, 0x1c, 0x35, 0x45, 0x01, 0xa0, 0x5f, 0x29, 0x70, 0x24, 0x66, 0x00, 0xa2,
+	0x7a, 0x0c, 0x63, 0x2d, 0x93, 0xe7, 0xc3, 0x3a, 0x9c, 0xc7, 0x45, 0xe9, 0x2d, 0xc3, 0x00, 0xcf,
+	0x32, 0xad, 0xdc, 0xb4, 0x4d, 0x6b, 0xe9, 0xbc, 0x87, 0xe5, 0x37, 0x5f, 0x4c, 0x9d, 0x4e, 0x57,
+	0x6e, 0x9e, 0x0d, 0x2b, 0x8d, 0xca, 0xf3, 0xc9, 0xb4, 0x8f, 0xfc, 0x7a, 0xbf, 0x6d, 0x32, 0xd7,
+	0x76, 0xcc, 0x4a, 0x14, 0x83, 0xe8, 0x55, 0x43, 0x8e, 0xc2, 0x08, 0x73, 0x75, 0xc7, 0x2d, 0x6f,
+	0x50, 0xb3, 0xba, 0xe1, 0xf2, 0xa2, 0x1d, 0x2c, 0x65, 0x78, 0xdb, 0x6d, 0xde, 0x44, 0x8e, 0x00,
+	0x50, 0xcb, 0xf0, 0x07, 0xec, 0xe4, 0x03, 0x86, 0xa9, 0x65, 0x60, 0xf7, 0x0a, 0x40, 0xb8, 0x9b,
+	0x64, 0x07, 0x79, 0xd1, 0x1f, 0x6f, 0xe1, 0x2c, 0xf6, 0x51, 0x9f, 0x79, 0x51, 0xaf, 0xfa, 0x3b,
+	0x4b, 0x49, 0xb2, 0x94, 0xd6, 0xef, 0xbf, 0x14, 0xd0, 0x92, 0xf8, 0xe0, 0x84, 0x5b, 0x70, 0x70,
+	0x03, 0x07, 0x94, 0x23, 0x67, 0x3e, 0xb1, 0x5c, 0xa3, 0x7c, 0x63, 0x65, 0xec, 0xdf, 0x88, 0x8a,
+	0x4b, 0x5e, 0x6b, 0x21, 0x2a, 0x56, 0xf7, 0x89, 0xae, 0x44, 0x05, 0x58, 0x99, 0xa9, 0xf6, 0x3d,
+	0x2c, 0xfc, 0x6f, 0xe8, 0xb5, 0xda, 0x56, 0x89, 0xb2, 0x66, 0xcd, 0x4d, 0x9b, 0xa5, 0x06, 0x75,
+	0x4c, 0xdb, 0x68, 0xc9, 0x52, 0x91, 0x37, 0xf9, 0x59, 0xc2, 0x01, 0x61, 0x96, 0x44, 0xb7, 0x34,
+	0xbb, 0xfe, 0xd2, 0x6a, 0x8d, 0x1e, 0x2e, 0x2d, 0xd7, 0x6b, 0x2f, 0x3b, 0xa2, 0x23, 0xcd, 0xd2,
+	0x92, 0x1c, 0xf9, 0x4b, 0xcb, 0x95, 0x7c, 0x6b, 0xd3, 0x90, 0xe3, 0x01, 0xdf, 0xf4, 0x66, 0xd1,
+	0x8d, 0x20, 0xad, 0x35, 0x61, 0x2a, 0x76, 0xc4, 0x36, 0x02, 0x9b, 0x87, 0x83, 0x3c, 0x6c, 0x89,
+	0xd6, 0x6d, 0x97, 0x16, 0x1d, 0xb3, 0x92, 0xfa, 0xc8, 0xab, 0x41, 0xb6, 0xd3, 0x14, 0xa1, 0x16,
+	0x61, 0xc4, 0xe1, 0xcd, 0xe5, 0x86, 0xd7, 0xce, 0x5d, 0x74, 0x41, 0x2a, 0xb9, 0x41, 0xa4, 0x19,
+	0x27, 0x6c, 0xd2, 0x56, 0x71, 0xef, 0xb9, 0xe9, 0xd8, 0x8c, 0x45, 0x9d, 0xd0, 0x04, 0x06, 0xbd,
+	0x02, 0x44, 0xb4, 0xfc, 0xb7, 0x47, 0xe1, 0x61, 0xd3, 0x76, 0x29, 0x2f, 0x96, 0xe1, 0x92, 0x78,
+	0x90, 0x28, 0x34, 0x21, 0x17, 0xe7, 0x74, 0x1b, 0x4f, 0x48, 0xed, 0x5b, 0x30, 0x2e, 0xca, 0xef,
+	0xfe, 0x62, 0x31, 0xb9, 0xe8, 0x5f, 0x81, 0xb1, 0x4d, 0xd3, 0x32, 0xec, 0xcd, 0x32, 0xa3, 0x15,
+	0xdb, 0x32, 0x18, 0x96, 0xfd, 0xa8, 0x68, 0x5d, 0x15, 0x8d, 0x12, 0xa3, 0xfb, 0x30, 0x21, 0xb9,
+	0x46, 0x12, 0x4b, 0x30, 0xe8, 0x6e, 0xea, 0x8d, 0x3e, 0xb1, 0x73, 0x5b, 0xed, 0xbd, 0x1d, 0xf0,
+	0xd2, 0x3d, 0xbd, 0x66, 0x1a, 0xba, 0x6b, 0x3b, 0x45, 0xea, 0xac, 0xdb, 0x4e, 0x5d, 0xb7, 0x2a,
+	0x74, 0xb5, 0x59, 0xaf, 0xeb, 0xce, 0x16, 0xb9, 0x00, 0xc3, 0x8f, 0xfc, 0x6e, 0x0c, 0x94, 0xfd,
+	0xf4, 0x77, 0x67, 0x26, 0x71, 0x6f, 0x58, 0x34, 0x0c, 0x87, 0x32, 0xb6, 0xea, 0x3a, 0xa6, 0x55,
+	0x2d, 0x85, 0x43, 0xbd, 0x93, 0x5d, 0x70, 0xf1, 0xa9, 0xf9, 0x8f, 0xde, 0x8c, 0x3c, 0xb2, 0x5d,
+	0xea, 0x2f, 0x64, 0xf1, 0x40, 0xc6, 0x61, 0xe7, 0x26, 0xee, 0xb1, 0x83, 0x25, 0xef, 0x27, 0x39,
+	0x00, 0x43, 0x75, 0x93, 0x31, 0x6a, 0x64, 0x77, 0xf1, 0x46, 0x7c, 0x22, 0x87, 0x61, 0x58, 0x5f,
+	0x63, 0xae, 0x6e, 0x5a, 0xd4, 0xc8, 0x0e, 0x89, 0xcd, 0x20, 0x68, 0x20, 0x2b, 0x30, 0xd4, 0x6c,
+	0xb8, 0x66, 0x9d, 0x66, 0x77, 0xf7, 0x35, 0x2b, 0x68, 0xad, 0xd5, 0xf1, 0xb2, 0x16, 0x35, 0x37,
+	0x7e, 0x6e, 0xaf, 0xc3, 0x58, 0x40, 0xb8, 0xac, 0x1b, 0x46, 0xf7, 0x09, 0x1a, 0x0d, 0xc6, 0x7b,
+	0xed, 0x52, 0x7e, 0x3f, 0xf6, 0xcf, 0xb9, 0xe8, 0x78, 0x98, 0xf0, 0xfb, 0xb0, 0x9b, 0x89, 0xbc,
+	0xe0, 0xca, 0xbb, 0x98, 0xb4, 0xf2, 0x12, 0xd2, 0x8a, 0x2b, 0xd1, 0xf7, 0x46, 0x8a, 0x72, 0xb6,
+	0xba, 0x9e, 0x2f, 0x51, 0x8e, 0x7d, 0x8f, 0xe8, 0x46, 0x3b, 0x96, 0xc0, 0x27, 0xd8, 0x1c, 0x7f,
+	0xe0, 0x9f, 0x86, 0x31, 0xa3, 0x90, 0xf6, 0x77, 0x60, 0x58, 0x00, 0x35, 0x83, 0xf3, 0xef, 0x05,
+	0x89, 0x87, 0xfe, 0xb4, 0x0a, 0x1c, 0xe0, 0x10, 0xde, 0xe2, 0x3e, 0xee, 0xe8, 0x66, 0x6d, 0x1b,
+	0xd2, 0xbb, 0x01, 0x07, 0x3b, 0x82, 0x20, 0xb9, 0xbb, 0x90, 0x11, 0xf0, 0xcb, 0xef, 0xe8, 0x66,
+	0x0d, 0xf3, 0x7a, 0x3c, 0x89, 0x5e, 0xe8, 0x04, 0xd9, 0x80, 0x1d, 0xb4, 0x68, 0x87, 0x3a, 0x22,
+	0x05, 0xb3, 0xfd, 0x00, 0xb2, 0x9d, 0x5d, 0x88, 0xe2, 0x2d, 0x18, 0x91, 0x50, 0xf8, 0xb3, 0xdc,
+	0x1b, 0x8c, 0x4c, 0x08, 0x83, 0x69, 0x47, 0xf1, 0xdc, 0x5b, 0xac, 0xb8, 0xe6, 0x23, 0x1a, 0x79,
+	0xef, 0xbc, 0x05, 0xd3, 0xf1, 0x43, 0x10, 0xd7, 0x51, 0x18, 0xd1, 0x79, 0xb7, 0x74, 0xfb, 0x19,
+	0x2e, 0x65, 0x44, 0x9b, 0xb8, 0x22, 0x9a, 0x78, 0xbd, 0x5d, 0xa1, 0xd4, 0xa0, 0xce, 0x32, 0xad,
+	0xd1, 0x2a, 0xbf, 0x8b, 0x6c, 0x43, 0x1a, 0x6f, 0xc0, 0x91, 0x98, 0x50, 0x08, 0x77, 0x0a, 0x32,
+	0xeb, 0xbc, 0x4f, 0x0a, 0x54, 0x02, 0xd1, 0xe4, 0xf9, 0xd2, 0xbe, 0x0b, 0xfb, 0x24, 0x0f, 0x6c,
+	0x1b, 0x30, 0x3e, 0x81, 0xc9, 0xd6, 0x08, 0x29, 0xa1, 0x91, 0x25, 0xd8, 0x2d, 0x9e, 0xfc, 0x3d,
+	0x40, 0x4b, 0xca, 0xbe, 0x70, 0xef, 0xaf, 0x7a, 0x34, 0xd4, 0x0c, 0xac, 0xbe, 0xbb, 0x26, 0x63,
+	0x37, 0xed, 0xa6, 0xe5, 0x52, 0x67, 0x1b, 0x28, 0x5e, 0x85, 0x6c, 0x67, 0x94, 0xb0, 0x60, 0xbc,
+	0x73, 0xa2, 0x5c, 0x11, 0xed, 0x3c, 0xc8, 0x60, 0x29, 0x53, 0x0f, 0x87, 0x06, 0x05, 0xb3, 0x58,
+	0xad, 0x3a, 0x5e, 0x02, 0x69, 0xd1, 0xa1, 0xde, 0x21, 0xb4, 0x0d, 0x48, 0x7f, 0x12, 0xbc, 0x5a,
+	0x75, 0xc4, 0x42, 0xbc, 0x0f, 0x60, 0x42, 0xf7, 0xfb, 0xca, 0x0d, 0xd1, 0x89, 0x9b, 0xc0, 0xa5,
+	0xa4, 0xf9, 0x0f, 0x1c, 0xca, 0xeb, 0x06, 0x9d, 0x63, 0x56, 0xc6, 0xf5, 0xb6, 0xa0, 0xda, 0x54,
+	0x0c, 0x9a, 0x60, 0x49, 0xfe, 0x54, 0x81, 0x5c, 0xdc, 0x08, 0x04, 0x5c, 0x07, 0xd2, 0x01, 0xd8,
+	0xdf, 0x2f, 0x5e, 0x14, 0xf1, 0x44, 0x3b, 0x62, 0xa6, 0xad, 0xe3, 0x95, 0x3e, 0xb0, 0xbe, 0xb7,
+	0x3d, 0x99, 0xfa, 0xbe, 0x02, 0x6a, 0x54, 0x20, 0x64, 0xbd, 0x06, 0x63, 0x21, 0x6b, 0x29, 0x47,
+	0x73, 0x3d, 0x33, 0xbe, 0x17, 0xd2, 0x1d, 0xd5, 0xe5, 0x58, 0xda, 0xe1, 0x28, 0x04, 0x41, 0x6a,
+	0x7e, 0xa4, 0xc0, 0x4b, 0x91, 0xdd, 0x88, 0xd0, 0x80, 0xbd, 0xad, 0x08, 0xfd, 0xa4, 0xbc, 0x10,
+	0xc4, 0xb1, 0x16, 0x88, 0x4c, 0x9b, 0x04, 0xc2, 0x41, 0x14, 0x75, 0x47, 0x0f, 0x25, 0x8d, 0xfb,
+	0xb0, 0xaf, 0xa5, 0x15, 0x21, 0xdd, 0x80, 0xa1, 0x06, 0x6f, 0xc1, 0xc9, 0x4a, 0xdc, 0x50, 0x84,
+	0x2d, 0x86, 0x45, 0xbb, 0xa0, 0x60, 0x4b, 0x74, 0x53, 0x77, 0x8c, 0xa2, 0x6d, 0xd7, 0x96, 0xf4,
+	0x9a, 0x74, 0x05, 0xd3, 0x7e, 0xe9, 0x17, 0x6c, 0xc4, 0x08, 0x44, 0xe1, 0xc2, 0x5e, 0x87, 0xd6,
+	0x75, 0xd3, 0x32, 0xad, 0x6a, 0x79, 0xbd, 0x69, 0x19, 0xfe, 0xc4, 0x1c, 0x8a, 0x54, 0x2f, 0xb8,
+	0x74, 0x71, 0x16, 0xa5, 0x8b, 0x93, 0x29, 0x6e, 0x8d, 0x42, 0xb7, 0x18, 0x0b, 0x62, 0xac, 0x78,
+	0x21, 0x02, 0xd1, 0x05, 0x71, 0x39, 0xf6, 0x3b, 0xb4, 0x22, 0x9d, 0x4a, 0xda, 0xff, 0x76, 0xc0,
+	0x91, 0x98, 0x01, 0x88, 0x7b, 0x13, 0x26, 0x1a, 0xd4, 0xc1, 0xb7, 0xde, 0x72, 0x43, 0xdf, 0xb2,
+	0x9b, 0xee, 0x76, 0x20, 0xdf, 0xdb, 0xa0, 0x8e, 0x78, 0x93, 0x2e, 0xf2, 0x18, 0xe4, 0x31, 0x4c,
+	0x34, 0x2d, 0x56, 0xd9, 0xa0, 0x46, 0xb3, 0x46, 0x0d, 0x9c, 0xb2, 0x1d, 0x5f, 0x7d, 0xe0, 0x71,
+	0x29, 0x0a, 0x9f, 0x34, 0xf2, 0x4d, 0x18, 0xf6, 0x5b, 0x58, 0x76, 0x27, 0x8f, 0x38, 0x9b, 0xfc,
+	0x6e, 0xe9, 0xcd, 0xdd, 0x2a, 0x9a, 0x84, 0x73, 0x18, 0xdc, 0xf2, 0x7c, 0x67, 0xda, 0x07, 0x0a,
+	0x64, 0xe3, 0x46, 0x93, 0x37, 0x61, 0x8f, 0x3f, 0x12, 0x2b, 0xf5, 0x54, 0xfa, 0xa8, 0xbe, 0xa6,
+	0xe9, 0x7b, 0x20, 0xa7, 0x61, 0x22, 0xac, 0x37, 0x91, 0x3d, 0xff, 0x1d, 0x68, 0x3c, 0xe8, 0x10,
+	0x13, 0xce, 0xc8, 0x29, 0x98, 0x70, 0x9a, 0xd6, 0xa6, 0xbe, 0x55, 0xee, 0xd0, 0xa1, 0xf6, 0x8a,
+	0x8e, 0x5b, 0xbe, 0x1a, 0x35, 0xf3, 0xd9, 0x49, 0xd8, 0xc5, 0x4b, 0x86, 0xfc, 0x55, 0x81, 0xf1,
+	0x76, 0xb1, 0x8e, 0x24, 0x6e, 0xbe, 0x49, 0x02, 0xa0, 0x3a, 0xdf, 0x87, 0xa5, 0x28, 0x52, 0xed,
+	0xea, 0x0f, 0x3f, 0xfb, 0xf7, 0xcf, 0x77, 0x5c, 0x24, 0x73, 0x85, 0x04, 0xd1, 0x5f, 0x48, 0x99,
+	0x05, 0xbd, 0x56, 0x6b, 0x13, 0xb3, 0xc8, 0x1f, 0x15, 0x18, 0x91, 0x1d, 0x93, 0xd9, 0xae, 0x50,
+	0x22, 0x34, 0x02, 0x75, 0xae, 0x47, 0x2b, 0x04, 0x7f, 0x83, 0x83, 0x5f, 0x20, 0x97, 0x52, 0x80,
+	0x6f, 0x01, 0x5e, 0x78, 0xc2, 0x5b, 0xdf, 0x25, 0xcf, 0x14, 0xd8, 0x1f, 0xa9, 0xe4, 0x91, 0xab,
+	0x5d, 0x21, 0x25, 0x29, 0x9a, 0xea, 0xb5, 0x7e, 0xcd, 0x91, 0xda, 0x1d, 0x4e, 0x6d, 0x99, 0x2c,
+	0xa5, 0xa0, 0x86, 0x64, 0x0a, 0x31, 0x8a, 0x23, 0x4f, 0x92, 0x2c, 0x5c, 0xa5, 0x48, 0x52, 0x84,
+	0x12, 0xa6, 0xce, 0xf5, 0x68, 0xd5, 0x47, 0x92, 0x7c, 0x26, 0x2d, 0x72, 0x1a, 0xf9, 0x9b, 0x02,
+	0xa4, 0x53, 0x7e, 0x23, 0x0b, 0x5d, 0xf1, 0xc4, 0xaa, 0x7a, 0xea, 0xe5, 0xbe, 0x6c, 0x91, 0xd1,
+	0x25, 0xce, 0x68, 0x86, 0x9c, 0x4d, 0xc1, 0xa8, 0x95, 0xc9, 0x1f, 0x14, 0xc8, 0x48, 0x7a, 0x1a,
+	0x39, 0xdf, 0x15, 0x46, 0xa7, 0xfe, 0xa7, 0xce, 0xf6, 0x66, 0x84, 0xa0, 0xaf, 0x73, 0xd0, 0xf3,
+	0xe4, 0x62, 0x0f, 0x69, 0x90, 0xa5, 0x42, 0xf2, 0x6b, 0x05, 0x06, 0x3d, 0xf5, 0x8a, 0xbc, 0xda,
+	0xbd, 0x0e, 0x42, 0xfd, 0x4c, 0x3d, 0x93, 0x72, 0x34, 0xc2, 0xbc, 0xc8, 0x61, 0x9e, 0x23, 0x85,
+	0x5e, 0xaa, 0x65, 0x53, 0x6f, 0x90, 0xa7, 0x0a, 0x4c, 0x46, 0x7d, 0xe6, 0x21, 0x57, 0x7a, 0xda,
+	0x5b, 0xda, 0xbe, 0x4f, 0xa9, 0x57, 0xfb, 0xb4, 0x46, 0x3a, 0xaf, 0x73, 0x3a, 0x37, 0xc9, 0x62,
+	0x0f, 0x74, 0xa2, 0x3f, 0x73, 0x91, 0x2f, 0x14, 0x38, 0x18, 0xf3, 0x91, 0x8c, 0x5c, 0xef, 0xf5,
+	0x00, 0x68, 0xa7, 0x79, 0xa3, 0x7f, 0x07, 0xc8, 0x74, 0x91, 0x33, 0xbd, 0x4c, 0xe6, 0x7b, 0xdd,
+	0x8b, 0x43, 0x86, 0x7f, 0x57, 0x60, 0xa2, 0x43, 0xf1, 0x25, 0xdd, 0x0f, 0xb7, 0x38, 0xe9, 0x59,
+	0x5d, 0xe8, 0xc7, 0x14, 0xf9, 0x5c, 0xe3, 0x7c, 0x2e, 0x91, 0x0b, 0x29, 0xf8, 0x54, 0x3c, 0x2f,
+	0xad, 0xbb, 0x2e, 0xf9, 0xad, 0x02, 0x23, 0xf2, 0x17, 0xc2, 0x14, 0x9b, 0x6e, 0xc4, 0xc7, 0x46,
+	0x75, 0xae, 0x47, 0x2b, 0x44, 0x7f, 0x8e, 0xa3, 0x3f, 0x4d, 0xbe, 0x96, 0x02, 0xbd, 0xf8, 0xd6,
+	0x48, 0xbe, 0x54, 0x60, 0x32, 0x4a, 0x78, 0x4b, 0xb1, 0x80, 0x12, 0x34, 0x56, 0xf5, 0x6a, 0x9f,
+	0xd6, 0x48, 0xe4, 0x0d, 0x4e, 0xe4, 0x16, 0xb9, 0x99, 0x44, 0x24, 0x78, 0x27, 0x64, 0x85, 0x27,
+	0xad, 0xef, 0x93, 0xef, 0x16, 0x1a, 0xa1, 0x53, 0xf2, 0xa9, 0x02, 0xfb, 0xa3, 0xa2, 0xa5, 0x39,
+	0xed, 0x93, 0x74, 0x50, 0xf5, 0x5a, 0xbf, 0xe6, 0xc8, 0x72, 0x81, 0xb3, 0x9c, 0x25, 0x33, 0x29,
+	0x59, 0xca, 0xa4, 0xfe, 0xac, 0x00, 0x84, 0x52, 0x1e, 0x99, 0xe9, 0x0a, 0xa5, 0x43, 0x28, 0x55,
+	0xcf, 0xf7, 0x64, 0xf3, 0x55, 0x65, 0x46, 0xd2, 0x2b, 0xc9, 0xef, 0x15, 0xc8, 0x84, 0x31, 0x18,
+	0xe9, 0x05, 0x11, 0x4b, 0x7f, 0x30, 0x46, 0x28, 0xa7, 0xda, 0x65, 0xce, 0x63, 0x8e, 0x9c, 0x4f,
+	0xc9, 0x43, 0x82, 0xcd, 0xbc, 0x8a, 0xda, 0x17, 0x21, 0x7f, 0x92, 0xee, 0xf7, 0x8b, 0x78, 0x5d,
+	0x55, 0xbd, 0xd2, 0x9f, 0x71, 0x1f, 0xf7, 0x2d, 0x94, 0x66, 0xdb, 0xee, 0x8b, 0xff, 0x50, 0x60,
+	0xbc, 0x5d, 0x21, 0x4d, 0xf1, 0x76, 0x12, 0xa3, 0xdf, 0xaa, 0xf3, 0x7d, 0x58, 0x22, 0x97, 0x15,
+	0xce, 0xe5, 0x06, 0xb9, 0xd6, 0x6f, 0x8d, 0x09, 0x5d, 0xd3, 0x2b, 0xaf, 0xdd, 0x22, 0x08, 0x23,
+	0x85, 0x94, 0x70, 0x82, 0x74, 0x9c, 0x4d, 0x6f, 0x80, 0xb0, 0x5f, 0xe3, 0xb0, 0x17, 0xc9, 0xf5,
+	0x17, 0x83, 0xcd, 0xc8, 0x9f, 0x14, 0xc8, 0x48, 0x22, 0x69, 0x8a, 0x65, 0xd1, 0x29, 0xdc, 0xaa,
+	0xb3, 0xbd, 0x19, 0x21, 0x87, 0x65, 0xce, 0xe1, 0x1a, 0xb9, 0xd2, 0x2f, 0x07, 0x4f, 0xb1, 0x25,
+	0x9f, 0x7b, 0x2f, 0xba, 0x6d, 0x9a, 0x60, 0x9a, 0x17, 0xdd, 0x68, 0x65, 0x57, 0x9d, 0xef, 0xc3,
+	0x12, 0xf9, 0xbc, 0xcd, 0xf9, 0xbc, 0x41, 0x5e, 0xef, 0x97, 0x4f, 0x87, 0x68, 0x4a, 0x3e, 0x52,
+	0x60, 0xa2, 0x3d, 0x1e, 0x23, 0xbd, 0x63, 0x64, 0xe9, 0xef, 0x2b, 0xb1, 0xb2, 0x6e, 0xba, 0xfb,
+	0x97, 0xc4, 0xaf, 0x83, 0x0e, 0x23, 0x1f, 0x2b, 0x30, 0xda, 0x22, 0x4e, 0x92, 0xb9, 0xf4, 0x80,
+	0x24, 0x59, 0x57, 0xbd, 0xd0, 0xab, 0x19, 0x72, 0xf8, 0x3a, 0xe7, 0x70, 0x9b, 0xac, 0x74, 0xe1,
+	0x60, 0x98, 0x5d, 0x73, 0xc4, 0x13, 0xf4, 0x81, 0x02, 0x63, 0x2d, 0x91, 0x18, 0xe9, 0x11, 0x5a,
+	0x90, 0x9a, 0x8b, 0x3d, 0xdb, 0xf5, 0x72, 0x8f, 0x8c, 0xcc, 0x8b, 0x48, 0xca, 0x7b, 0x0a, 0x0c,
+	0x09, 0x69, 0x95, 0xe4, 0xbb, 0x62, 0x68, 0x51, 0x75, 0xd5, 0x42, 0xea, 0xf1, 0x88, 0xf5, 0x14,
+	0xc7, 0xfa, 0x32, 0xd1, 0x92, 0xb0, 0x0a, 0x65, 0x97, 0xfc, 0x45, 0xc1, 0xef, 0xae, 0x1d, 0xc2,
+	0x6d, 0x8a, 0x15, 0x10, 0x27, 0x07, 0xab, 0x0b, 0xfd, 0x98, 0x22, 0xfa, 0x59, 0x8e, 0x3e, 0x4f,
+	0x5e, 0x8d, 0x47, 0x5f, 0x70, 0xb8, 0x75, 0xb9, 0x61, 0xdb, 0x35, 0xa1, 0x8b, 0x7a, 0x5b, 0xec,
+	0x78, 0xbb, 0x84, 0x9b, 0x62, 0x87, 0x8a, 0x91, 0x85, 0xd5, 0xf9, 0x3e, 0x2c, 0x11, 0xff, 0x1c,
+	0xc7, 0x5f, 0x20, 0x67, 0x92, 0x66, 0xdf, 0x27, 0x10, 0x4a, 0xa5, 0xa5, 0x0f, 0x9f, 0xe5, 0x94,
+	0x4f, 0x9e, 0xe5, 0x94, 0x2f, 0x9f, 0xe5, 0x94, 0x9f, 0x3d, 0xcf, 0x0d, 0x7c, 0xf2, 0x3c, 0x37,
+	0xf0, 0xcf, 0xe7, 0xb9, 0x81, 0x6f, 0x5f, 0x92, 0x94, 0x5c, 0xd3, 0xaa, 0x34, 0xd7, 0x9a, 0xec,
+	0x8c, 0x45, 0xdd, 0x4d, 0xdb, 0x79, 0x50, 0x58, 0xd7, 0xad, 0xf5, 0xa6, 0xb3, 0xc5, 0x35, 0xdd,
+	0x47, 0x33, 0x85, 0xc7, 0x7e, 0x1c, 0xae, 0xef, 0xae, 0x0d, 0xf1, 0x7f, 0xbc, 0x3d, 0xff, 0xff,
+	0x01, 0x00, 0xd5, 0x7e, 0xd5, 0xc8, 0x82, 0x2c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRates returns exchange rates of all denoms.
+	AllExchangeRates(ctx context.Context, in *QueryAllExchangeRatesRequest, opts ...grpc.CallOption) (*QueryAllExchangeRatesResponse, error)
+	// ExchangeRate returns exchange rates of a specified denom.
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// HistoricExchangeRates returns the tallied exchange rates of a denom within
+	// a block height range.
+	HistoricExchangeRates(ctx context.Context, in *QueryHistoricExchangeRatesRequest, opts ...grpc.CallOption) (*QueryHistoricExchangeRatesResponse, error)
+	// TallyResults returns the statistics of the tallies of a denom within a
+	// vote period range.
+	TallyResults(ctx context.Context, in *QueryTallyResultsRequest, opts ...grpc.CallOption) (*QueryTallyResultsResponse, error)
+	// LatestTallyResults returns the statistics of the latest tally of every
+	// denom.
+	LatestTallyResults(ctx context.Context, in *QueryLatestTallyResultsRequest, opts ...grpc.CallOption) (*QueryLatestTallyResultsResponse, error)
+	// RemotePrice returns the price of a denom last read from its remote DEX
+	// pool.
+	RemotePrice(ctx context.Context, in *QueryRemotePriceRequest, opts ...grpc.CallOption) (*QueryRemotePriceResponse, error)
+	// TWAP returns the time-weighted average exchange rate of a denom over a
+	// window ending at the current block.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// ExchangeRateMetadata returns the exchange rate of a denom together with
+	// the metadata of its last successful tally, even if the rate expired.
+	ExchangeRateMetadata(ctx context.Context, in *QueryExchangeRateMetadataRequest, opts ...grpc.CallOption) (*QueryExchangeRateMetadataResponse, error)
+	// AllExchangeRateMetadata returns the metadata of the exchange rates of all
+	// denoms.
+	AllExchangeRateMetadata(ctx context.Context, in *QueryAllExchangeRateMetadataRequest, opts ...grpc.CallOption) (*QueryAllExchangeRateMetadataResponse, error)
+	// CrossExchangeRate returns the exchange rate of a pair of denoms derived
+	// from their USD exchange rates.
+	CrossExchangeRate(ctx context.Context, in *QueryCrossExchangeRateRequest, opts ...grpc.CallOption) (*QueryCrossExchangeRateResponse, error)
+	// HaltedDenoms returns the denoms halted by the circuit breaker.
+	HaltedDenoms(ctx context.Context, in *QueryHaltedDenomsRequest, opts ...grpc.CallOption) (*QueryHaltedDenomsResponse, error)
+	// ValidatorPerformance returns the vote statistics of a validator over the
+	// kept slash windows.
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// ValidatorPerformances returns the vote statistics of all validators,
+	// ranked by uptime.
+	ValidatorPerformances(ctx context.Context, in *QueryValidatorPerformancesRequest, opts ...grpc.CallOption) (*QueryValidatorPerformancesResponse, error)
+	// OracleJail returns the oracle jail record of a validator.
+	OracleJail(ctx context.Context, in *QueryOracleJailRequest, opts ...grpc.CallOption) (*QueryOracleJailResponse, error)
+	// OracleJails returns the oracle jail records of all validators.
+	OracleJails(ctx context.Context, in *QueryOracleJailsRequest, opts ...grpc.CallOption) (*QueryOracleJailsResponse, error)
+	// ActiveExchangeRates returns all active denoms
+	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRatesRequest, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// Feeders returns the feeder delegation and the registered feeders of a
+	// validator
+	Feeders(ctx context.Context, in *QueryFeedersRequest, opts ...grpc.CallOption) (*QueryFeedersResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryRewardPoolBalance queries funds left in the reward pool.
+	QueryRewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error)
+	// RewardProjection returns the projected reward payout per vote period and
+	// the remaining runway of the reward schedules.
+	RewardProjection(ctx context.Context, in *QueryRewardProjectionRequest, opts ...grpc.CallOption) (*QueryRewardProjectionResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AllExchangeRates(ctx context.Context, in *QueryAllExchangeRatesRequest, opts ...grpc.CallOption) (*QueryAllExchangeRatesResponse, error) {
+	out := new(QueryAllExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AllExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/ExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricExchangeRates(ctx context.Context, in *QueryHistoricExchangeRatesRequest, opts ...grpc.CallOption) (*QueryHistoricExchangeRatesResponse, error) {
+	out := new(QueryHistoricExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/HistoricExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyResults(ctx context.Context, in *QueryTallyResultsRequest, opts ...grpc.CallOption) (*QueryTallyResultsResponse, error) {
+	out := new(QueryTallyResultsResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/TallyResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestTallyResults(ctx context.Context, in *QueryLatestTallyResultsRequest, opts ...grpc.CallOption) (*QueryLatestTallyResultsResponse, error) {
+	out := new(QueryLatestTallyResultsResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/LatestTallyResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemotePrice(ctx context.Context, in *QueryRemotePriceRequest, opts ...grpc.CallOption) (*QueryRemotePriceResponse, error) {
+	out := new(QueryRemotePriceResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/RemotePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRateMetadata(ctx context.Context, in *QueryExchangeRateMetadataRequest, opts ...grpc.CallOption) (*QueryExchangeRateMetadataResponse, error) {
+	out := new(QueryExchangeRateMetadataResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/ExchangeRateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllExchangeRateMetadata(ctx context.Context, in *QueryAllExchangeRateMetadataRequest, opts ...grpc.CallOption) (*QueryAllExchangeRateMetadataResponse, error) {
+	out := new(QueryAllExchangeRateMetadataResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AllExchangeRateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CrossExchangeRate(ctx context.Context, in *QueryCrossExchangeRateRequest, opts ...grpc.CallOption) (*QueryCrossExchangeRateResponse, error) {
+	out := new(QueryCrossExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/CrossExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HaltedDenoms(ctx context.Context, in *QueryHaltedDenomsRequest, opts ...grpc.CallOption) (*QueryHaltedDenomsResponse, error) {
+	out := new(QueryHaltedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/HaltedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPerformances(ctx context.Context, in *QueryValidatorPerformancesRequest, opts ...grpc.CallOption) (*QueryValidatorPerformancesResponse, error) {
+	out := new(QueryValidatorPerformancesResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/ValidatorPerformances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleJail(ctx context.Context, in *QueryOracleJailRequest, opts ...grpc.CallOption) (*QueryOracleJailResponse, error) {
+	out := new(QueryOracleJailResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/OracleJail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleJails(ctx context.Context, in *QueryOracleJailsRequest, opts ...grpc.CallOption) (*QueryOracleJailsResponse, error) {
+	out := new(QueryOracleJailsResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/OracleJails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRatesRequest, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error) {
+	out := new(QueryActiveExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/ActiveExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Feeders(ctx context.Context, in *QueryFeedersRequest, opts ...grpc.CallOption) (*QueryFeedersResponse, error) {
+	out := new(QueryFeedersResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/Feeders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
+	out := new(QueryMissCounterResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/MissCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AggregatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
+	out := new(QueryAggregatePrevotesResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AggregatePrevotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
+	out := new(QueryAggregateVoteResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AggregateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
+	out := new(QueryAggregateVotesResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/AggregateVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error) {
+	out := new(QueryRewardPoolBalanceResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/QueryRewardPoolBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardProjection(ctx context.Context, in *QueryRewardProjectionRequest, opts ...grpc.CallOption) (*QueryRewardProjectionResponse, error) {
+	out := new(QueryRewardProjectionResponse)
+	err := c.cc.Invoke(ctx, "/persistence.oracle.v1beta1.Query/RewardProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRates returns exchange rates of all denoms.
+	AllExchangeRates(context.Context, *QueryAllExchangeRatesRequest) (*QueryAllExchangeRatesResponse, error)
+	// ExchangeRate returns exchange rates of a specified denom.
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// HistoricExchangeRates returns the tallied exchange rates of a denom within
+	// a block height range.
+	HistoricExchangeRates(context.Context, *QueryHistoricExchangeRatesRequest) (*QueryHistoricExchangeRatesResponse, error)
+	// TallyResults returns the statistics of the tallies of a denom within a
+	// vote period range.
+	TallyResults(context.Context, *QueryTallyResultsRequest) (*QueryTallyResultsResponse, error)
+	// LatestTallyResults returns the statistics of the latest tally of every
+	// denom.
+	LatestTallyResults(context.Context, *QueryLatestTallyResultsRequest) (*QueryLatestTallyResultsResponse, error)
+	// RemotePrice returns the price of a denom last read from its remote DEX
+	// pool.
+	RemotePrice(context.Context, *QueryRemotePriceRequest) (*QueryRemotePriceResponse, error)
+	// TWAP returns the time-weighted average exchange rate of a denom over a
+	// window ending at the current block.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// ExchangeRateMetadata returns the exchange rate of a denom together with
+	// the metadata of its last successful tally, even if the rate expired.
+	ExchangeRateMetadata(context.Context, *QueryExchangeRateMetadataRequest) (*QueryExchangeRateMetadataResponse, error)
+	// AllExchangeRateMetadata returns the metadata of the exchange rates of all
+	// denoms.
+	AllExchangeRateMetadata(context.Context, *QueryAllExchangeRateMetadataRequest) (*QueryAllExchangeRateMetadataResponse, error)
+	// CrossExchangeRate returns the exchange rate of a pair of denoms derived
+	// from their USD exchange rates.
+	CrossExchangeRate(context.Context, *QueryCrossExchangeRateRequest) (*QueryCrossExchangeRateResponse, error)
+	// HaltedDenoms returns the denoms halted by the circuit breaker.
+	HaltedDenoms(context.Context, *QueryHaltedDenomsRequest) (*QueryHaltedDenomsResponse, error)
+	// ValidatorPerformance returns the vote statistics of a validator over the
+	// kept slash windows.
+	ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error)
+	// ValidatorPerformances returns the vote statistics of all validators,
+	// ranked by uptime.
+	ValidatorPerformances(context.Context, *QueryValidatorPerformancesRequest) (*QueryValidatorPerformancesResponse, error)
+	// OracleJail returns the oracle jail record of a validator.
+	OracleJail(context.Context, *QueryOracleJailRequest) (*QueryOracleJailResponse, error)
+	// OracleJails returns the oracle jail records of all validators.
+	OracleJails(context.Context, *QueryOracleJailsRequest) (*QueryOracleJailsResponse, error)
+	// ActiveExchangeRates returns all active denoms
+	ActiveExchangeRates(context.Context, *QueryActiveExchangeRatesRequest) (*QueryActiveExchangeRatesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
+	// Feeders returns the feeder delegation and the registered feeders of a
+	// validator
+	Feeders(context.Context, *QueryFeedersRequest) (*QueryFeedersResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryRewardPoolBalance queries funds left in the reward pool.
+	QueryRewardPoolBalance(context.Context, *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error)
+	// RewardProjection returns the projected reward payout per vote period and
+	// the remaining runway of the reward schedules.
+	RewardProjection(context.Context, *QueryRewardProjectionRequest) (*QueryRewardProjectionResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AllExchangeRates(ctx context.Context, req *QueryAllExchangeRatesRequest) (*QueryAllExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) HistoricExchangeRates(ctx context.Context, req *QueryHistoricExchangeRatesRequest) (*QueryHistoricExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) TallyResults(ctx context.Context, req *QueryTallyResultsRequest) (*QueryTallyResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyResults not implemented")
+}
+func (*UnimplementedQueryServer) LatestTallyResults(ctx context.Context, req *QueryLatestTallyResultsRequest) (*QueryLatestTallyResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestTallyResults not implemented")
+}
+func (*UnimplementedQueryServer) RemotePrice(ctx context.Context, req *QueryRemotePriceRequest) (*QueryRemotePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemotePrice not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRateMetadata(ctx context.Context, req *QueryExchangeRateMetadataRequest) (*QueryExchangeRateMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateMetadata not implemented")
+}
+func (*UnimplementedQueryServer) AllExchangeRateMetadata(ctx context.Context, req *QueryAllExchangeRateMetadataRequest) (*QueryAllExchangeRateMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllExchangeRateMetadata not implemented")
+}
+func (*UnimplementedQueryServer) CrossExchangeRate(ctx context.Context, req *QueryCrossExchangeRateRequest) (*QueryCrossExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) HaltedDenoms(ctx context.Context, req *QueryHaltedDenomsRequest) (*QueryHaltedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPerformance(ctx context.Context, req *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPerformances(ctx context.Context, req *QueryValidatorPerformancesRequest) (*QueryValidatorPerformancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformances not implemented")
+}
+func (*UnimplementedQueryServer) OracleJail(ctx context.Context, req *QueryOracleJailRequest) (*QueryOracleJailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleJail not implemented")
+}
+func (*UnimplementedQueryServer) OracleJails(ctx context.Context, req *QueryOracleJailsRequest) (*QueryOracleJailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleJails not implemented")
+}
+func (*UnimplementedQueryServer) ActiveExchangeRates(ctx context.Context, req *QueryActiveExchangeRatesRequest) (*QueryActiveExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+}
+func (*UnimplementedQueryServer) Feeders(ctx context.Context, req *QueryFeedersRequest) (*QueryFeedersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feeders not implemented")
+}
+func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) QueryRewardPoolBalance(ctx context.Context, req *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRewardPoolBalance not implemented")
+}
+func (*UnimplementedQueryServer) RewardProjection(ctx context.Context, req *QueryRewardProjectionRequest) (*QueryRewardProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardProjection not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AllExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/AllExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllExchangeRates(ctx, req.(*QueryAllExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/ExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/HistoricExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricExchangeRates(ctx, req.(*QueryHistoricExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/TallyResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyResults(ctx, req.(*QueryTallyResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestTallyResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestTallyResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestTallyResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/LatestTallyResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestTallyResults(ctx, req.(*QueryLatestTallyResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemotePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/RemotePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemotePrice(ctx, req.(*QueryRemotePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/ExchangeRateMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateMetadata(ctx, req.(*QueryExchangeRateMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllExchangeRateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllExchangeRateMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllExchangeRateMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/AllExchangeRateMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllExchangeRateMetadata(ctx, req.(*QueryAllExchangeRateMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CrossExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/CrossExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossExchangeRate(ctx, req.(*QueryCrossExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HaltedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHaltedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HaltedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/HaltedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HaltedDenoms(ctx, req.(*QueryHaltedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/ValidatorPerformances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformances(ctx, req.(*QueryValidatorPerformancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleJail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleJailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleJail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/OracleJail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleJail(ctx, req.(*QueryOracleJailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleJails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleJailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleJails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/OracleJails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleJails(ctx, req.(*QueryOracleJailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/ActiveExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveExchangeRates(ctx, req.(*QueryActiveExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeederDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/FeederDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Feeders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeedersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Feeders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/Feeders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Feeders(ctx, req.(*QueryFeedersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/MissCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/AggregatePrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/AggregateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/AggregateVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRewardPoolBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRewardPoolBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/QueryRewardPoolBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRewardPoolBalance(ctx, req.(*QueryRewardPoolBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/persistence.oracle.v1beta1.Query/RewardProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardProjection(ctx, req.(*QueryRewardProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "persistence.oracle.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllExchangeRates",
+			Handler:    _Query_AllExchangeRates_Handler,
+		},
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "HistoricExchangeRates",
+			Handler:    _Query_HistoricExchangeRates_Handler,
+		},
+		{
+			MethodName: "TallyResults",
+			Handler:    _Query_TallyResults_Handler,
+		},
+		{
+			MethodName: "LatestTallyResults",
+			Handler:    _Query_LatestTallyResults_Handler,
+		},
+		{
+			MethodName: "RemotePrice",
+			Handler:    _Query_RemotePrice_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "ExchangeRateMetadata",
+			Handler:    _Query_ExchangeRateMetadata_Handler,
+		},
+		{
+			MethodName: "AllExchangeRateMetadata",
+			Handler:    _Query_AllExchangeRateMetadata_Handler,
+		},
+		{
+			MethodName: "CrossExchangeRate",
+			Handler:    _Query_CrossExchangeRate_Handler,
+		},
+		{
+			MethodName: "HaltedDenoms",
+			Handler:    _Query_HaltedDenoms_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformances",
+			Handler:    _Query_ValidatorPerformances_Handler,
+		},
+		{
+			MethodName: "OracleJail",
+			Handler:    _Query_OracleJail_Handler,
+		},
+		{
+			MethodName: "OracleJails",
+			Handler:    _Query_OracleJails_Handler,
+		},
+		{
+			MethodName: "ActiveExchangeRates",
+			Handler:    _Query_ActiveExchangeRates_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "Feeders",
+			Handler:    _Query_Feeders_Handler,
+		},
+		{
+			MethodName: "MissCounter",
+			Handler:    _Query_MissCounter_Handler,
+		},
+		{
+			MethodName: "AggregatePrevote",
+			Handler:    _Query_AggregatePrevote_Handler,
+		},
+		{
+			MethodName: "AggregatePrevotes",
+			Handler:    _Query_AggregatePrevotes_Handler,
+		},
+		{
+			MethodName: "AggregateVote",
+			Handler:    _Query_AggregateVote_Handler,
+		},
+		{
+			MethodName: "AggregateVotes",
+			Handler:    _Query_AggregateVotes_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryRewardPoolBalance",
+			Handler:    _Query_QueryRewardPoolBalance_Handler,
+		},
+		{
+			MethodName: "RewardProjection",
+			Handler:    _Query_RewardProjection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "persistence/oracle/v1beta1/query.proto",
+}
+
+func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRate) > 0 {
+		i -= len(m.ExchangeRate)
+		copy(dAtA[i:], m.ExchangeRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExchangeRate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllExchangeRateMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllExchangeRateMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllExchangeRateMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllExchangeRateMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllExchangeRateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllExchangeRateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRateMetadata) > 0 {
+		for iNdEx := len(m.ExchangeRateMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHaltedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHaltedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHaltedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHaltedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHaltedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHaltedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HaltedDenoms) > 0 {
+		for iNdEx := len(m.HaltedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HaltedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HistoricExchangeRates) > 0 {
+		for iNdEx := len(m.HistoricExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TallyResults) > 0 {
+		for iNdEx := len(m.TallyResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestTallyResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestTallyResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestTallyResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestTallyResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestTallyResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestTallyResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TallyResults) > 0 {
+		for iNdEx := len(m.TallyResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemotePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemotePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemotePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemotePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemotePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemotePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemotePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Abstained != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Abstained))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Missed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Won != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Won))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Voted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Voted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Windows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Windows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Summaries) > 0 {
+		for iNdEx := len(m.Summaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Summaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleJailRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleJailRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleJailRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleJailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleJailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleJailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OracleJail.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleJailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleJailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleJailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleJailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleJailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleJailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleJails) > 0 {
+		for iNdEx := len(m.OracleJails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleJails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActiveRates) > 0 {
+		for iNdEx := len(m.ActiveRates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActiveRates[iNdEx])
+			copy(dAtA[i:], m.ActiveRates[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ActiveRates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeedersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeedersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeders) > 0 {
+		for iNdEx := len(m.Feeders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingFunds) > 0 {
+		for iNdEx := len(m.RemainingFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UnscheduledFunds) > 0 {
+		for iNdEx := len(m.UnscheduledFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnscheduledFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PerPeriodPayout) > 0 {
+		for iNdEx := len(m.PerPeriodPayout) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerPeriodPayout[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardScheduleProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardScheduleProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardScheduleProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RunwayEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RunwayEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RemainingPeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingPeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExchangeRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllExchangeRateMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllExchangeRateMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateMetadata) > 0 {
+		for _, e := range m.ExchangeRateMetadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHaltedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHaltedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HaltedDenoms) > 0 {
+		for _, e := range m.HaltedDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHistoricExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HistoricExchangeRates) > 0 {
+		for _, e := range m.HistoricExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTallyResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.StartPeriod))
+	}
+	if m.EndPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.EndPeriod))
+	}
+	return n
+}
+
+func (m *QueryTallyResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TallyResults) > 0 {
+		for _, e := range m.TallyResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLatestTallyResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestTallyResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TallyResults) > 0 {
+		for _, e := range m.TallyResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRemotePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemotePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RemotePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCrossExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCrossExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformanceSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Windows != 0 {
+		n += 1 + sovQuery(uint64(m.Windows))
+	}
+	if m.Voted != 0 {
+		n += 1 + sovQuery(uint64(m.Voted))
+	}
+	if m.Won != 0 {
+		n += 1 + sovQuery(uint64(m.Won))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovQuery(uint64(m.Missed))
+	}
+	if m.Abstained != 0 {
+		n += 1 + sovQuery(uint64(m.Abstained))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Summary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValidatorPerformancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Summaries) > 0 {
+		for _, e := range m.Summaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleJailRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleJailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OracleJail.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOracleJailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOracleJailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleJails) > 0 {
+		for _, e := range m.OracleJails {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActiveExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveRates) > 0 {
+		for _, s := range m.ActiveRates {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeedersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeedersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Feeders) > 0 {
+		for _, e := range m.Feeders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMissCounterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for _, e := range m.AggregateVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardPoolBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.RemainingFunds) > 0 {
@@ -2465,16 +6272,2991 @@ func (m *QueryRewardPoolBalanceResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryRewardProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PerPeriodPayout) > 0 {
+		for _, e := range m.PerPeriodPayout {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnscheduledFunds) > 0 {
+		for _, e := range m.UnscheduledFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardScheduleProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingPeriods != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingPeriods))
+	}
+	if m.RunwayEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RunwayEndHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllExchangeRateMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllExchangeRateMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllExchangeRateMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllExchangeRateMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllExchangeRateMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllExchangeRateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateMetadata = append(m.ExchangeRateMetadata, ExchangeRateMetadata{})
+			if err := m.ExchangeRateMetadata[len(m.ExchangeRateMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHaltedDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHaltedDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHaltedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHaltedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHaltedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHaltedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedDenoms = append(m.HaltedDenoms, HaltedDenom{})
+			if err := m.HaltedDenoms[len(m.HaltedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricExchangeRates = append(m.HistoricExchangeRates, HistoricExchangeRate{})
+			if err := m.HistoricExchangeRates[len(m.HistoricExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPeriod", wireType)
+			}
+			m.StartPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPeriod", wireType)
+			}
+			m.EndPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyResults = append(m.TallyResults, TallyResult{})
+			if err := m.TallyResults[len(m.TallyResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestTallyResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestTallyResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestTallyResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestTallyResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestTallyResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestTallyResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyResults = append(m.TallyResults, TallyResult{})
+			if err := m.TallyResults[len(m.TallyResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemotePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemotePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemotePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemotePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemotePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemotePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemotePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			m.Windows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Windows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			m.Voted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Won", wireType)
+			}
+			m.Won = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Won |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstained", wireType)
+			}
+			m.Abstained = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstained |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, ValidatorPerformance{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorPerformancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPerformancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPerformancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorPerformancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPerformancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPerformancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summaries = append(m.Summaries, ValidatorPerformanceSummary{})
+			if err := m.Summaries[len(m.Summaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleJailRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleJailRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleJailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleJailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleJailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleJailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleJail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleJail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleJailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleJailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleJailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleJailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleJailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleJailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleJails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleJails = append(m.OracleJails, OracleJail{})
+			if err := m.OracleJails[len(m.OracleJails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2497,15 +9279,15 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2533,7 +9315,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2556,7 +9338,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2579,15 +9361,15 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2615,7 +9397,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRate = string(dAtA[iNdEx:postIndex])
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2638,7 +9420,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeedersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2661,12 +9443,44 @@ func (m *QueryAllExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeedersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeedersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2688,7 +9502,7 @@ func (m *QueryAllExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeedersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2711,15 +9525,47 @@ func (m *QueryAllExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeedersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeedersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2746,8 +9592,8 @@ func (m *QueryAllExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Feeders = append(m.Feeders, Feeder{})
+			if err := m.Feeders[len(m.Feeders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2772,7 +9618,7 @@ func (m *QueryAllExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2795,12 +9641,44 @@ func (m *QueryActiveExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2822,7 +9700,7 @@ func (m *QueryActiveExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2845,17 +9723,17 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
 			}
-			var stringLen uint64
+			m.MissCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2865,24 +9743,11 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2904,7 +9769,7 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2927,10 +9792,10 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2986,7 +9851,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3009,17 +9874,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3029,23 +9894,24 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3068,7 +9934,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3091,44 +9957,12 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3150,7 +9984,7 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3173,17 +10007,17 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
 			}
-			m.MissCounter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3193,11 +10027,26 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3219,7 +10068,7 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3242,10 +10091,10 @@ func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3301,7 +10150,7 @@ func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3324,15 +10173,15 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3359,7 +10208,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3384,7 +10233,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3407,10 +10256,10 @@ func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3434,7 +10283,7 @@ func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3457,15 +10306,15 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3492,8 +10341,8 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3518,7 +10367,7 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3541,44 +10390,12 @@ func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3600,7 +10417,7 @@ func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3623,15 +10440,15 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3658,7 +10475,7 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3683,7 +10500,7 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3706,10 +10523,10 @@ func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3733,7 +10550,7 @@ func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3756,15 +10573,15 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingFunds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3791,8 +10608,8 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemainingFunds = append(m.RemainingFunds, types.Coin{})
+			if err := m.RemainingFunds[len(m.RemainingFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3817,7 +10634,7 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardProjectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3840,10 +10657,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardProjectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3867,7 +10684,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3890,15 +10707,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerPeriodPayout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3925,60 +10742,79 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PerPeriodPayout = append(m.PerPeriodPayout, types.Coin{})
+			if err := m.PerPeriodPayout[len(m.PerPeriodPayout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnscheduledFunds", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.UnscheduledFunds = append(m.UnscheduledFunds, types.Coin{})
+			if err := m.UnscheduledFunds[len(m.UnscheduledFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Schedules = append(m.Schedules, RewardScheduleProjection{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4000,7 +10836,7 @@ func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *RewardScheduleProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4023,15 +10859,15 @@ func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardScheduleProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardScheduleProjection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4058,11 +10894,48 @@ func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemainingFunds = append(m.RemainingFunds, types.Coin{})
-			if err := m.RemainingFunds[len(m.RemainingFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPeriods", wireType)
+			}
+			m.RemainingPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwayEndHeight", wireType)
+			}
+			m.RunwayEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunwayEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Policies of a remote price source
+const (
+	// RemotePricePolicyFallback sets the remote price as exchange rate of the
+	// denom if its ballot fails to reach quorum.
+	RemotePricePolicyFallback = "fallback"
+	// RemotePricePolicySanityBound rejects a tallied exchange rate deviating
+	// from the remote price by more than the maximum deviation of the source.
+	RemotePricePolicySanityBound = "sanity_bound"
+)
+
+const (
+	// RemotePriceCallbackID is the id of the interchain query callback
+	// receiving the reserves of remote DEX pools.
+	RemotePriceCallbackID = "remoteprice"
+	// RemotePriceQueryType is the type of the interchain queries reading a
+	// reserve of a remote DEX pool, i.e. the proven balance of its account in
+	// a denom.
+	RemotePriceQueryType = "store/bank/key"
+
+	// MaxRemoteExponent is the maximum exponent of a remote reserve denom.
+	MaxRemoteExponent = 18
+)
+
+// String implement stringify
+func (s RemotePriceSource) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// Equal checks whether two remote price sources are both unset or equal.
+func (s *RemotePriceSource) Equal(s1 *RemotePriceSource) bool {
+	if s == nil || s1 == nil {
+		return s == s1
+	}
+
+	return s.ConnectionId == s1.ConnectionId &&
+		s.ChainId == s1.ChainId &&
+		s.PoolAddress == s1.PoolAddress &&
+		s.BaseDenom == s1.BaseDenom &&
+		s.QuoteDenom == s1.QuoteDenom &&
+		s.BaseExponent == s1.BaseExponent &&
+		s.QuoteExponent == s1.QuoteExponent &&
+		s.Policy == s1.Policy &&
+		s.QueryPeriod == s1.QueryPeriod &&
+		s.MaxAge == s1.MaxAge &&
+		decPtrEqual(s.MaxDeviation, s1.MaxDeviation) &&
+		s.QuoteSymbolDenom == s1.QuoteSymbolDenom &&
+		s.QueryTtl == s1.QueryTtl
+}
+
+// Validate performs a basic validation of the remote price source fields.
+func (s RemotePriceSource) Validate() error {
+	if len(s.ConnectionId) == 0 {
+		return fmt.Errorf("remote price source must have ConnectionId")
+	}
+
+	if len(s.ChainId) == 0 {
+		return fmt.Errorf("remote price source must have ChainId")
+	}
+
+	if _, _, err := bech32.DecodeAndConvert(s.PoolAddress); err != nil {
+		return fmt.Errorf("remote price source has invalid PoolAddress: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(s.BaseDenom); err != nil {
+		return fmt.Errorf("remote price source has invalid BaseDenom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(s.QuoteDenom); err != nil {
+		return fmt.Errorf("remote price source has invalid QuoteDenom: %w", err)
+	}
+
+	if s.BaseDenom == s.QuoteDenom {
+		return fmt.Errorf("remote price source BaseDenom and QuoteDenom must differ: %s", s.BaseDenom)
+	}
+
+	if s.BaseExponent > MaxRemoteExponent || s.QuoteExponent > MaxRemoteExponent {
+		return fmt.Errorf("remote price source exponents must not exceed %d", MaxRemoteExponent)
+	}
+
+	if s.QueryPeriod == 0 {
+		return fmt.Errorf("remote price source QueryPeriod must be positive")
+	}
+
+	if s.MaxAge == 0 {
+		return fmt.Errorf("remote price source MaxAge must be positive")
+	}
+
+	if s.QueryTtl == 0 {
+		return fmt.Errorf("remote price source QueryTtl must be positive")
+	}
+
+	if len(s.QuoteSymbolDenom) == 0 {
+		return fmt.Errorf("remote price source must have QuoteSymbolDenom")
+	}
+
+	switch s.Policy {
+	case RemotePricePolicyFallback:
+	case RemotePricePolicySanityBound:
+		if s.MaxDeviation == nil || !s.MaxDeviation.IsPositive() {
+			return fmt.Errorf("remote price source with policy %s must have a positive MaxDeviation", s.Policy)
+		}
+	default:
+		return fmt.Errorf("remote price source has unknown Policy: %s", s.Policy)
+	}
+
+	return nil
+}
+
+// ReserveRequest returns the request of the interchain query reading the
+// reserve of the pool in a remote denom, i.e. the key of the balance of the
+// pool in the remote bank store.
+func (s RemotePriceSource) ReserveRequest(denom string) []byte {
+	_, addr, err := bech32.DecodeAndConvert(s.PoolAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return append(banktypes.CreateAccountBalancesPrefix(addr), []byte(denom)...)
+}
+
+// UnmarshalReserve decodes a reserve read from the remote bank store, which
+// holds the amount of the balance or, before v0.46, the whole coin. An empty
+// value is a proven absent balance.
+func UnmarshalReserve(bz []byte) (sdk.Int, error) {
+	if len(bz) == 0 {
+		return sdk.ZeroInt(), nil
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err == nil {
+		return amount, nil
+	}
+
+	var coin sdk.Coin
+	if err := coin.Unmarshal(bz); err != nil {
+		return sdk.ZeroInt(), fmt.Errorf("failed to decode reserve: %w", err)
+	}
+
+	return coin.Amount, nil
+}
+
+// PriceFromReserves returns the price of the base denom quoted in the quote
+// denom implied by the reserves of the pool, adjusted to their exponents.
+func (s RemotePriceSource) PriceFromReserves(base, quote sdk.Int) (sdk.Dec, error) {
+	if base.IsNil() || quote.IsNil() || !base.IsPositive() || !quote.IsPositive() {
+		return sdk.ZeroDec(), fmt.Errorf("pool %s has no reserves of %s and %s", s.PoolAddress, s.BaseDenom, s.QuoteDenom)
+	}
+
+	price := sdk.NewDecFromInt(quote).QuoInt(base)
+
+	if s.BaseExponent > s.QuoteExponent {
+		price = price.Mul(sdk.NewDec(10).Power(uint64(s.BaseExponent - s.QuoteExponent)))
+	} else {
+		price = price.Quo(sdk.NewDec(10).Power(uint64(s.QuoteExponent - s.BaseExponent)))
+	}
+
+	if !price.IsPositive() {
+		return sdk.ZeroDec(), fmt.Errorf("pool %s implies a price of zero", s.PoolAddress)
+	}
+
+	return price, nil
+}
+
+// NewRemotePrice creates a RemotePrice instance
+func NewRemotePrice(denom string, price sdk.Dec, remoteHeight, localHeight uint64) RemotePrice {
+	return RemotePrice{
+		Denom:        denom,
+		Price:        price,
+		RemoteHeight: remoteHeight,
+		LocalHeight:  localHeight,
+	}
+}
+
+// String implement stringify
+func (p RemotePrice) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// IsFresh returns true if the price was received at most maxAge blocks before
+// the given height.
+func (p RemotePrice) IsFresh(height, maxAge uint64) bool {
+	return height <= p.LocalHeight+maxAge
+}
@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+
+	"github.com/incubus-network/fanfury-sdk/v2/x/oracle/types"
+)
+
+func newRemotePriceSource(t *testing.T) types.RemotePriceSource {
+	poolAddress, err := bech32.ConvertAndEncode("osmo", []byte("pool________________"))
+	require.NoError(t, err)
+
+	return types.RemotePriceSource{
+		ConnectionId:     "connection-0",
+		ChainId:          "osmosis-1",
+		PoolAddress:      poolAddress,
+		BaseDenom:        "ibc/atom",
+		QuoteDenom:       "uusdc",
+		BaseExponent:     6,
+		QuoteExponent:    6,
+		Policy:           types.RemotePricePolicyFallback,
+		QueryPeriod:      10,
+		MaxAge:           100,
+		QuoteSymbolDenom: types.USDSymbol,
+		QueryTtl:         20,
+	}
+}
+
+func TestRemotePriceSourceValidate(t *testing.T) {
+	maxDeviation := sdk.NewDecWithPrec(1, 1)
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.RemotePriceSource)
+		expErr   string
+	}{
+		{"valid", func(*types.RemotePriceSource) {}, ""},
+		{"no connection", func(s *types.RemotePriceSource) { s.ConnectionId = "" }, "must have ConnectionId"},
+		{"invalid pool address", func(s *types.RemotePriceSource) { s.PoolAddress = "pool" }, "invalid PoolAddress"},
+		{"invalid denom", func(s *types.RemotePriceSource) { s.QuoteDenom = "" }, "invalid QuoteDenom"},
+		{"same denoms", func(s *types.RemotePriceSource) { s.QuoteDenom = s.BaseDenom }, "must differ"},
+		{"exponent too large", func(s *types.RemotePriceSource) { s.BaseExponent = 19 }, "must not exceed"},
+		{"zero query period", func(s *types.RemotePriceSource) { s.QueryPeriod = 0 }, "QueryPeriod must be positive"},
+		{"zero max age", func(s *types.RemotePriceSource) { s.MaxAge = 0 }, "MaxAge must be positive"},
+		{"zero query ttl", func(s *types.RemotePriceSource) { s.QueryTtl = 0 }, "QueryTtl must be positive"},
+		{"no quote symbol", func(s *types.RemotePriceSource) { s.QuoteSymbolDenom = "" }, "must have QuoteSymbolDenom"},
+		{"unknown policy", func(s *types.RemotePriceSource) { s.Policy = "median" }, "unknown Policy"},
+		{
+			"sanity bound without max deviation",
+			func(s *types.RemotePriceSource) { s.Policy = types.RemotePricePolicySanityBound },
+			"must have a positive MaxDeviation",
+		},
+		{
+			"sanity bound",
+			func(s *types.RemotePriceSource) {
+				s.Policy = types.RemotePricePolicySanityBound
+				s.MaxDeviation = &maxDeviation
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			source := newRemotePriceSource(t)
+			tc.malleate(&source)
+
+			err := source.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestRemotePriceSourcePriceFromReserves(t *testing.T) {
+	source := newRemotePriceSource(t)
+
+	price, err := source.PriceFromReserves(sdk.NewInt(2_000_000), sdk.NewInt(25_000_000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), price)
+
+	// the reserves are adjusted to the exponents of their denoms
+	source.QuoteExponent = 18
+
+	price, err = source.PriceFromReserves(sdk.NewInt(2_000_000), sdk.NewDec(10).Power(18).MulInt64(25).TruncateInt())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), price)
+
+	_, err = source.PriceFromReserves(sdk.ZeroInt(), sdk.NewInt(25_000_000))
+	require.Error(t, err)
+}
+
+func TestUnmarshalReserve(t *testing.T) {
+	amount, err := sdk.NewInt(25_000_000).Marshal()
+	require.NoError(t, err)
+
+	reserve, err := types.UnmarshalReserve(amount)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(25_000_000), reserve)
+
+	// the balances of older remote chains are stored as coins
+	coin := sdk.NewInt64Coin("uusdc", 25_000_000)
+	bz, err := coin.Marshal()
+	require.NoError(t, err)
+
+	reserve, err = types.UnmarshalReserve(bz)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(25_000_000), reserve)
+
+	// an absent balance is an empty reserve
+	reserve, err = types.UnmarshalReserve(nil)
+	require.NoError(t, err)
+	require.True(t, reserve.IsZero())
+}
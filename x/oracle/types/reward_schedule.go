@@ -0,0 +1,132 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// NewRewardSchedulePlan creates a RewardSchedulePlan instance
+func NewRewardSchedulePlan(startHeight, endHeight uint64, amountPerPeriod sdk.Coins) RewardSchedulePlan {
+	return RewardSchedulePlan{
+		StartHeight:     startHeight,
+		EndHeight:       endHeight,
+		AmountPerPeriod: amountPerPeriod,
+	}
+}
+
+// ValidateBasic performs a stateless validation of the plan and the funds
+// reserved for it.
+func (p RewardSchedulePlan) ValidateBasic(funds sdk.Coins) error {
+	if p.EndHeight == 0 {
+		return errors.Wrap(ErrInvalidRewardSchedule, "end height must be positive")
+	}
+
+	if p.StartHeight > p.EndHeight {
+		return errors.Wrapf(ErrInvalidRewardSchedule, "start height %d is after end height %d", p.StartHeight, p.EndHeight)
+	}
+
+	if !p.AmountPerPeriod.IsValid() {
+		return errors.Wrapf(ErrInvalidRewardSchedule, "invalid amount per period: %s", p.AmountPerPeriod)
+	}
+
+	if !funds.IsValid() {
+		return errors.Wrapf(ErrInvalidRewardSchedule, "invalid funds: %s", funds)
+	}
+
+	if !p.AmountPerPeriod.DenomsSubsetOf(funds) || !funds.DenomsSubsetOf(p.AmountPerPeriod) {
+		return errors.Wrapf(ErrInvalidRewardSchedule, "denoms of funds %s and amount per period %s differ",
+			funds, p.AmountPerPeriod)
+	}
+
+	return nil
+}
+
+// NewRewardSchedule creates a RewardSchedule instance
+func NewRewardSchedule(id uint64, funder sdk.AccAddress, plan RewardSchedulePlan, funds sdk.Coins) RewardSchedule {
+	return RewardSchedule{
+		Id:              id,
+		Funder:          funder.String(),
+		StartHeight:     plan.StartHeight,
+		EndHeight:       plan.EndHeight,
+		AmountPerPeriod: plan.AmountPerPeriod,
+		RemainingFunds:  funds,
+	}
+}
+
+// String implement stringify
+func (s RewardSchedule) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// IsActive returns true if the schedule pays out at the given height.
+func (s RewardSchedule) IsActive(height uint64) bool {
+	return s.StartHeight <= height && height <= s.EndHeight && !s.RemainingFunds.IsZero()
+}
+
+// PeriodPayout returns the amount the schedule pays out in a vote period,
+// bounded by its remaining funds.
+func (s RewardSchedule) PeriodPayout() sdk.Coins {
+	return s.AmountPerPeriod.Min(s.RemainingFunds)
+}
+
+// FundedPeriods returns the number of vote periods the remaining funds of the
+// schedule pay out for, counting a final partial payout.
+func (s RewardSchedule) FundedPeriods() uint64 {
+	var periods uint64
+
+	for _, amount := range s.AmountPerPeriod {
+		if !amount.IsPositive() {
+			continue
+		}
+
+		remaining := s.RemainingFunds.AmountOf(amount.Denom)
+		funded := remaining.Add(amount.Amount).SubRaw(1).Quo(amount.Amount)
+
+		if funded.IsUint64() && funded.Uint64() > periods {
+			periods = funded.Uint64()
+		}
+	}
+
+	return periods
+}
+
+// RemainingPeriods returns the number of vote periods from the given height
+// on the schedule pays out for, and the height of its last payout, zero if it
+// does not pay out anymore.
+func (s RewardSchedule) RemainingPeriods(height, votePeriod uint64) (periods, lastPayoutHeight uint64) {
+	if height < s.StartHeight {
+		height = s.StartHeight
+	}
+
+	if height > s.EndHeight {
+		return 0, 0
+	}
+
+	// vote periods are tallied at their last block, i.e. at the heights h
+	// with (h+1) % votePeriod == 0
+	firstPeriod := height/votePeriod + 1
+	lastPeriod := (s.EndHeight + 1) / votePeriod
+
+	if lastPeriod < firstPeriod {
+		return 0, 0
+	}
+
+	periods = lastPeriod - firstPeriod + 1
+	if funded := s.FundedPeriods(); funded < periods {
+		periods = funded
+	}
+
+	if periods == 0 {
+		return 0, 0
+	}
+
+	return periods, (firstPeriod+periods-1)*votePeriod - 1
+}
+
+// NextPayoutHeight returns the height of the next block, starting at the given
+// height, a vote period is tallied and rewards are paid out at.
+func NextPayoutHeight(height, votePeriod uint64) uint64 {
+	return (height/votePeriod+1)*votePeriod - 1
+}
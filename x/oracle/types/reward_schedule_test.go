@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardScheduleRemainingPeriods(t *testing.T) {
+	funder := sdk.AccAddress([]byte("funder______________"))
+	plan := NewRewardSchedulePlan(100, 200, sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 1000)))
+	schedule := NewRewardSchedule(1, funder, plan, sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 2500)))
+
+	require.Equal(t, uint64(3), schedule.FundedPeriods())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 1000)), schedule.PeriodPayout())
+
+	// bounded by the funds, payouts at 109, 119 and 129
+	periods, lastPayoutHeight := schedule.RemainingPeriods(0, 10)
+	require.Equal(t, uint64(3), periods)
+	require.Equal(t, uint64(129), lastPayoutHeight)
+
+	// bounded by the end height, payouts at 189 and 199
+	periods, lastPayoutHeight = schedule.RemainingPeriods(181, 10)
+	require.Equal(t, uint64(2), periods)
+	require.Equal(t, uint64(199), lastPayoutHeight)
+
+	periods, lastPayoutHeight = schedule.RemainingPeriods(201, 10)
+	require.Zero(t, periods)
+	require.Zero(t, lastPayoutHeight)
+
+	schedule.RemainingFunds = sdk.NewCoins(sdk.NewInt64Coin(PersistenceDenom, 400))
+	require.Equal(t, uint64(1), schedule.FundedPeriods())
+	require.Equal(t, schedule.RemainingFunds, schedule.PeriodPayout())
+	require.True(t, schedule.IsActive(150))
+	require.False(t, schedule.IsActive(99))
+	require.False(t, schedule.IsActive(201))
+
+	schedule.RemainingFunds = sdk.NewCoins()
+	require.Zero(t, schedule.FundedPeriods())
+	require.False(t, schedule.IsActive(150))
+}
+
+func TestNextPayoutHeight(t *testing.T) {
+	require.Equal(t, uint64(9), NextPayoutHeight(0, 10))
+	require.Equal(t, uint64(9), NextPayoutHeight(9, 10))
+	require.Equal(t, uint64(19), NextPayoutHeight(10, 10))
+	require.Equal(t, uint64(4), NextPayoutHeight(4, 1))
+}
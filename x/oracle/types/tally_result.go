@@ -0,0 +1,11 @@
+package types
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// String implement stringify
+func (r TallyResult) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
@@ -65,7 +65,8 @@ func (tuples ExchangeRateTuples) String() string {
 	return string(out)
 }
 
-// ParseExchangeRateTuples ExchangeRateTuple parser
+// ParseExchangeRateTuples ExchangeRateTuple parser. A zero exchange rate
+// abstains from voting on the denom.
 func ParseExchangeRateTuples(tuplesStr string) (ExchangeRateTuples, error) {
 	if len(tuplesStr) == 0 {
 		return nil, nil
@@ -87,7 +88,7 @@ func ParseExchangeRateTuples(tuplesStr string) (ExchangeRateTuples, error) {
 			return nil, err
 		}
 
-		if !decCoin.IsPositive() {
+		if decCoin.IsNegative() {
 			return nil, ErrInvalidOraclePrice
 		}
 
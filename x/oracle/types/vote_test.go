@@ -66,8 +66,9 @@ func TestParseExchangeRateTuples(t *testing.T) {
 	require.Error(t, err)
 
 	zeroCoinsWithValid := "uxprt:0.0,uatom:123.1"
-	_, err = ParseExchangeRateTuples(zeroCoinsWithValid)
-	require.Error(t, err)
+	res, err := ParseExchangeRateTuples(zeroCoinsWithValid)
+	require.NoError(t, err)
+	require.True(t, res[0].ExchangeRate.IsZero())
 
 	negativeCoinsWithValid := "uxprt:-1234.5,uatom:123.1"
 	_, err = ParseExchangeRateTuples(negativeCoinsWithValid)
@@ -77,7 +78,7 @@ func TestParseExchangeRateTuples(t *testing.T) {
 	_, err = ParseExchangeRateTuples(multiplePricesPerRate)
 	require.Error(t, err)
 
-	res, err := ParseExchangeRateTuples("")
+	res, err = ParseExchangeRateTuples("")
 	require.Nil(t, err)
 	require.Nil(t, res)
 }